@@ -0,0 +1,212 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package analyzer reports Go files missing the license header licentia
+// sets, and suggests inserting it.
+//
+// The expected header is read from a file holding it as "licentia set"
+// inserts it in Go files, which "licentia show --header <type> <owner> //"
+// writes. Analyzer takes it from its -header flag, for singlechecker,
+// multichecker and go vet -vettool:
+//
+//	licentia show --header mpl2 "Your Company" // > .licentia-header
+//	go vet -vettool=$(which licentia-vet) -header=$PWD/.licentia-header ./...
+//
+// golangci-lint plugins and other drivers configure it with New instead.
+package analyzer
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"io/ioutil"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const doc = `check that Go files have a license header
+
+Files are expected to start with the header in the file given by -header,
+which "licentia show --header <type> <owner> //" writes. Copyright notices
+are only required to be there, regardless of their holders and years.`
+
+// Analyzer configured by its -header flag.
+var Analyzer = &analysis.Analyzer{
+	Name: "licenseheader",
+	Doc:  doc,
+	Run:  runFlags,
+}
+
+var headerFile string
+
+// Returns the current time, which gives the year of inserted notices.
+var now = time.Now
+
+func init() {
+	Analyzer.Flags.StringVar(&headerFile, "header", "", "file holding the license header as set in Go files")
+}
+
+var (
+	// Copyright notice, ex: // Copyright (c) 2015 Your Company.
+	copyrightRe = regexp.MustCompile(`^//\s*(?i:copyright\b|\(c\)|©)`)
+	// Years of a copyright notice, ex: 2015 or 2015-2019.
+	yearsRe = regexp.MustCompile(`\d{4}(?:\s*[-,]\s*\d{4})*`)
+	// https://golang.org/s/generatedcode
+	generatedRe = regexp.MustCompile(`^// Code generated .* DO NOT EDIT\.$`)
+)
+
+// License header Go files are expected to have.
+type header struct {
+	// Header as inserted, ending with an empty line.
+	text string
+	// Whether the header starts with copyright notices.
+	copyright bool
+	// Lines of the license header following the copyright notices.
+	body []string
+}
+
+// Returns an analyzer reporting the Go files missing header, a license
+// header as "licentia set" inserts it in Go files.
+func New(header []byte) (*analysis.Analyzer, error) {
+	h, err := parseHeader(header)
+	if err != nil {
+		return nil, err
+	}
+	return &analysis.Analyzer{
+		Name: Analyzer.Name,
+		Doc:  doc,
+		Run: func(pass *analysis.Pass) (interface{}, error) {
+			return run(pass, h)
+		},
+	}, nil
+}
+
+func runFlags(pass *analysis.Pass) (interface{}, error) {
+	if headerFile == "" {
+		return nil, errors.New("no license header given, see -header")
+	}
+	data, err := ioutil.ReadFile(headerFile)
+	if err != nil {
+		return nil, err
+	}
+	h, err := parseHeader(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", headerFile, err)
+	}
+	return run(pass, h)
+}
+
+func parseHeader(data []byte) (*header, error) {
+	text := strings.TrimRight(strings.Replace(string(data), "\r\n", "\n", -1), "\n")
+	if text == "" {
+		return nil, errors.New("empty license header")
+	}
+
+	h := &header{text: text + "\n\n"}
+	lines := strings.Split(text, "\n")
+	for len(lines) > 0 && copyrightRe.MatchString(lines[0]) {
+		h.copyright = true
+		lines = lines[1:]
+	}
+	for _, line := range lines {
+		if !strings.HasPrefix(line, "//") {
+			return nil, fmt.Errorf("license header line %q is not a // comment", line)
+		}
+		h.body = append(h.body, strings.TrimRight(line, " \t"))
+	}
+	return h, nil
+}
+
+func run(pass *analysis.Pass, h *header) (interface{}, error) {
+	for _, file := range pass.Files {
+		if isGenerated(file) || hasHeader(file, h) {
+			continue
+		}
+
+		tf := pass.Fset.File(file.Pos())
+		if tf == nil {
+			continue
+		}
+		start := tf.Pos(0)
+
+		// Inserted notices get the current year.
+		year := strconv.Itoa(now().Year())
+		text := h.text
+		if h.copyright {
+			lines := strings.SplitAfter(text, "\n")
+			for i := 0; i < len(lines) && copyrightRe.MatchString(lines[i]); i++ {
+				lines[i] = yearsRe.ReplaceAllLiteralString(lines[i], year)
+			}
+			text = strings.Join(lines, "")
+		}
+
+		pass.Report(analysis.Diagnostic{
+			Pos:     file.Package,
+			Message: "missing license header",
+			SuggestedFixes: []analysis.SuggestedFix{{
+				Message:   "Insert license header",
+				TextEdits: []analysis.TextEdit{{Pos: start, End: start, NewText: []byte(text)}},
+			}},
+		})
+	}
+	return nil, nil
+}
+
+// Tells whether one of the comments preceding the package clause of file,
+// such as build constraints or the package documentation, is the license
+// header.
+func hasHeader(file *ast.File, h *header) bool {
+	for _, group := range file.Comments {
+		if group.Pos() >= file.Package {
+			break
+		}
+
+		var lines []string
+		for _, c := range group.List {
+			lines = append(lines, strings.Split(c.Text, "\n")...)
+		}
+
+		copyrights := 0
+		for copyrights < len(lines) && copyrightRe.MatchString(lines[copyrights]) {
+			copyrights++
+		}
+		if h.copyright && copyrights == 0 {
+			continue
+		}
+
+		body := lines[copyrights:]
+		if len(body) < len(h.body) {
+			continue
+		}
+		matched := true
+		for i, line := range h.body {
+			if strings.TrimRight(body[i], " \t") != line {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+func isGenerated(file *ast.File) bool {
+	for _, group := range file.Comments {
+		if group.Pos() >= file.Package {
+			return false
+		}
+		for _, c := range group.List {
+			if generatedRe.MatchString(c.Text) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,46 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package analyzer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	now = func() time.Time {
+		return time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	}
+	defer func() { now = time.Now }()
+
+	if err := Analyzer.Flags.Set("header", filepath.Join("testdata", "header")); err != nil {
+		t.Fatal(err)
+	}
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), Analyzer, "a")
+}
+
+func TestNew(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "header"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, err := New(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Name != Analyzer.Name {
+		t.Errorf("unexpected name %q", a.Name)
+	}
+
+	for _, header := range []string{"", "\n\n", "// Copyright (c) 2015 Test\n/* MIT */\n"} {
+		if _, err := New([]byte(header)); err == nil {
+			t.Errorf("header %q should be rejected", header)
+		}
+	}
+}
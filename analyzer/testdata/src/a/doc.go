@@ -0,0 +1,9 @@
+//go:build go1.1
+
+// Copyright (c) 2015 Test
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+// Package a is licensed below its build constraints.
+package a
@@ -0,0 +1,7 @@
+// Copyright (c) 2015-2019 Other
+// Copyright (c) 2017 Test
+//
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package a
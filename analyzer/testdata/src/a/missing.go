@@ -0,0 +1,2 @@
+// Package a is not licensed.
+package a // want "missing license header"
@@ -0,0 +1,4 @@
+// Use of this source code is governed by an MIT-style
+// license that can be found in the LICENSE file.
+
+package a // want "missing license header"
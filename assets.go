@@ -0,0 +1,109 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"embed"
+	"errors"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+//go:embed licenses
+var embeddedLicenses embed.FS
+
+// Templates of the supported licenses, laid out as licenses/ is. Replace it
+// with SetTemplates to use other templates.
+var templatesFS = DefaultTemplates()
+
+// Makes the engine read license templates from fsys. Layer fsys over
+// DefaultTemplates with LayeredFS to only override some of them.
+func SetTemplates(fsys fs.FS) {
+	templatesFS = fsys
+}
+
+// Returns the embedded license templates.
+func DefaultTemplates() fs.FS {
+	return mustSub(embeddedLicenses, "licenses")
+}
+
+func mustSub(fsys fs.FS, dir string) fs.FS {
+	sub, err := fs.Sub(fsys, dir)
+	if err != nil {
+		panic(err)
+	}
+	return sub
+}
+
+// Returns the contents of a template, ex: licenses/mit.header.
+func Asset(path string) ([]byte, error) {
+	return fs.ReadFile(templatesFS, assetName(path))
+}
+
+// Returns the names of the templates in a directory, ex: licenses.
+func AssetDir(path string) ([]string, error) {
+	entries, err := fs.ReadDir(templatesFS, assetName(path))
+	names := make([]string, len(entries))
+	for i, entry := range entries {
+		names[i] = entry.Name()
+	}
+	return names, err
+}
+
+// Maps a path under licenses/ to the name of the template in templatesFS.
+func assetName(path string) string {
+	name := strings.TrimPrefix(filepath.ToSlash(path), "/")
+	if name == "licenses" {
+		return "."
+	}
+	return strings.TrimPrefix(name, "licenses/")
+}
+
+// File system made of layers, where files of a layer hide the ones of the
+// layers after it. Directories list the files of every layer.
+type LayeredFS []fs.FS
+
+func (l LayeredFS) Open(name string) (fs.File, error) {
+	for _, layer := range l {
+		f, err := layer.Open(name)
+		if err == nil || !errors.Is(err, fs.ErrNotExist) {
+			return f, err
+		}
+	}
+	return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+}
+
+func (l LayeredFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	seen := make(map[string]bool)
+	var entries []fs.DirEntry
+	found := false
+	for _, layer := range l {
+		layerEntries, err := fs.ReadDir(layer, name)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		found = true
+		for _, entry := range layerEntries {
+			if !seen[entry.Name()] {
+				seen[entry.Name()] = true
+				entries = append(entries, entry)
+			}
+		}
+	}
+	if !found {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrNotExist}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name() < entries[j].Name()
+	})
+	return entries, nil
+}
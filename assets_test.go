@@ -0,0 +1,49 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"testing/fstest"
+)
+
+func TestLayeredTemplates(t *testing.T) {
+	overrides := fstest.MapFS{
+		"mit.header": {Data: []byte("Licensed under the MIT license.\n")},
+		"notes.txt":  {Data: []byte("Not a template.\n")},
+	}
+	SetTemplates(LayeredFS{overrides, DefaultTemplates()})
+	defer SetTemplates(DefaultTemplates())
+
+	names, err := AssetDir("licenses")
+	ok(t, err)
+	assert(t, contains(names, "notes.txt") && contains(names, "mpl2.header"), "Templates of every layer should be listed: %v", names)
+
+	file, err := ioutil.TempFile(os.TempDir(), "licentia-tests-")
+	ok(t, err)
+	defer os.Remove(file.Name())
+
+	// The overridden header is used along with the embedded copyright notice.
+	_, err = Set(&Config{CopyrightOwner: "Test", LicenseType: MIT, Files: []string{file.Name()}, EOLCommentStyle: "#"})
+	ok(t, err)
+	data, err := ioutil.ReadFile(file.Name())
+	ok(t, err)
+	year := CopyrightHolder{}.years()
+	equals(t, "# Copyright (c) "+year+" Test\n#\n# Licensed under the MIT license.\n\n", string(data))
+
+	_, err = Asset("licenses/missing")
+	assert(t, os.IsNotExist(err), "Missing templates should not exist: %v", err)
+}
+
+func contains(list []string, s string) bool {
+	for _, elt := range list {
+		if elt == s {
+			return true
+		}
+	}
+	return false
+}
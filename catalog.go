@@ -0,0 +1,249 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// Description of a supported license. The templates of a license are
+// embedded from licenses/: {type} holds its full text, {type}.header the
+// header set to source files and {type}.copyright the copyright notice
+// preceding the header, with @@year@@ and @@owner@@ placeholders.
+type licenseInfo struct {
+	Type LicenseType `json:"type"`
+	// Full name of the license.
+	Name string `json:"name"`
+	// SPDX identifier. See https://spdx.org/licenses/
+	SPDX string `json:"spdx"`
+	// Canonical location of the license text.
+	URL string `json:"url"`
+	// Approved by the Open Source Initiative.
+	OSI bool `json:"osi"`
+	// Listed as free by the Free Software Foundation.
+	FSF      bool     `json:"fsf"`
+	Copyleft copyleft `json:"copyleft"`
+	// Whether source files are given a license header.
+	Header bool `json:"header"`
+	// Whether the license header is preceded by copyright notices.
+	Copyright bool `json:"copyright"`
+	// Whether the full text is bundled, which is required to dump it.
+	Text bool `json:"text"`
+	// License whose bundled full text is the text of this one too, as the
+	// -only versions of the GNU licenses only differ by their headers.
+	TextOf LicenseType `json:"-"`
+	// Other identifiers and names commonly found in package metadata, in
+	// lower case.
+	Aliases []string `json:"aliases,omitempty"`
+}
+
+// Supported licenses, from the most permissive to the strongest copyleft.
+var catalog = []licenseInfo{
+	{
+		Type: Apache2, Name: "Apache License 2.0", SPDX: "Apache-2.0",
+		URL: "https://www.apache.org/licenses/LICENSE-2.0",
+		OSI: true, FSF: true, Copyleft: permissive, Header: true, Copyright: true, Text: true,
+		Aliases: []string{"apache 2.0", "apache license 2.0", "apache license, version 2.0", "apache software license", "apache-2", "apache2"},
+	},
+	{
+		Type: MIT, Name: "MIT License", SPDX: "MIT",
+		URL: "https://opensource.org/licenses/MIT",
+		OSI: true, FSF: true, Copyleft: permissive, Header: true, Copyright: true, Text: true,
+		Aliases: []string{"mit license"},
+	},
+	{
+		Type: NewBSD, Name: `BSD 3-Clause "New" or "Revised" License`, SPDX: "BSD-3-Clause",
+		URL: "https://opensource.org/licenses/BSD-3-Clause",
+		OSI: true, FSF: true, Copyleft: permissive, Header: true, Copyright: true, Text: true,
+	},
+	{
+		Type: Freebsd, Name: `BSD 2-Clause "Simplified" License`, SPDX: "BSD-2-Clause",
+		URL: "https://opensource.org/licenses/BSD-2-Clause",
+		OSI: true, FSF: true, Copyleft: permissive, Header: true, Copyright: true, Text: true,
+		Aliases: []string{"bsd-2-clause-freebsd"},
+	},
+	{
+		Type: ZeroBSD, Name: "BSD Zero Clause License", SPDX: "0BSD",
+		URL: "https://opensource.org/licenses/0BSD",
+		OSI: true, FSF: false, Copyleft: permissive, Header: true, Copyright: true, Text: true,
+	},
+	{
+		Type: ISC, Name: "ISC License", SPDX: "ISC",
+		URL: "https://opensource.org/licenses/ISC",
+		OSI: true, FSF: true, Copyleft: permissive, Header: true, Copyright: true, Text: true,
+		Aliases: []string{"isc license"},
+	},
+	{
+		Type: Zlib, Name: "zlib License", SPDX: "Zlib",
+		URL: "https://zlib.net/zlib_license.html",
+		OSI: true, FSF: true, Copyleft: permissive, Header: true, Copyright: true, Text: true,
+	},
+	{
+		Type: BSL, Name: "Boost Software License 1.0", SPDX: "BSL-1.0",
+		URL: "https://www.boost.org/LICENSE_1_0.txt",
+		OSI: true, FSF: true, Copyleft: permissive, Header: true, Copyright: true, Text: true,
+		Aliases: []string{"boost software license 1.0"},
+	},
+	{
+		Type: UPL, Name: "Universal Permissive License v1.0", SPDX: "UPL-1.0",
+		URL: "https://opensource.org/licenses/UPL",
+		OSI: true, FSF: true, Copyleft: permissive, Header: true, Copyright: true, Text: true,
+	},
+	{
+		Type: UNLICENSE, Name: "The Unlicense", SPDX: "Unlicense",
+		URL: "https://unlicense.org/",
+		OSI: true, FSF: true, Copyleft: permissive, Header: true, Copyright: true, Text: true,
+		Aliases: []string{"the unlicense (unlicense)"},
+	},
+	{
+		Type: CC0, Name: "Creative Commons Zero v1.0 Universal", SPDX: "CC0-1.0",
+		URL: "https://creativecommons.org/publicdomain/zero/1.0/",
+		OSI: false, FSF: true, Copyleft: permissive, Header: true, Copyright: false, Text: true,
+		Aliases: []string{"cc0"},
+	},
+	{
+		Type: CCBY, Name: "Creative Commons Attribution 4.0 International", SPDX: "CC-BY-4.0",
+		URL: "https://creativecommons.org/licenses/by/4.0/",
+		OSI: false, FSF: true, Copyleft: permissive, Header: true, Copyright: true, Text: true,
+	},
+	{
+		Type: MPL2, Name: "Mozilla Public License 2.0", SPDX: "MPL-2.0",
+		URL: "https://mozilla.org/MPL/2.0/",
+		OSI: true, FSF: true, Copyleft: fileCopyleft, Header: true, Copyright: false, Text: true,
+		Aliases: []string{"mozilla public license 2.0 (mpl 2.0)"},
+	},
+	{
+		Type: EPL, Name: "Eclipse Public License 1.0", SPDX: "EPL-1.0",
+		URL: "https://www.eclipse.org/legal/epl-v10.html",
+		OSI: true, FSF: true, Copyleft: fileCopyleft, Header: true, Copyright: true, Text: true,
+	},
+	{
+		Type: EPL2, Name: "Eclipse Public License 2.0", SPDX: "EPL-2.0",
+		URL: "https://www.eclipse.org/legal/epl-2.0/",
+		OSI: true, FSF: true, Copyleft: fileCopyleft, Header: true, Copyright: true, Text: true,
+	},
+	{
+		Type: CDDL, Name: "Common Development and Distribution License 1.0", SPDX: "CDDL-1.0",
+		URL: "https://opensource.org/licenses/CDDL-1.0",
+		OSI: true, FSF: true, Copyleft: fileCopyleft, Header: true, Copyright: false, Text: true,
+	},
+	{
+		Type: LGPL2, Name: "GNU Lesser General Public License v2.1 or later", SPDX: "LGPL-2.1-or-later",
+		URL: "https://www.gnu.org/licenses/old-licenses/lgpl-2.1.html",
+		OSI: true, FSF: true, Copyleft: libraryCopyleft, Header: true, Copyright: true, Text: true,
+		Aliases: []string{"lgpl-2.1+"},
+	},
+	{
+		Type: LGPL2Only, Name: "GNU Lesser General Public License v2.1 only", SPDX: "LGPL-2.1-only",
+		URL: "https://www.gnu.org/licenses/old-licenses/lgpl-2.1.html",
+		OSI: true, FSF: true, Copyleft: libraryCopyleft, Header: true, Copyright: true, Text: true, TextOf: LGPL2,
+		Aliases: []string{"lgpl-2.1"},
+	},
+	{
+		Type: LGPL3, Name: "GNU Lesser General Public License v3.0 or later", SPDX: "LGPL-3.0-or-later",
+		URL: "https://www.gnu.org/licenses/lgpl-3.0.html",
+		OSI: true, FSF: true, Copyleft: libraryCopyleft, Header: true, Copyright: true, Text: true,
+		Aliases: []string{"lgpl-3.0+"},
+	},
+	{
+		Type: LGPL3Only, Name: "GNU Lesser General Public License v3.0 only", SPDX: "LGPL-3.0-only",
+		URL: "https://www.gnu.org/licenses/lgpl-3.0.html",
+		OSI: true, FSF: true, Copyleft: libraryCopyleft, Header: true, Copyright: true, Text: true, TextOf: LGPL3,
+		Aliases: []string{"lgpl-3.0"},
+	},
+	{
+		Type: GPL2, Name: "GNU General Public License v2.0 or later", SPDX: "GPL-2.0-or-later",
+		URL: "https://www.gnu.org/licenses/old-licenses/gpl-2.0.html",
+		OSI: true, FSF: true, Copyleft: strongCopyleft, Header: true, Copyright: true, Text: true,
+		Aliases: []string{"gpl-2.0+"},
+	},
+	{
+		Type: GPL2Only, Name: "GNU General Public License v2.0 only", SPDX: "GPL-2.0-only",
+		URL: "https://www.gnu.org/licenses/old-licenses/gpl-2.0.html",
+		OSI: true, FSF: true, Copyleft: strongCopyleft, Header: true, Copyright: true, Text: true, TextOf: GPL2,
+		Aliases: []string{"gpl-2.0", "gplv2"},
+	},
+	{
+		Type: GPL3, Name: "GNU General Public License v3.0 or later", SPDX: "GPL-3.0-or-later",
+		URL: "https://www.gnu.org/licenses/gpl-3.0.html",
+		OSI: true, FSF: true, Copyleft: strongCopyleft, Header: true, Copyright: true, Text: true,
+		Aliases: []string{"gpl-3.0+"},
+	},
+	{
+		Type: GPL3Only, Name: "GNU General Public License v3.0 only", SPDX: "GPL-3.0-only",
+		URL: "https://www.gnu.org/licenses/gpl-3.0.html",
+		OSI: true, FSF: true, Copyleft: strongCopyleft, Header: true, Copyright: true, Text: true, TextOf: GPL3,
+		Aliases: []string{"gpl-3.0", "gplv3"},
+	},
+	{
+		Type: AGPL3, Name: "GNU Affero General Public License v3.0 or later", SPDX: "AGPL-3.0-or-later",
+		URL: "https://www.gnu.org/licenses/agpl-3.0.html",
+		OSI: true, FSF: true, Copyleft: strongCopyleft, Header: true, Copyright: true, Text: true,
+		Aliases: []string{"agpl-3.0+"},
+	},
+	{
+		Type: AGPL3Only, Name: "GNU Affero General Public License v3.0 only", SPDX: "AGPL-3.0-only",
+		URL: "https://www.gnu.org/licenses/agpl-3.0.html",
+		OSI: true, FSF: true, Copyleft: strongCopyleft, Header: true, Copyright: true, Text: true, TextOf: AGPL3,
+		Aliases: []string{"agpl-3.0", "agplv3"},
+	},
+	{
+		Type: EUPL, Name: "European Union Public License 1.2", SPDX: "EUPL-1.2",
+		URL: "https://joinup.ec.europa.eu/collection/eupl/eupl-text-eupl-12",
+		OSI: true, FSF: true, Copyleft: strongCopyleft, Header: true, Copyright: true, Text: true,
+		Aliases: []string{"eupl-1.2-or-later"},
+	},
+}
+
+var catalogIndex = func() map[LicenseType]*licenseInfo {
+	index := make(map[LicenseType]*licenseInfo, len(catalog))
+	for i := range catalog {
+		index[catalog[i].Type] = &catalog[i]
+	}
+	return index
+}()
+
+// Returns the description of ltype, or nil if it is not supported.
+func lookupLicense(ltype LicenseType) *licenseInfo {
+	return catalogIndex[ltype]
+}
+
+// Returns the path of the template holding the full text of the license.
+func (info *licenseInfo) textAsset() string {
+	ltype := info.Type
+	if info.TextOf != "" {
+		ltype = info.TextOf
+	}
+	return filepath.Join("licenses", string(ltype))
+}
+
+// Parses a license type or SPDX identifier, ex: apache2 or Apache-2.0. An
+// error is returned for unsupported licenses.
+func ParseLicenseType(s string) (LicenseType, error) {
+	if lookupLicense(LicenseType(s)) != nil {
+		return LicenseType(s), nil
+	}
+	if ltype := spdxLicenseType(s); ltype != UNKNOWN {
+		return ltype, nil
+	}
+	return UNKNOWN, fmt.Errorf("unsupported license type %q, see \"licentia list\"", s)
+}
+
+// Formats the supported license types in columns for the usage message.
+func licenseTypesUsage() string {
+	const columns = 3
+
+	var lines []string
+	for i := 0; i < len(catalog); i += columns {
+		var line string
+		for j := i; j < i+columns && j < len(catalog); j++ {
+			line += fmt.Sprintf("* %-11s", catalog[j].Type)
+		}
+		lines = append(lines, strings.TrimRight(line, " "))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,65 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// Fails when the catalog and the templates in licenses/ diverge.
+func TestCatalogAssets(t *testing.T) {
+	assets, err := AssetDir("licenses")
+	ok(t, err)
+
+	present := make(map[string]bool, len(assets))
+	for _, name := range assets {
+		present[name] = true
+		ltype := LicenseType(strings.TrimSuffix(strings.TrimSuffix(name, ".header"), ".copyright"))
+		assert(t, lookupLicense(ltype) != nil, "licenses/%s belongs to no license of the catalog", name)
+	}
+
+	for _, info := range catalog {
+		name := string(info.Type)
+		text := filepath.Base(info.textAsset())
+		assert(t, info.Text == present[text], "%s: Text is %v but licenses/%s exists: %v", name, info.Text, text, present[text])
+		assert(t, info.Text, "%s: licenses/%s with the full text is missing", name, text)
+		assert(t, info.TextOf == "" || !present[name], "%s: licenses/%s duplicates the text of %s", name, name, info.TextOf)
+		assert(t, info.Header == present[name+".header"], "%s: Header is %v but licenses/%s.header exists: %v", name, info.Header, name, present[name+".header"])
+		assert(t, info.Copyright == present[name+".copyright"], "%s: Copyright is %v but licenses/%s.copyright exists: %v", name, info.Copyright, name, present[name+".copyright"])
+	}
+}
+
+func TestCatalogIdentifiers(t *testing.T) {
+	seen := make(map[string]LicenseType)
+	for _, info := range catalog {
+		assert(t, info.Name != "" && info.SPDX != "" && info.URL != "", "%s: name, SPDX identifier and URL are required", info.Type)
+
+		for _, id := range append([]string{string(info.Type), info.SPDX}, info.Aliases...) {
+			id = strings.ToLower(id)
+			other, dup := seen[id]
+			assert(t, !dup || other == info.Type, "%q identifies both %s and %s", id, other, info.Type)
+			seen[id] = info.Type
+		}
+
+		ltype, err := ParseLicenseType(info.SPDX)
+		ok(t, err)
+		equals(t, info.Type, ltype)
+		for _, alias := range info.Aliases {
+			equals(t, info.Type, spdxLicenseType(alias))
+		}
+	}
+
+	// Versions of the GNU licenses without "or later" are only meant when
+	// explicitly given.
+	for id, ltype := range map[string]LicenseType{"GPL-2.0": GPL2Only, "GPL-2.0-only": GPL2Only, "GPL-2.0+": GPL2, "gpl-3.0": GPL3Only, "AGPL-3.0-or-later": AGPL3} {
+		equals(t, ltype, spdxLicenseType(id))
+	}
+	equals(t, UNKNOWN, spdxLicenseType("BSD"))
+
+	_, err := ParseLicenseType("foo")
+	assert(t, err != nil, "Unsupported license types should not be accepted")
+}
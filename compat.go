@@ -0,0 +1,191 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// How far the terms of a license reach into the work that includes it.
+type copyleft int
+
+const (
+	// No requirements on the license of the larger work.
+	permissive copyleft = iota
+	// Copyleft limited to the files under the license.
+	fileCopyleft
+	// Copyleft limited to the library, provided it is linked as such.
+	libraryCopyleft
+	// Copyleft extends to the whole work.
+	strongCopyleft
+)
+
+func (c copyleft) String() string {
+	switch c {
+	case permissive:
+		return "permissive"
+	case fileCopyleft:
+		return "file"
+	case libraryCopyleft:
+		return "library"
+	case strongCopyleft:
+		return "strong"
+	}
+	return fmt.Sprintf("copyleft(%d)", int(c))
+}
+
+// Marshals the copyleft strength by name, ex: "file".
+func (c copyleft) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// Known incompatibilities that do not follow from the copyleft strength of
+// the licenses, indexed by project license and then by file license.
+var incompatibilities = map[LicenseType]map[LicenseType]string{
+	GPL2: {
+		Apache2: "Apache-2.0 patent termination and indemnification terms are additional restrictions forbidden by GPL-2.0",
+		LGPL3:   "LGPL-3.0 code can only be combined into GPL-3.0 or later works",
+		EPL:     "EPL-1.0 and GPL-2.0 are mutually incompatible copyleft licenses",
+		EPL2:    "EPL-2.0 and GPL-2.0 are mutually incompatible copyleft licenses unless GPL-2.0 is designated as a secondary license",
+		CDDL:    "CDDL-1.0 and GPL-2.0 are mutually incompatible copyleft licenses",
+	},
+	GPL3: {
+		GPL2Only: "GPL-2.0-only code cannot be distributed under GPL-3.0",
+		EPL:      "EPL-1.0 and GPL-3.0 are mutually incompatible copyleft licenses",
+		EPL2:     "EPL-2.0 and GPL-3.0 are mutually incompatible copyleft licenses unless GPL-3.0 is designated as a secondary license",
+		CDDL:     "CDDL-1.0 and GPL-3.0 are mutually incompatible copyleft licenses",
+	},
+	AGPL3: {
+		GPL2Only: "GPL-2.0-only code cannot be upgraded to GPL-3.0, which AGPL-3.0 works may only be combined with",
+		EPL:      "EPL-1.0 and AGPL-3.0 are mutually incompatible copyleft licenses",
+		EPL2:     "EPL-2.0 and AGPL-3.0 are mutually incompatible copyleft licenses",
+		CDDL:     "CDDL-1.0 and AGPL-3.0 are mutually incompatible copyleft licenses",
+	},
+	LGPL2: {
+		Apache2: "Apache-2.0 patent termination and indemnification terms are additional restrictions forbidden by LGPL-2.1",
+		LGPL3:   "LGPL-3.0 code cannot be relicensed under LGPL-2.1",
+		EPL:     "EPL-1.0 and LGPL-2.1 are mutually incompatible copyleft licenses",
+		EPL2:    "EPL-2.0 and LGPL-2.1 are mutually incompatible copyleft licenses",
+		CDDL:    "CDDL-1.0 and LGPL-2.1 are mutually incompatible copyleft licenses",
+	},
+	LGPL3: {
+		EPL:  "EPL-1.0 and LGPL-3.0 are mutually incompatible copyleft licenses",
+		EPL2: "EPL-2.0 and LGPL-3.0 are mutually incompatible copyleft licenses",
+		CDDL: "CDDL-1.0 and LGPL-3.0 are mutually incompatible copyleft licenses",
+	},
+}
+
+// Licenses limited to a single version of the GNU licenses, by the license
+// also allowing later versions. They share its compatibility, except that
+// they cannot be upgraded to a later version.
+var onlyVersions = map[LicenseType]LicenseType{
+	GPL2Only:  GPL2,
+	GPL3Only:  GPL3,
+	LGPL2Only: LGPL2,
+	LGPL3Only: LGPL3,
+	AGPL3Only: AGPL3,
+}
+
+// Returns the license ltype is a single version of, or ltype itself.
+func orLater(ltype LicenseType) LicenseType {
+	if l, ok := onlyVersions[ltype]; ok {
+		return l
+	}
+	return ltype
+}
+
+type fileConflict struct {
+	file        string
+	license     LicenseExpression
+	explanation string
+}
+
+// Tells whether a file under the license file can be part of a project
+// distributed under the license project. If it cannot, the reason is
+// returned.
+func compatible(project, file LicenseType) (bool, string) {
+	if project == file {
+		return true, ""
+	}
+	// Projects under a single version of a license have the compatibility
+	// of the license, and so do files under the version the project is
+	// under.
+	project = orLater(project)
+	if project == orLater(file) {
+		return true, ""
+	}
+
+	if reason, ok := incompatibilities[project][file]; ok {
+		return false, reason
+	}
+	if reason, ok := incompatibilities[project][orLater(file)]; ok {
+		return false, reason
+	}
+
+	info := lookupLicense(file)
+	if info == nil {
+		return false, fmt.Sprintf("no compatibility information about %s", file)
+	}
+
+	switch info.Copyleft {
+	case permissive, fileCopyleft:
+		// File level copyleft only binds the files themselves.
+		return true, ""
+	case libraryCopyleft:
+		if project == GPL2 || project == GPL3 || project == AGPL3 || project == LGPL3 {
+			return true, ""
+		}
+		return false, fmt.Sprintf("%s requires the library these files belong to, and its modifications, to be distributed under %s", file, file)
+	}
+
+	// The "or any later version" clause in our GPL-2.0 header allows the
+	// work to be distributed under GPL-3.0.
+	if project == GPL3 && file == GPL2 {
+		return true, ""
+	}
+	// Section 13 of AGPL-3.0 allows combining the work with GPL-3.0 code,
+	// which GPL-2.0 code can be upgraded to as above.
+	if project == AGPL3 && (file == GPL3 || file == GPL3Only || file == GPL2) {
+		return true, ""
+	}
+	return false, fmt.Sprintf("%s requires the whole work to be distributed under %s", file, file)
+}
+
+// Reports the files whose license cannot coexist with the project license.
+// Files under alternative licenses only conflict when none of them is
+// compatible, and files under several licenses at once when any of them is
+// not. Files of unknown license are not reported. Conflicts are sorted by
+// file name.
+func Compat(project LicenseType, licenses []fileLicense) ([]fileConflict, error) {
+	if lookupLicense(project) == nil {
+		return nil, fmt.Errorf("no compatibility information about %s", project)
+	}
+
+	var conflicts []fileConflict
+	for _, l := range licenses {
+		var reasons []string
+		for _, ltype := range l.license.Licenses {
+			ok, reason := compatible(project, ltype)
+			if ok && l.license.Operator != "AND" {
+				reasons = nil
+				break
+			}
+			if !ok {
+				reasons = append(reasons, reason)
+			}
+		}
+
+		if len(reasons) > 0 {
+			conflicts = append(conflicts, fileConflict{file: l.file, license: l.license, explanation: strings.Join(reasons, "; ")})
+		}
+	}
+
+	sort.Slice(conflicts, func(i, j int) bool {
+		return conflicts[i].file < conflicts[j].file
+	})
+	return conflicts, nil
+}
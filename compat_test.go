@@ -0,0 +1,70 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import "testing"
+
+func TestCompatible(t *testing.T) {
+	tests := []struct {
+		project, file LicenseType
+		compatible    bool
+	}{
+		{Apache2, MIT, true},
+		{Apache2, MPL2, true},
+		{Apache2, GPL2, false},
+		{Apache2, LGPL3, false},
+		{MIT, Apache2, true},
+		{GPL2, Apache2, false},
+		{GPL3, Apache2, true},
+		{GPL3, GPL2, true},
+		{GPL2, GPL3, false},
+		{GPL2, LGPL2, true},
+		{GPL2, LGPL3, false},
+		{GPL3, EPL, false},
+		{LGPL3, LGPL2, true},
+		{LGPL2, LGPL3, false},
+		{AGPL3, GPL3, true},
+		{AGPL3, ISC, true},
+		{GPL3, AGPL3, false},
+		{GPL2, EPL2, false},
+		{MIT, EUPL, false},
+		{Apache2, ZeroBSD, true},
+		{GPL3, GPL2Only, false},
+		{GPL3Only, GPL2Only, false},
+		{AGPL3, GPL2Only, false},
+		{GPL2, GPL2Only, true},
+		{GPL2Only, GPL2, true},
+		{GPL3Only, GPL2, true},
+		{GPL2Only, GPL3Only, false},
+		{AGPL3Only, GPL3Only, true},
+		{GPL2Only, LGPL2Only, true},
+		{LGPL2Only, LGPL3, false},
+	}
+
+	for _, tt := range tests {
+		ok, reason := compatible(tt.project, tt.file)
+		assert(t, ok == tt.compatible, "%s files in a %s project: expected compatible=%v, got %v", tt.file, tt.project, tt.compatible, ok)
+		assert(t, ok == (reason == ""), "%s files in a %s project: unexpected reason %q", tt.file, tt.project, reason)
+	}
+}
+
+func TestCompat(t *testing.T) {
+	conflicts, err := Compat(Apache2, []fileLicense{
+		{file: "b.go", license: singleLicense(GPL2)},
+		{file: "a.go", license: singleLicense(GPL3)},
+		{file: "c.go", license: singleLicense(MIT)},
+		{file: "d.go", license: singleLicense(UNKNOWN)},
+		{file: "e.go", license: LicenseExpression{Licenses: []LicenseType{GPL3, MIT}, Operator: "OR"}},
+		{file: "f.go", license: LicenseExpression{Licenses: []LicenseType{MIT, GPL3}, Operator: "AND"}},
+	})
+	ok(t, err)
+	equals(t, 3, len(conflicts))
+	equals(t, "a.go", conflicts[0].file)
+	equals(t, "b.go", conflicts[1].file)
+	equals(t, "f.go", conflicts[2].file)
+
+	_, err = Compat(UNKNOWN, nil)
+	assert(t, err != nil, "Unknown project license should fail")
+}
@@ -0,0 +1,72 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Name of the project configuration file.
+const ProjectConfigFile = ".licentia.yml"
+
+// Project wide settings, usually read from .licentia.yml at the root of the
+// project.
+type ProjectConfig struct {
+	// License type or expression and copyright owner of the project, ex:
+	// mpl2 or apache2 OR mit.
+	License string `yaml:"license,omitempty"`
+	Owner   string `yaml:"owner,omitempty"`
+	Policy  Policy `yaml:"policy"`
+}
+
+// Reads the project configuration from filename.
+func LoadProjectConfig(filename string) (*ProjectConfig, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	config := new(ProjectConfig)
+	if err := yaml.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("%s: %v", filename, err)
+	}
+
+	if err := config.Policy.validate(); err != nil {
+		return nil, fmt.Errorf("%s: %v", filename, err)
+	}
+	return config, nil
+}
+
+// Writes a project configuration for a project under expr whose policy
+// allows its own licenses. An existing file is only overwritten when force
+// is set.
+func SeedProjectConfig(filename string, expr LicenseExpression, owner string, force bool) error {
+	if _, err := os.Stat(filename); err == nil && !force {
+		return fmt.Errorf("%s already exists, use --force to overwrite it", filename)
+	}
+
+	config := &ProjectConfig{License: expr.String(), Owner: owner}
+	for _, ltype := range expr.Licenses {
+		if id := spdxID(ltype); id != "" {
+			config.Policy.Allow = append(config.Policy.Allow, id)
+		}
+	}
+
+	buf := bytes.NewBuffer(nil)
+	enc := yaml.NewEncoder(buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(config); err != nil {
+		return err
+	}
+	if err := enc.Close(); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filename, buf.Bytes(), 0644)
+}
@@ -0,0 +1,259 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"go/build"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+)
+
+// A third-party dependency and the license it was found to be under.
+type dependency struct {
+	ecosystem string
+	name      string
+	version   string
+	// License as declared in the package metadata, if any.
+	declared string
+	license  LicenseType
+	// Why the license is unknown.
+	reason string
+	// Directory the license files of the dependency are looked up in.
+	dir string
+}
+
+// Returns the SPDX license expression of the dependency.
+func (d dependency) spdx() string {
+	if expr, err := parseSPDXExpression(d.declared); err == nil && !expr.isLicense() {
+		return d.declared
+	}
+	if id := spdxID(d.license); id != "" {
+		return id
+	}
+	if d.declared != "" && !isAmbiguousLicense(d.declared) {
+		return d.declared
+	}
+	return "NOASSERTION"
+}
+
+// Returned by ScanDependencies for projects without any dependency manifest.
+var errNoManifests = errors.New("no dependency manifests found")
+
+// Scans the dependencies of the project in dir for every supported package
+// manager: Go modules, npm, Python and Cargo. Python packages are looked up
+// in sitePackages, or in the usual virtualenv locations within dir if empty.
+func ScanDependencies(dir, sitePackages string) ([]dependency, error) {
+	var deps []dependency
+	found := false
+
+	scanners := []struct {
+		manifest string
+		scan     func(dir string) ([]dependency, error)
+	}{
+		{"go.mod", ScanGoModules},
+		{"package-lock.json", ScanNpmPackages},
+		{"Cargo.lock", ScanCargoPackages},
+	}
+	for _, s := range scanners {
+		if _, err := os.Stat(filepath.Join(dir, s.manifest)); err != nil {
+			continue
+		}
+		found = true
+
+		d, err := s.scan(dir)
+		if err != nil {
+			return deps, fmt.Errorf("%s: %v", s.manifest, err)
+		}
+		deps = append(deps, d...)
+	}
+
+	if sitePackages == "" {
+		sitePackages = findSitePackages(dir)
+	}
+	if sitePackages != "" {
+		found = true
+
+		d, err := ScanPythonPackages(sitePackages)
+		if err != nil {
+			return deps, err
+		}
+		deps = append(deps, d...)
+	}
+
+	if !found {
+		return nil, fmt.Errorf("%w in %s", errNoManifests, dir)
+	}
+
+	sort.SliceStable(deps, func(i, j int) bool {
+		return deps[i].ecosystem < deps[j].ecosystem
+	})
+	return deps, nil
+}
+
+// Classifies a dependency using its declared license, falling back to the
+// license files found in dir.
+func classifyDependency(dep *dependency, declared, dir string) {
+	dep.dir = dir
+	dep.declared = strings.TrimSpace(declared)
+	id := dep.declared
+	if expr, err := parseSPDXExpression(id); err == nil && expr.isLicense() {
+		id = expr.License
+	}
+	if dep.license = spdxLicenseType(id); dep.license != UNKNOWN {
+		return
+	}
+
+	dep.license, dep.reason = dirLicense(dir)
+	if dep.license == UNKNOWN && isAmbiguousLicense(dep.declared) {
+		dep.reason = fmt.Sprintf("ambiguous license %q", dep.declared)
+	} else if dep.license == UNKNOWN && dep.declared != "" {
+		dep.reason = fmt.Sprintf("unsupported license %q", dep.declared)
+	}
+}
+
+// Scans the licenses of the Go modules required by the go.mod file of the
+// module in dir, which lists every module of the build since Go 1.17.
+// Modules are resolved from the vendor directory or the local module cache,
+// the network is never used.
+func ScanGoModules(dir string) ([]dependency, error) {
+	filename := filepath.Join(dir, "go.mod")
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := modfile.Parse(filename, data, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// A module required more than once is built at its highest version.
+	versions := make(map[string]string, len(f.Require))
+	for _, r := range f.Require {
+		if v, ok := versions[r.Mod.Path]; !ok || semver.Compare(v, r.Mod.Version) < 0 {
+			versions[r.Mod.Path] = r.Mod.Version
+		}
+	}
+
+	deps := make([]dependency, 0, len(versions))
+	for path, version := range versions {
+		dep := dependency{ecosystem: "go", name: path, version: version}
+
+		moddir := filepath.Join(dir, "vendor", filepath.FromSlash(path))
+		if _, err := os.Stat(moddir); err != nil {
+			target := replacement(f, module.Version{Path: path, Version: version})
+			if modfile.IsDirectoryPath(target.Path) {
+				moddir = filepath.Join(dir, filepath.FromSlash(target.Path))
+			} else if moddir, err = moduleCacheDir(target); err != nil {
+				return nil, err
+			}
+		}
+
+		classifyDependency(&dep, "", moddir)
+		deps = append(deps, dep)
+	}
+
+	sort.Slice(deps, func(i, j int) bool {
+		return deps[i].name < deps[j].name
+	})
+	return deps, nil
+}
+
+// Returns the module mod is replaced with in f, or mod itself. Replacements
+// of a specific version take precedence over the ones of every version.
+func replacement(f *modfile.File, mod module.Version) module.Version {
+	target := mod
+	for _, r := range f.Replace {
+		if r.Old.Path != mod.Path {
+			continue
+		}
+		if r.Old.Version == mod.Version {
+			return r.New
+		}
+		if r.Old.Version == "" {
+			target = r.New
+		}
+	}
+	return target
+}
+
+// Returns the license of the license file found in dir.
+func dirLicense(dir string) (LicenseType, string) {
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return UNKNOWN, "not found locally"
+		}
+		return UNKNOWN, err.Error()
+	}
+
+	var unrecognized string
+	for _, fi := range fis {
+		if fi.IsDir() || !isLicenseFile(fi.Name()) {
+			continue
+		}
+
+		data, err := ioutil.ReadFile(filepath.Join(dir, fi.Name()))
+		if err != nil {
+			unrecognized = fmt.Sprintf("%s: %v", fi.Name(), err)
+			continue
+		}
+
+		ltype, err := guessLicenseType(string(data))
+		if err != nil {
+			unrecognized = fmt.Sprintf("%s: %v", fi.Name(), err)
+			continue
+		}
+		return ltype, ""
+	}
+
+	if unrecognized != "" {
+		return UNKNOWN, unrecognized
+	}
+	return UNKNOWN, "no license file found"
+}
+
+// Tells whether name is a conventional license file name, such as LICENSE,
+// COPYING.md or LICENSE-MIT.
+func isLicenseFile(name string) bool {
+	name = strings.ToLower(name)
+	for _, prefix := range []string{"license", "licence", "copying", "unlicense"} {
+		if name == prefix || strings.HasPrefix(name, prefix+".") || strings.HasPrefix(name, prefix+"-") {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the directory where mod is extracted in the module cache.
+func moduleCacheDir(mod module.Version) (string, error) {
+	root := os.Getenv("GOMODCACHE")
+	if root == "" {
+		gopath := filepath.SplitList(build.Default.GOPATH)
+		if len(gopath) == 0 {
+			return "", fmt.Errorf("unable to locate the module cache: GOPATH is not set")
+		}
+		root = filepath.Join(gopath[0], "pkg", "mod")
+	}
+
+	path, err := module.EscapePath(mod.Path)
+	if err != nil {
+		return "", err
+	}
+	version, err := module.EscapeVersion(mod.Version)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(root, filepath.FromSlash(path)+"@"+version), nil
+}
@@ -0,0 +1,126 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Scans the licenses of the crates locked in dir/Cargo.lock. Crates are read
+// from the vendor directory or the local registry sources, the network is
+// never used.
+func ScanCargoPackages(dir string) ([]dependency, error) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, "Cargo.lock"))
+	if err != nil {
+		return nil, err
+	}
+
+	registries, err := cargoRegistries()
+	if err != nil {
+		return nil, err
+	}
+
+	var deps []dependency
+	for _, pkg := range parseTOMLTables(data, "package") {
+		if pkg["source"] == "" {
+			// Members of the workspace.
+			continue
+		}
+
+		dep := dependency{ecosystem: "cargo", name: pkg["name"], version: pkg["version"]}
+
+		// "cargo vendor" only appends the version when a crate is vendored
+		// more than once.
+		candidates := []string{
+			filepath.Join(dir, "vendor", pkg["name"]+"-"+pkg["version"]),
+			filepath.Join(dir, "vendor", pkg["name"]),
+		}
+		for _, registry := range registries {
+			candidates = append(candidates, filepath.Join(registry, pkg["name"]+"-"+pkg["version"]))
+		}
+
+		var declared string
+		cratedir := candidates[0]
+		for _, candidate := range candidates {
+			manifest, err := ioutil.ReadFile(filepath.Join(candidate, "Cargo.toml"))
+			if err != nil {
+				continue
+			}
+
+			tables := parseTOMLTables(manifest, "package")
+			if len(tables) == 0 || tables[0]["version"] != pkg["version"] {
+				continue
+			}
+			cratedir, declared = candidate, tables[0]["license"]
+			break
+		}
+
+		classifyDependency(&dep, declared, cratedir)
+		deps = append(deps, dep)
+	}
+	return deps, nil
+}
+
+// Returns the directories where Cargo extracts the sources of the crates
+// downloaded from each registry.
+func cargoRegistries() ([]string, error) {
+	home := os.Getenv("CARGO_HOME")
+	if home == "" {
+		userHome, err := os.UserHomeDir()
+		if err != nil {
+			return nil, err
+		}
+		home = filepath.Join(userHome, ".cargo")
+	}
+	return filepath.Glob(filepath.Join(home, "registry", "src", "*"))
+}
+
+// Returns the string keys of every table, or array of tables, with the given
+// name in a TOML document. Cargo files are simple enough to not need a full
+// TOML parser.
+func parseTOMLTables(data []byte, name string) []map[string]string {
+	var tables []map[string]string
+	var table map[string]string
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "[") {
+			table = nil
+			header := strings.Trim(line, "[] ")
+			if header == name {
+				table = make(map[string]string)
+				tables = append(tables, table)
+			}
+			continue
+		}
+
+		if table == nil {
+			continue
+		}
+
+		i := strings.Index(line, "=")
+		if i < 0 {
+			continue
+		}
+		key := strings.TrimSpace(line[:i])
+		value := strings.TrimSpace(line[i+1:])
+		if len(value) >= 2 && value[0] == '\'' && value[len(value)-1] == '\'' {
+			// Literal string.
+			table[key] = value[1 : len(value)-1]
+			continue
+		}
+		if value, err := strconv.Unquote(value); err == nil {
+			table[key] = value
+		}
+	}
+	return tables
+}
@@ -0,0 +1,136 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+type npmLockfile struct {
+	// Lockfile version 2 and later.
+	Packages map[string]npmLockPackage `json:"packages"`
+	// Lockfile version 1.
+	Dependencies map[string]npmLockPackage `json:"dependencies"`
+}
+
+type npmLockPackage struct {
+	Version      string                    `json:"version"`
+	License      npmLicense                `json:"license"`
+	Link         bool                      `json:"link"`
+	Dependencies map[string]npmLockPackage `json:"dependencies"`
+}
+
+type npmPackageJSON struct {
+	Name     string       `json:"name"`
+	Version  string       `json:"version"`
+	License  npmLicense   `json:"license"`
+	Licenses []npmLicense `json:"licenses"`
+}
+
+// License field of npm packages. It is usually an SPDX expression, although
+// older packages use an object with a type.
+type npmLicense string
+
+func (l *npmLicense) UnmarshalJSON(data []byte) error {
+	var expr string
+	if err := json.Unmarshal(data, &expr); err == nil {
+		*l = npmLicense(expr)
+		return nil
+	}
+
+	var obj struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+	*l = npmLicense(obj.Type)
+	return nil
+}
+
+// Scans the licenses of the npm packages locked in dir/package-lock.json.
+// Packages are read from node_modules, the network is never used.
+func ScanNpmPackages(dir string) ([]dependency, error) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, "package-lock.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	var lock npmLockfile
+	if err := json.Unmarshal(data, &lock); err != nil {
+		return nil, err
+	}
+
+	// Locations of packages within the project, relative to dir.
+	locations := make(map[string]npmLockPackage)
+	if lock.Packages != nil {
+		for location, pkg := range lock.Packages {
+			if !strings.Contains(location, "node_modules/") || pkg.Link {
+				// The project itself, its workspaces and the links to them.
+				continue
+			}
+			locations[location] = pkg
+		}
+	} else {
+		npmLocations(locations, "", lock.Dependencies)
+	}
+
+	deps := make([]dependency, 0, len(locations))
+	seen := make(map[string]bool, len(locations))
+	for location, pkg := range locations {
+		name := location[strings.LastIndex(location, "node_modules/")+len("node_modules/"):]
+		if seen[name+"@"+pkg.Version] {
+			// Installed more than once in the tree.
+			continue
+		}
+		seen[name+"@"+pkg.Version] = true
+
+		dep := dependency{ecosystem: "npm", name: name, version: pkg.Version}
+
+		pkgdir := filepath.Join(dir, filepath.FromSlash(location))
+		declared := string(pkg.License)
+
+		var pkgjson npmPackageJSON
+		if data, err := ioutil.ReadFile(filepath.Join(pkgdir, "package.json")); err == nil {
+			if err := json.Unmarshal(data, &pkgjson); err == nil {
+				if pkgjson.License != "" {
+					declared = string(pkgjson.License)
+				} else if len(pkgjson.Licenses) > 0 {
+					ids := make([]string, len(pkgjson.Licenses))
+					for i, l := range pkgjson.Licenses {
+						ids[i] = string(l)
+					}
+					declared = strings.Join(ids, " OR ")
+				}
+			}
+		}
+
+		classifyDependency(&dep, declared, pkgdir)
+		deps = append(deps, dep)
+	}
+
+	sort.Slice(deps, func(i, j int) bool {
+		if deps[i].name == deps[j].name {
+			return deps[i].version < deps[j].version
+		}
+		return deps[i].name < deps[j].name
+	})
+	return deps, nil
+}
+
+// Flattens the nested dependencies of a version 1 lockfile into the
+// node_modules locations they are installed at.
+func npmLocations(locations map[string]npmLockPackage, parent string, deps map[string]npmLockPackage) {
+	for name, pkg := range deps {
+		location := path.Join(parent, "node_modules", name)
+		locations[location] = pkg
+		npmLocations(locations, location, pkg.Dependencies)
+	}
+}
@@ -0,0 +1,149 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// SPDX identifiers of the license trove classifiers, without the
+// "License :: OSI Approved ::" prefix, in lower case.
+var pythonClassifiers = map[string]string{
+	"apache software license":                                    "Apache-2.0",
+	"common development and distribution license 1.0 (cddl-1.0)": "CDDL-1.0",
+	"eclipse public license 1.0 (epl-1.0)":                       "EPL-1.0",
+	"gnu general public license v2 (gplv2)":                      "GPL-2.0-only",
+	"gnu general public license v2 or later (gplv2+)":            "GPL-2.0-or-later",
+	"gnu general public license v3 (gplv3)":                      "GPL-3.0-only",
+	"gnu general public license v3 or later (gplv3+)":            "GPL-3.0-or-later",
+	// LGPL 2.0, which predates the LGPL 2.1 licentia supports.
+	"gnu lesser general public license v2 (lgplv2)":           "LGPL-2.0-only",
+	"gnu lesser general public license v2 or later (lgplv2+)": "LGPL-2.0-or-later",
+	"gnu lesser general public license v3 (lgplv3)":           "LGPL-3.0-only",
+	"gnu lesser general public license v3 or later (lgplv3+)": "LGPL-3.0-or-later",
+	"mit license":                          "MIT",
+	"mozilla public license 2.0 (mpl 2.0)": "MPL-2.0",
+	"the unlicense (unlicense)":            "Unlicense",
+}
+
+// Scans the licenses of the Python distributions installed in sitePackages,
+// as described by their *.dist-info metadata.
+func ScanPythonPackages(sitePackages string) ([]dependency, error) {
+	distInfos, err := filepath.Glob(filepath.Join(sitePackages, "*.dist-info"))
+	if err != nil {
+		return nil, err
+	}
+
+	deps := make([]dependency, 0, len(distInfos))
+	for _, distInfo := range distInfos {
+		data, err := ioutil.ReadFile(filepath.Join(distInfo, "METADATA"))
+		if err != nil {
+			// The directory is named after the distribution and its version.
+			name := strings.TrimSuffix(filepath.Base(distInfo), ".dist-info")
+			dep := dependency{ecosystem: "python", name: name, license: UNKNOWN, dir: distInfo, reason: err.Error()}
+			if i := strings.LastIndex(name, "-"); i > 0 {
+				dep.name, dep.version = name[:i], name[i+1:]
+			}
+			if os.IsNotExist(err) {
+				dep.reason = "no METADATA file found"
+			}
+			deps = append(deps, dep)
+			continue
+		}
+
+		meta := parsePythonMetadata(data)
+		dep := dependency{ecosystem: "python", name: meta["name"][0], version: meta["version"][0]}
+
+		declared := meta["license-expression"][0]
+		if declared == "" && !strings.Contains(meta["license"][0], "\n") {
+			// Some distributions paste the whole license text here.
+			declared = meta["license"][0]
+		}
+		if spdxLicenseType(declared) == UNKNOWN {
+			for _, classifier := range meta["classifier"] {
+				classifier = strings.ToLower(strings.TrimPrefix(classifier, "License :: OSI Approved :: "))
+				if id, ok := pythonClassifiers[classifier]; ok {
+					declared = id
+					break
+				}
+			}
+		}
+
+		// Newer metadata versions keep license files in a subdirectory.
+		licenses := distInfo
+		if _, reason := dirLicense(distInfo); reason == "no license file found" {
+			licenses = filepath.Join(distInfo, "licenses")
+		}
+
+		classifyDependency(&dep, declared, licenses)
+		deps = append(deps, dep)
+	}
+
+	sort.Slice(deps, func(i, j int) bool {
+		return strings.ToLower(deps[i].name) < strings.ToLower(deps[j].name)
+	})
+	return deps, nil
+}
+
+// Parses the headers of a METADATA file into lower case keys. Every key has
+// at least one, possibly empty, value.
+func parsePythonMetadata(data []byte) map[string][]string {
+	meta := map[string][]string{
+		"name":               {""},
+		"version":            {""},
+		"license":            {""},
+		"license-expression": {""},
+	}
+
+	var key string
+	seen := make(map[string]bool)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			// The description follows the headers.
+			break
+		}
+
+		if key != "" && (line[0] == ' ' || line[0] == '\t') {
+			// Continuation line.
+			values := meta[key]
+			values[len(values)-1] += "\n" + strings.TrimSpace(line)
+			continue
+		}
+
+		i := strings.Index(line, ":")
+		if i < 0 {
+			continue
+		}
+		key = strings.ToLower(line[:i])
+		value := strings.TrimSpace(line[i+1:])
+		if !seen[key] {
+			seen[key] = true
+			meta[key] = nil
+		}
+		meta[key] = append(meta[key], value)
+	}
+	return meta
+}
+
+// Returns the site-packages directory of a virtualenv within dir, if any.
+func findSitePackages(dir string) string {
+	for _, venv := range []string{".venv", "venv", "env"} {
+		for _, pattern := range []string{"lib/python*/site-packages", "Lib/site-packages"} {
+			matches, _ := filepath.Glob(filepath.Join(dir, venv, filepath.FromSlash(pattern)))
+			if len(matches) > 0 {
+				return matches[0]
+			}
+		}
+	}
+	return ""
+}
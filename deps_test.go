@@ -0,0 +1,214 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestScanGoModules(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "licentia-tests-")
+	ok(t, err)
+	defer os.RemoveAll(dir)
+
+	cache := filepath.Join(dir, "cache")
+	project := filepath.Join(dir, "project")
+
+	mit, err := ioutil.ReadFile("licenses/mit")
+	ok(t, err)
+	apache2, err := ioutil.ReadFile("licenses/apache2")
+	ok(t, err)
+
+	files := map[string][]byte{
+		"cache/github.com/!big!corp/lib@v1.2.0/LICENSE":   mit,
+		"cache/example.com/indirect@v0.2.0/COPYING":       apache2,
+		"cache/example.com/rc@v1.0.0-rc.10/LICENSE":       mit,
+		"cache/example.com/nolicense@v1.0.0/main.go":      []byte("package nolicense\n"),
+		"project/vendor/example.com/vendored/LICENSE.txt": apache2,
+		"project/local/LICENSE":                           mit,
+		"project/go.mod": []byte(`module example.com/project
+
+go 1.13
+
+require (
+	github.com/BigCorp/lib v1.2.0
+	example.com/nolicense v1.0.0 // indirect
+	example.com/vendored v1.0.0
+	example.com/missing v1.0.0
+	example.com/rc v1.0.0-rc.9
+)
+
+require (
+	example.com/replaced v1.0.0
+	example.com/indirect v0.2.0 // indirect
+	example.com/rc v1.0.0-rc.10
+)
+
+replace example.com/replaced => ./local
+
+replace example.com/missing v0.9.0 => ./local
+`),
+		// Modules outside the build are listed in go.sum too.
+		"project/go.sum": []byte(`example.com/indirect v0.2.0 h1:abc=
+example.com/indirect v0.2.0/go.mod h1:abc=
+example.com/unused v0.1.0 h1:abc=
+example.com/unused v0.1.0/go.mod h1:abc=
+`),
+	}
+	for name, data := range files {
+		name = filepath.Join(dir, filepath.FromSlash(name))
+		ok(t, os.MkdirAll(filepath.Dir(name), 0750))
+		ok(t, ioutil.WriteFile(name, data, 0640))
+	}
+
+	defer os.Setenv("GOMODCACHE", os.Getenv("GOMODCACHE"))
+	os.Setenv("GOMODCACHE", cache)
+
+	deps, err := ScanGoModules(project)
+	ok(t, err)
+
+	equals(t, []dependency{
+		{ecosystem: "go", name: "example.com/indirect", version: "v0.2.0", license: Apache2,
+			dir: filepath.Join(cache, "example.com", "indirect@v0.2.0")},
+		{ecosystem: "go", name: "example.com/missing", version: "v1.0.0", license: UNKNOWN, reason: "not found locally",
+			dir: filepath.Join(cache, "example.com", "missing@v1.0.0")},
+		{ecosystem: "go", name: "example.com/nolicense", version: "v1.0.0", license: UNKNOWN, reason: "no license file found",
+			dir: filepath.Join(cache, "example.com", "nolicense@v1.0.0")},
+		{ecosystem: "go", name: "example.com/rc", version: "v1.0.0-rc.10", license: MIT,
+			dir: filepath.Join(cache, "example.com", "rc@v1.0.0-rc.10")},
+		{ecosystem: "go", name: "example.com/replaced", version: "v1.0.0", license: MIT,
+			dir: filepath.Join(project, "local")},
+		{ecosystem: "go", name: "example.com/vendored", version: "v1.0.0", license: Apache2,
+			dir: filepath.Join(project, "vendor", "example.com", "vendored")},
+		{ecosystem: "go", name: "github.com/BigCorp/lib", version: "v1.2.0", license: MIT,
+			dir: filepath.Join(cache, "github.com", "!big!corp", "lib@v1.2.0")},
+	}, deps)
+}
+
+func TestScanNpmWorkspaces(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "licentia-tests-")
+	ok(t, err)
+	defer os.RemoveAll(dir)
+
+	ok(t, ioutil.WriteFile(filepath.Join(dir, "package-lock.json"), []byte(`{
+  "lockfileVersion": 3,
+  "packages": {
+    "": {"name": "project", "workspaces": ["packages/*"]},
+    "node_modules/a": {"resolved": "packages/a", "link": true},
+    "node_modules/ms": {"version": "2.1.3", "license": "MIT"},
+    "packages/a": {"name": "a", "version": "1.0.0", "license": "ISC"},
+    "packages/a/node_modules/ms": {"version": "2.0.0", "license": "MIT"}
+  }
+}`), 0640))
+
+	deps, err := ScanNpmPackages(dir)
+	ok(t, err)
+
+	var report []string
+	for _, dep := range deps {
+		report = append(report, dep.name+"@"+dep.version+" "+dep.spdx())
+	}
+	equals(t, []string{"ms@2.0.0 MIT", "ms@2.1.3 MIT"}, report)
+}
+
+func TestScanDependencies(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "licentia-tests-")
+	ok(t, err)
+	defer os.RemoveAll(dir)
+
+	mit, err := ioutil.ReadFile("licenses/mit")
+	ok(t, err)
+
+	files := map[string][]byte{
+		"project/package-lock.json": []byte(`{
+  "lockfileVersion": 3,
+  "packages": {
+    "": {"name": "project"},
+    "node_modules/left-pad": {"version": "1.3.0", "license": "WTFPL"},
+    "node_modules/@scope/dual": {"version": "2.0.0"},
+    "node_modules/old": {"version": "0.1.0"},
+    "node_modules/gpl": {"version": "1.0.0", "license": "GPL-2.0"},
+    "node_modules/bsd": {"version": "1.0.0", "license": "BSD"}
+  }
+}`),
+		"project/node_modules/@scope/dual/package.json": []byte(`{"name": "@scope/dual", "license": "(MIT OR Apache-2.0)"}`),
+		"project/node_modules/old/package.json":         []byte(`{"name": "old", "license": {"type": "MIT"}}`),
+		"project/.venv/lib/python3.11/site-packages/requests-2.31.0.dist-info/METADATA": []byte(`Metadata-Version: 2.1
+Name: requests
+Version: 2.31.0
+License: Apache 2.0
+Classifier: License :: OSI Approved :: Apache Software License
+
+Requests is an HTTP library.
+`),
+		"project/.venv/lib/python3.11/site-packages/six-1.16.0.dist-info/METADATA": []byte(`Metadata-Version: 2.1
+Name: six
+Version: 1.16.0
+Classifier: License :: OSI Approved :: MIT License
+`),
+		"project/.venv/lib/python3.11/site-packages/unlicensed-1.0.dist-info/METADATA": []byte(`Metadata-Version: 2.4
+Name: unlicensed
+Version: 1.0
+`),
+		"project/.venv/lib/python3.11/site-packages/unlicensed-1.0.dist-info/licenses/LICENSE": mit,
+		"project/.venv/lib/python3.11/site-packages/broken-0.1.dist-info/RECORD":               []byte(""),
+		"project/.venv/lib/python3.11/site-packages/lgpl-2.0.dist-info/METADATA": []byte(`Metadata-Version: 2.1
+Name: lgpl
+Version: 2.0
+Classifier: License :: OSI Approved :: GNU Lesser General Public License v2 (LGPLv2)
+`),
+		"project/Cargo.lock": []byte(`version = 3
+
+[[package]]
+name = "project"
+version = "0.1.0"
+
+[[package]]
+name = "serde"
+version = "1.0.0"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+`),
+		"cargo/registry/src/index.crates.io-6f17d22bba15001f/serde-1.0.0/Cargo.toml": []byte(`[package]
+name = "serde"
+version = "1.0.0"
+license = "MIT OR Apache-2.0"
+`),
+	}
+	for name, data := range files {
+		name = filepath.Join(dir, filepath.FromSlash(name))
+		ok(t, os.MkdirAll(filepath.Dir(name), 0750))
+		ok(t, ioutil.WriteFile(name, data, 0640))
+	}
+
+	defer os.Setenv("CARGO_HOME", os.Getenv("CARGO_HOME"))
+	os.Setenv("CARGO_HOME", filepath.Join(dir, "cargo"))
+
+	deps, err := ScanDependencies(filepath.Join(dir, "project"), "")
+	ok(t, err)
+
+	var report []string
+	for _, dep := range deps {
+		report = append(report, dep.ecosystem+" "+dep.name+"@"+dep.version+" "+string(dep.license)+" "+dep.spdx())
+		if dep.name == "broken" {
+			equals(t, "no METADATA file found", dep.reason)
+		}
+	}
+	equals(t, []string{
+		"cargo serde@1.0.0 unknown MIT OR Apache-2.0",
+		"npm @scope/dual@2.0.0 unknown (MIT OR Apache-2.0)",
+		"npm bsd@1.0.0 unknown NOASSERTION",
+		"npm gpl@1.0.0 gpl2-only GPL-2.0-only",
+		"npm left-pad@1.3.0 unknown WTFPL",
+		"npm old@0.1.0 mit MIT",
+		"python broken@0.1 unknown NOASSERTION",
+		"python lgpl@2.0 unknown LGPL-2.0-only",
+		"python requests@2.31.0 apache2 Apache-2.0",
+		"python six@1.16.0 mit MIT",
+		"python unlicensed@1.0 mit MIT",
+	}, report)
+}
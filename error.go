@@ -4,9 +4,13 @@
 
 package main
 
-import "fmt"
+import (
+	"fmt"
+	"sync"
+)
 
 type Error struct {
+	mu     sync.Mutex
 	errors []error
 }
 
@@ -21,6 +25,8 @@ func (e *Error) Error() string {
 }
 
 func (e *Error) Append(err ...error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
 	e.errors = append(e.errors, err...)
 }
 
@@ -0,0 +1,127 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Combination of licenses, ex: apache2 OR mit. Only expressions using a
+// single operator are supported.
+type LicenseExpression struct {
+	Licenses []LicenseType
+	// OR when the licenses are alternatives, AND when all of them apply.
+	// Empty for a single license.
+	Operator string
+}
+
+// Returns an expression made of ltype alone, or an empty one if ltype is
+// UNKNOWN.
+func singleLicense(ltype LicenseType) LicenseExpression {
+	if ltype == UNKNOWN {
+		return LicenseExpression{}
+	}
+	return LicenseExpression{Licenses: []LicenseType{ltype}}
+}
+
+// Parses a license expression made of license types or SPDX identifiers, ex:
+// "apache2 OR mit" or "Apache-2.0 OR MIT".
+func ParseLicenseExpression(s string) (LicenseExpression, error) {
+	var expr LicenseExpression
+
+	parsed, err := parseSPDXExpression(s)
+	if err != nil {
+		return expr, err
+	}
+
+	operands := []spdxExpression{parsed}
+	if parsed.Operator != "" {
+		operands = parsed.Operands
+		expr.Operator = parsed.Operator
+	}
+
+	for _, operand := range operands {
+		if !operand.isLicense() {
+			return expr, fmt.Errorf("unsupported license expression %q", s)
+		}
+
+		ltype, err := ParseLicenseType(operand.License)
+		if err != nil {
+			return expr, err
+		}
+		expr.Licenses = append(expr.Licenses, ltype)
+	}
+	return expr, nil
+}
+
+// Tells whether no license is known.
+func (e LicenseExpression) IsUnknown() bool {
+	return len(e.Licenses) == 0
+}
+
+// Returns the expression using license types, ex: apache2 OR mit.
+func (e LicenseExpression) String() string {
+	if e.IsUnknown() {
+		return string(UNKNOWN)
+	}
+
+	types := make([]string, len(e.Licenses))
+	for i, ltype := range e.Licenses {
+		types[i] = string(ltype)
+	}
+	return strings.Join(types, " "+e.Operator+" ")
+}
+
+// Returns the SPDX license expression, or an empty string if a license has
+// no SPDX identifier.
+func (e LicenseExpression) SPDX() string {
+	ids := make([]string, len(e.Licenses))
+	for i, ltype := range e.Licenses {
+		if ids[i] = spdxID(ltype); ids[i] == "" {
+			return ""
+		}
+	}
+	return strings.Join(ids, " "+e.Operator+" ")
+}
+
+// Renders the license of every license type in expr, by the file name it
+// is conventionally written to, ex: LICENSE-APACHE and LICENSE-MIT.
+func DumpExpression(expr LicenseExpression, owner string) (map[string]string, error) {
+	licenses := make(map[string]string, len(expr.Licenses))
+	for _, ltype := range expr.Licenses {
+		text, err := Dump(ltype, owner)
+		if err != nil {
+			return nil, err
+		}
+
+		name := licenseFileName(ltype)
+		if _, ok := licenses[name]; ok {
+			return nil, fmt.Errorf("more than one license would be written to %s", name)
+		}
+		licenses[name] = text
+	}
+	return licenses, nil
+}
+
+// Conventional suffixes of the license files of a project under several
+// licenses. Other licenses are named by their SPDX identifier.
+var licenseFileSuffixes = map[LicenseType]string{
+	Apache2: "APACHE",
+	MIT:     "MIT",
+}
+
+// Returns the name of the license file of ltype in a project under several
+// licenses, ex: LICENSE-APACHE or LICENSE-GPL-3.0-or-later.
+func licenseFileName(ltype LicenseType) string {
+	name, ok := licenseFileSuffixes[ltype]
+	if !ok {
+		name = spdxID(ltype)
+	}
+	if name == "" {
+		name = string(ltype)
+	}
+	return DefaultLicenseFile + "-" + name
+}
@@ -0,0 +1,134 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseLicenseExpression(t *testing.T) {
+	tests := []struct {
+		expr     string
+		licenses []LicenseType
+		operator string
+		spdx     string
+	}{
+		{"mpl2", []LicenseType{MPL2}, "", "MPL-2.0"},
+		{"upl", []LicenseType{UPL}, "", "UPL-1.0"},
+		{"apache2 OR mit", []LicenseType{Apache2, MIT}, "OR", "Apache-2.0 OR MIT"},
+		{"(Apache-2.0 or MIT)", []LicenseType{Apache2, MIT}, "OR", "Apache-2.0 OR MIT"},
+		{"mit AND BSD-3-Clause", []LicenseType{MIT, NewBSD}, "AND", "MIT AND BSD-3-Clause"},
+	}
+
+	for _, tt := range tests {
+		expr, err := ParseLicenseExpression(tt.expr)
+		ok(t, err)
+		equals(t, tt.licenses, expr.Licenses)
+		equals(t, tt.operator, expr.Operator)
+		equals(t, tt.spdx, expr.SPDX())
+	}
+
+	for _, invalid := range []string{"", "mit OR apache2 AND gpl3", "mit OR foo", "(mit OR gpl3) AND apache2"} {
+		_, err := ParseLicenseExpression(invalid)
+		assert(t, err != nil, "%q should not be accepted", invalid)
+	}
+}
+
+func TestParseSPDXExpression(t *testing.T) {
+	expr, err := parseSPDXExpression("MIT AND (GPL-2.0-only WITH Classpath-exception-2.0 or LicenseRef-Custom) AND Zlib")
+	ok(t, err)
+	equals(t, spdxExpression{Operator: "AND", Operands: []spdxExpression{
+		{License: "MIT"},
+		{Operator: "OR", Operands: []spdxExpression{
+			{License: "GPL-2.0-only", Exception: "Classpath-exception-2.0"},
+			{License: "LicenseRef-Custom"},
+		}},
+		{License: "Zlib"},
+	}}, expr)
+	equals(t, []string{"MIT", "GPL-2.0-only", "Classpath-exception-2.0", "LicenseRef-Custom", "Zlib"}, expr.IDs())
+
+	// AND takes precedence over OR.
+	expr, err = parseSPDXExpression("MIT OR Apache-2.0 AND Zlib")
+	ok(t, err)
+	equals(t, "OR", expr.Operator)
+	equals(t, "AND", expr.Operands[1].Operator)
+
+	for _, invalid := range []string{"", "MIT OR", "(MIT", "MIT)", "MIT Apache-2.0", "MIT WITH", "WITH MIT", "MIT/X11"} {
+		_, err := parseSPDXExpression(invalid)
+		assert(t, err != nil, "%q should not be accepted", invalid)
+	}
+}
+
+func TestSetUnsetExpression(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "licentia-tests-")
+	ok(t, err)
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "main.go")
+	ok(t, ioutil.WriteFile(filename, []byte("package main\n"), 0640))
+
+	config := &Config{
+		CopyrightOwner:  "Test",
+		EOLCommentStyle: "//",
+		Files:           []string{filename},
+	}
+	ok(t, config.setLicense("apache2 OR mit"))
+	equals(t, Apache2, config.LicenseType)
+
+	_, err = Set(config)
+	ok(t, err)
+
+	data, err := ioutil.ReadFile(filename)
+	ok(t, err)
+	equals(t, "// Copyright "+CopyrightHolder{}.years()+" Test\n//\n// SPDX-License-Identifier: Apache-2.0 OR MIT\n\npackage main\n", string(data))
+
+	licenses, err := Detect(config)
+	ok(t, err)
+	equals(t, "apache2 OR mit", licenses[0].license.String())
+
+	_, err = Unset(config)
+	ok(t, err)
+
+	data, err = ioutil.ReadFile(filename)
+	ok(t, err)
+	equals(t, "package main\n", string(data))
+}
+
+func TestWriteLicenses(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "licentia-tests-")
+	ok(t, err)
+	defer os.RemoveAll(dir)
+
+	expr, err := ParseLicenseExpression("apache2 OR mit")
+	ok(t, err)
+
+	written, err := WriteLicenses(filepath.Join(dir, DefaultLicenseFile), expr, "Test", false)
+	ok(t, err)
+	equals(t, []string{filepath.Join(dir, "LICENSE-APACHE"), filepath.Join(dir, "LICENSE-MIT")}, written)
+
+	expected, err := Dump(MIT, "Test")
+	ok(t, err)
+	data, err := ioutil.ReadFile(filepath.Join(dir, "LICENSE-MIT"))
+	ok(t, err)
+	equals(t, expected, string(data))
+
+	_, err = DumpExpression(LicenseExpression{Licenses: []LicenseType{MIT, MIT}, Operator: "AND"}, "Test")
+	assert(t, err != nil, "Licenses written to the same file should fail")
+}
+
+func TestLicenseFileNames(t *testing.T) {
+	seen := make(map[string]LicenseType)
+	for _, info := range catalog {
+		name := licenseFileName(info.Type)
+		other, dup := seen[strings.ToLower(name)]
+		assert(t, !dup, "%s and %s are both written to %s", other, info.Type, name)
+		seen[strings.ToLower(name)] = info.Type
+	}
+	equals(t, "LICENSE-GPL-2.0-only", licenseFileName(GPL2Only))
+}
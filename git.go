@@ -0,0 +1,99 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// Returns the files of the git index with staged changes, other than
+// deletions, as slash separated paths relative to dir.
+func stagedFiles(dir string) ([]string, error) {
+	return gitFiles(dir, "diff", "--cached", "--name-only", "-z", "--diff-filter=ACMR", "--relative")
+}
+
+// Returns the files added or modified since ref, a commit or branch, as
+// slash separated paths relative to dir. Changes are taken from where the
+// current branch forked from ref, so that only the changes of the branch
+// count, and include uncommitted changes and untracked files.
+func changedFiles(dir, ref string) ([]string, error) {
+	out, err := exec.Command("git", "-C", dir, "merge-base", ref, "HEAD").Output()
+	if err != nil {
+		return nil, fmt.Errorf("git merge-base %s: %v", ref, gitError(err))
+	}
+	base := strings.TrimSpace(string(out))
+
+	changed, err := gitFiles(dir, "diff", "--name-only", "-z", "--diff-filter=ACMR", "--relative", base)
+	if err != nil {
+		return nil, err
+	}
+	untracked, err := gitFiles(dir, "ls-files", "-z", "--others", "--exclude-standard")
+	if err != nil {
+		return nil, err
+	}
+	return append(changed, untracked...), nil
+}
+
+// Keeps the files changed since ref, as returned by changedFiles for the
+// current directory. Directories are replaced by the changed files they
+// contain.
+func onlyChanged(files []string, ref string) ([]string, error) {
+	changed, err := changedFiles(".", ref)
+	if err != nil {
+		return nil, err
+	}
+
+	var kept []string
+	seen := make(map[string]bool)
+	for _, file := range files {
+		file = filepath.ToSlash(filepath.Clean(file))
+		for _, c := range changed {
+			if !seen[c] && (c == file || file == "." || strings.HasPrefix(c, file+"/")) {
+				seen[c] = true
+				kept = append(kept, filepath.FromSlash(c))
+			}
+		}
+	}
+	return kept, nil
+}
+
+// Runs a git command in dir listing NUL separated files.
+func gitFiles(dir string, args ...string) ([]string, error) {
+	out, err := exec.Command("git", append([]string{"-C", dir}, args...)...).Output()
+	if err != nil {
+		return nil, fmt.Errorf("git %s: %v", args[0], gitError(err))
+	}
+
+	var files []string
+	for _, file := range strings.Split(string(out), "\x00") {
+		if file != "" {
+			files = append(files, file)
+		}
+	}
+	return files, nil
+}
+
+// Adds files to the git index.
+func stageFiles(files []string) error {
+	if len(files) == 0 {
+		return nil
+	}
+	if err := exec.Command("git", append([]string{"add", "--"}, files...)...).Run(); err != nil {
+		return fmt.Errorf("git add: %v", gitError(err))
+	}
+	return nil
+}
+
+// Returns the standard error of failed git commands, which explains the
+// failure better than the exit status.
+func gitError(err error) error {
+	if exitErr, ok := err.(*exec.ExitError); ok && len(exitErr.Stderr) > 0 {
+		return fmt.Errorf("%s", strings.TrimSpace(string(exitErr.Stderr)))
+	}
+	return err
+}
@@ -0,0 +1,46 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestOnlyChanged(t *testing.T) {
+	defer gitRepo(t)()
+
+	git := func(args ...string) {
+		cmd := exec.Command("git", append([]string{"-c", "user.name=Test", "-c", "user.email=test@example.com"}, args...)...)
+		out, err := cmd.CombinedOutput()
+		assert(t, err == nil, "git %v: %v: %s", args, err, out)
+	}
+
+	ok(t, os.Mkdir("pkg", 0750))
+	for _, name := range []string{"a.go", "b.go", filepath.Join("pkg", "c.go")} {
+		ok(t, ioutil.WriteFile(name, []byte("package main\n"), 0640))
+	}
+	git("add", ".")
+	git("commit", "-q", "-m", "base")
+	git("tag", "base")
+
+	ok(t, ioutil.WriteFile("a.go", []byte("package main\n\nfunc a() {}\n"), 0640))
+	git("commit", "-q", "-am", "change")
+	ok(t, ioutil.WriteFile(filepath.Join("pkg", "d.go"), []byte("package main\n"), 0640))
+
+	files, err := onlyChanged([]string{"a.go", "b.go", "pkg"}, "base")
+	ok(t, err)
+	equals(t, []string{"a.go", filepath.Join("pkg", "d.go")}, files)
+
+	files, err = onlyChanged([]string{".", "a.go"}, "base")
+	ok(t, err)
+	equals(t, []string{"a.go", filepath.Join("pkg", "d.go")}, files)
+
+	_, err = onlyChanged([]string{"."}, "missing")
+	assert(t, err != nil, "Unknown refs should be reported")
+}
@@ -1,9 +1,13 @@
 module github.com/c4milo/licentia
 
-go 1.13
+go 1.22.0
 
 require (
 	github.com/docopt/docopt-go v0.0.0-20180111231733-ee0de3bc6815
-	github.com/rakyll/statik v0.1.6
 	github.com/ryanuber/go-license v0.0.0-20180405065157-c69f41c2c8d6
+	golang.org/x/mod v0.21.0
+	golang.org/x/tools v0.26.0
+	gopkg.in/yaml.v3 v3.0.1
 )
+
+require golang.org/x/sync v0.8.0 // indirect
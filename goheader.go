@@ -0,0 +1,114 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"bytes"
+	"go/ast"
+	"go/build/constraint"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Line starting package documentation. See https://go.dev/doc/comment
+var goDocRe = regexp.MustCompile(`^(//\s*|/\*\s*)Package\s`)
+
+// Comments preceding the package clause of a Go file.
+type goPreamble struct {
+	// Comment groups that may hold the license header: every group but
+	// build constraints and the package documentation.
+	header []goCommentGroup
+	// Package documentation, if any.
+	doc *goCommentGroup
+	// Every comment group, in order.
+	groups []goCommentGroup
+	// Offset of the package clause.
+	pkg int
+}
+
+// Offsets of a comment group in the file content.
+type goCommentGroup struct {
+	start, end int
+}
+
+func isGoFile(name string) bool {
+	return strings.EqualFold(filepath.Ext(name), ".go")
+}
+
+// Parses the comments preceding the package clause of the Go source data.
+// An error is returned if data is not Go source.
+func parseGoPreamble(data []byte) (*goPreamble, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", data, parser.PackageClauseOnly|parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	offset := func(pos token.Pos) int {
+		return fset.Position(pos).Offset
+	}
+
+	p := &goPreamble{pkg: offset(f.Package)}
+	for _, group := range f.Comments {
+		if group.Pos() >= f.Package {
+			break
+		}
+
+		g := goCommentGroup{start: offset(group.Pos()), end: offset(group.End())}
+		p.groups = append(p.groups, g)
+		switch {
+		case group == f.Doc:
+			doc := g
+			p.doc = &doc
+		case !isBuildConstraint(group):
+			p.header = append(p.header, g)
+		}
+	}
+	return p, nil
+}
+
+// Tells whether every line of group is a build constraint.
+func isBuildConstraint(group *ast.CommentGroup) bool {
+	for _, c := range group.List {
+		if !constraint.IsGoBuild(c.Text) && !constraint.IsPlusBuild(c.Text) {
+			return false
+		}
+	}
+	return true
+}
+
+// Detects the licenses of the Go source data from the comments preceding
+// its package clause, leaving out build constraints and the package
+// documentation, except for a license header merged into it. Data that is
+// not Go source is handled as detectLicenseIn does.
+func detectGoLicense(data []byte) (LicenseExpression, error) {
+	p, err := parseGoPreamble(data)
+	if err != nil {
+		return detectLicenseIn(bytes.NewReader(data))
+	}
+
+	var lines [][]byte
+	for _, g := range p.header {
+		lines = append(lines, bytes.Split(data[g.start:g.end], []byte("\n"))...)
+	}
+	expr, err := detectLicenseLines(lines)
+	if err != nil || !expr.IsUnknown() || p.doc == nil {
+		return expr, err
+	}
+
+	// The license header is merged into the package documentation when no
+	// empty line separates them. It then precedes the sentence starting
+	// the documentation.
+	lines = nil
+	for _, line := range bytes.Split(data[p.doc.start:p.doc.end], []byte("\n")) {
+		if goDocRe.Match(line) {
+			break
+		}
+		lines = append(lines, line)
+	}
+	return detectLicenseLines(lines)
+}
@@ -0,0 +1,234 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Placeholders rendered into the header template so that the owner and year
+// can later be turned into patterns.
+const (
+	ownerMark = "\x00owner\x00"
+	yearMark  = "\x00year\x00"
+)
+
+const (
+	ownerPattern = `(?P<owner>.+?)`
+	yearPattern  = `(?P<year>\d{4}(?:\s*[-,]\s*\d{4})*)`
+)
+
+var errModifiedHeader = errors.New("license header was modified and no longer matches its template")
+
+// Lines that may precede the license header and must be kept in place.
+var preambleRe = regexp.MustCompile(`^(#!|//go:build|// \+build|<\?php|<\?xml|#.*-\*-.*-\*-|#.*coding[:=])`)
+
+// Patterns matching the lines of the header that insertLicense would write,
+// regardless of owner and year.
+type headerTemplate struct {
+	// Copyright notice of a single holder. Nil if the license does not use
+	// one.
+	copyright *regexp.Regexp
+	// Any copyright notice, including the ones written by third parties.
+	anyCopyright *regexp.Regexp
+	// License header lines.
+	body []*regexp.Regexp
+	// Number of body lines that are empty comments.
+	bare int
+}
+
+// A license header found in a file. Offsets are relative to the file
+// content.
+type headerBlock struct {
+	// Range of the whole block, including the empty line separating it
+	// from the code.
+	start, end int
+	copyrights []copyrightNotice
+}
+
+type copyrightNotice struct {
+	start, end int
+	// Holder of the notice. Empty if the notice does not follow the license
+	// template.
+	owner string
+	years string
+}
+
+func newHeaderTemplate(config *Config) (*headerTemplate, error) {
+	tmpl := &headerTemplate{
+		anyCopyright: regexp.MustCompile(`^` + regexp.QuoteMeta(config.EOLCommentStyle) + `\s*(?i:copyright\b|\(c\)|©)`),
+	}
+
+	buf := bytes.NewBuffer(nil)
+	err := renderCopyright(buf, config, CopyrightHolder{Name: ownerMark, Years: yearMark})
+	if err == nil {
+		if tmpl.copyright, err = linePattern(strings.TrimSuffix(buf.String(), "\n")); err != nil {
+			return nil, err
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	buf.Reset()
+	var holders []CopyrightHolder
+	if tmpl.copyright != nil {
+		holders = []CopyrightHolder{{Name: ownerMark, Years: yearMark}}
+	}
+	if err := renderHeader(buf, config, holders); err != nil {
+		return nil, err
+	}
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if tmpl.copyright != nil {
+		lines = lines[1:]
+	}
+	for _, line := range lines {
+		re, err := linePattern(line)
+		if err != nil {
+			return nil, err
+		}
+		tmpl.body = append(tmpl.body, re)
+	}
+
+	for tmpl.bare < len(lines) && lines[tmpl.bare] == strings.TrimSpace(config.EOLCommentStyle) {
+		tmpl.bare++
+	}
+	return tmpl, nil
+}
+
+func linePattern(line string) (*regexp.Regexp, error) {
+	expr := regexp.QuoteMeta(line)
+	expr = strings.Replace(expr, regexp.QuoteMeta(ownerMark), ownerPattern, -1)
+	expr = strings.Replace(expr, regexp.QuoteMeta(yearMark), yearPattern, -1)
+	return regexp.Compile(`^` + expr + `$`)
+}
+
+// Locates the leading license header block in data, the content of the file
+// name, skipping any preamble such as shebangs or build constraints. It
+// returns nil if there is no header, or an error if the block was found but
+// it was modified.
+func findHeader(data []byte, tmpl *headerTemplate, name string) (*headerBlock, error) {
+	lines := bytes.SplitAfter(data, []byte("\n"))
+
+	// Offsets of each line within data.
+	offsets := make([]int, len(lines)+1)
+	for i, line := range lines {
+		offsets[i+1] = offsets[i] + len(line)
+	}
+
+	starts, limit := headerStarts(data, lines, offsets, name)
+	for _, start := range starts {
+		header := &headerBlock{start: offsets[start]}
+
+		i := start
+		for ; i < limit && tmpl.anyCopyright.Match(lines[i]); i++ {
+			notice := copyrightNotice{start: offsets[i], end: offsets[i+1]}
+			if tmpl.copyright != nil {
+				m := tmpl.copyright.FindSubmatch(trimLine(lines[i]))
+				if m != nil {
+					notice.owner = submatch(tmpl.copyright, m, "owner")
+					notice.years = submatch(tmpl.copyright, m, "year")
+				}
+			}
+			header.copyrights = append(header.copyrights, notice)
+		}
+
+		matched := 0
+		for matched < len(tmpl.body) && i+matched < limit &&
+			tmpl.body[matched].Match(trimLine(lines[i+matched])) {
+			matched++
+		}
+
+		if matched <= tmpl.bare {
+			continue
+		}
+
+		if matched < len(tmpl.body) {
+			return nil, errModifiedHeader
+		}
+
+		end := i + matched
+		if end < limit && isBlank(lines[end]) {
+			end++
+		}
+		header.end = offsets[end]
+		return header, nil
+	}
+	return nil, nil
+}
+
+// Returns the lines where the license header of the file name may start, and
+// the line before which it must end. The header is inserted at the very top
+// of the file, yet it could have been moved below the preamble by hand. In Go
+// files, it may start any comment group preceding the package clause, which
+// it cannot go past.
+func headerStarts(data []byte, lines [][]byte, offsets []int, name string) ([]int, int) {
+	if isGoFile(name) {
+		if p, err := parseGoPreamble(data); err == nil {
+			// Line holding offset.
+			line := func(offset int) int {
+				return sort.SearchInts(offsets, offset+1) - 1
+			}
+
+			starts := []int{0}
+			for _, g := range p.groups {
+				if start := line(g.start); start != starts[len(starts)-1] {
+					starts = append(starts, start)
+				}
+			}
+			return starts, line(p.pkg)
+		}
+	}
+
+	first := 0
+	for first < len(lines) && preambleRe.Match(lines[first]) {
+		first++
+		for first < len(lines) && isBlank(lines[first]) {
+			first++
+		}
+	}
+	return []int{0, first}, len(lines)
+}
+
+// Returns the length of the preamble of data: the leading lines that must
+// stay first, such as a shebang, and the blank lines following them.
+func preambleLen(data []byte) int {
+	n := 0
+	for rest := data; len(rest) > 0; {
+		line := rest
+		if i := bytes.IndexByte(rest, '\n'); i >= 0 {
+			line = rest[:i+1]
+		}
+		if !preambleRe.Match(line) && (n == 0 || !isBlank(line)) {
+			break
+		}
+		n += len(line)
+		rest = rest[len(line):]
+	}
+	return n
+}
+
+// Returns the named group of m, which was matched by re.
+func submatch(re *regexp.Regexp, m [][]byte, name string) string {
+	for i, n := range re.SubexpNames() {
+		if n == name {
+			return string(m[i])
+		}
+	}
+	return ""
+}
+
+func trimLine(line []byte) []byte {
+	return bytes.TrimRight(line, " \t\r\n")
+}
+
+func isBlank(line []byte) bool {
+	return len(bytes.TrimSpace(line)) == 0
+}
@@ -0,0 +1,229 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestUnsetKeepsCode(t *testing.T) {
+	content := `#!/usr/bin/env bash
+
+# Copyright 2015 Somebody Else
+echo "Copyright 2015 Test"
+`
+	tests := []struct {
+		ltype  LicenseType
+		header string
+	}{
+		{Apache2, "# Copyright 2009-2015 Test\n#\n#\n#    Licensed under the Apache License, Version 2.0 (the \"License\");\n" +
+			"#    you may not use this file except in compliance with the License.\n" +
+			"#    You may obtain a copy of the License at\n#\n#      http://www.apache.org/licenses/LICENSE-2.0\n#\n" +
+			"#    Unless required by applicable law or agreed to in writing, software\n" +
+			"#    distributed under the License is distributed on an \"AS IS\" BASIS,\n" +
+			"#    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.\n" +
+			"#    See the License for the specific language governing permissions and\n" +
+			"#    limitations under the License.\n\n"},
+		{MPL2, "# This Source Code Form is subject to the terms of the Mozilla Public\n" +
+			"# License, version 2.0. If a copy of the MPL was not distributed with this\n" +
+			"# file, You can obtain one at http://mozilla.org/MPL/2.0/.\n\n"},
+	}
+
+	for _, tt := range tests {
+		file, err := ioutil.TempFile(os.TempDir(), "licentia-tests-")
+		ok(t, err)
+		defer os.Remove(file.Name())
+
+		// Header placed below the shebang.
+		ok(t, ioutil.WriteFile(file.Name(), []byte(content[:21]+tt.header+content[21:]), 0640))
+
+		config := &Config{
+			LicenseType:     tt.ltype,
+			Files:           []string{file.Name()},
+			EOLCommentStyle: "#",
+		}
+		_, err = Unset(config)
+		ok(t, err)
+
+		data, err := ioutil.ReadFile(file.Name())
+		ok(t, err)
+		equals(t, content, string(data))
+	}
+}
+
+func TestUnsetModifiedHeader(t *testing.T) {
+	file, err := ioutil.TempFile(os.TempDir(), "licentia-tests-")
+	ok(t, err)
+	defer os.Remove(file.Name())
+
+	content := "// This Source Code Form is subject to the terms of the Mozilla Public\n" +
+		"// License, version 2.0.\n\npackage main\n"
+	ok(t, ioutil.WriteFile(file.Name(), []byte(content), 0640))
+
+	config := &Config{
+		LicenseType:     MPL2,
+		Files:           []string{file.Name()},
+		EOLCommentStyle: "//",
+	}
+	_, err = Unset(config)
+	assert(t, err != nil, "Modified header should not be removed")
+
+	data, err := ioutil.ReadFile(file.Name())
+	ok(t, err)
+	equals(t, content, string(data))
+}
+
+func TestSetUnsetRoundTrip(t *testing.T) {
+	types, err := List()
+	ok(t, err)
+
+	content := "// +build linux\n\npackage main\n"
+	for _, ltype := range types {
+		file, err := ioutil.TempFile(os.TempDir(), "licentia-tests-")
+		ok(t, err)
+		defer os.Remove(file.Name())
+		ok(t, ioutil.WriteFile(file.Name(), []byte(content), 0640))
+
+		config := &Config{
+			CopyrightOwner:  "Test Inc.",
+			LicenseType:     LicenseType(ltype),
+			Files:           []string{file.Name()},
+			EOLCommentStyle: "//",
+		}
+		_, err = Set(config)
+		ok(t, err)
+		_, err = Unset(config)
+		ok(t, err)
+
+		data, err := ioutil.ReadFile(file.Name())
+		ok(t, err)
+		if _, err := Asset("licenses/" + ltype + ".header"); err != nil {
+			// Licenses without header are never removed.
+			continue
+		}
+		equals(t, content, string(data))
+	}
+}
+
+func TestHolders(t *testing.T) {
+	file, err := ioutil.TempFile(os.TempDir(), "licentia-tests-")
+	ok(t, err)
+	defer os.Remove(file.Name())
+
+	content := "package main\n"
+	ok(t, ioutil.WriteFile(file.Name(), []byte(content), 0640))
+
+	config := &Config{
+		CopyrightHolders: []CopyrightHolder{
+			{Name: "Upstream Authors", Years: "2010-2012"},
+			{Name: "Test", Years: "2015"},
+		},
+		LicenseType:     MIT,
+		Files:           []string{file.Name()},
+		EOLCommentStyle: "//",
+	}
+	_, err = Set(config)
+	ok(t, err)
+
+	data, err := ioutil.ReadFile(file.Name())
+	ok(t, err)
+	assert(t, strings.HasPrefix(string(data), "// Copyright (c) 2010-2012 Upstream Authors\n// Copyright (c) 2015 Test\n//\n// The MIT License (MIT)\n"),
+		"Unexpected copyright notices:\n%s", data)
+
+	// Add a new holder right after the existing ones.
+	addConfig := *config
+	addConfig.CopyrightHolders = []CopyrightHolder{{Name: "Test"}, {Name: "Contributor", Years: "2019"}}
+	_, err = AddHolder(&addConfig)
+	ok(t, err)
+
+	data, err = ioutil.ReadFile(file.Name())
+	ok(t, err)
+	assert(t, strings.HasPrefix(string(data), "// Copyright (c) 2010-2012 Upstream Authors\n// Copyright (c) 2015 Test\n// Copyright (c) 2019 Contributor\n//\n"),
+		"Unexpected copyright notices:\n%s", data)
+
+	// Only our own notices are removed.
+	unsetConfig := *config
+	unsetConfig.CopyrightHolders = []CopyrightHolder{{Name: "Test"}, {Name: "Contributor"}}
+	_, err = Unset(&unsetConfig)
+	ok(t, err)
+
+	data, err = ioutil.ReadFile(file.Name())
+	ok(t, err)
+	equals(t, "// Copyright (c) 2010-2012 Upstream Authors\n\npackage main\n", string(data))
+}
+
+func TestUnsetKeepsForeignHolders(t *testing.T) {
+	file, err := ioutil.TempFile(os.TempDir(), "licentia-tests-")
+	ok(t, err)
+	defer os.Remove(file.Name())
+
+	content := "// Copyright 2009 The Go Authors. All rights reserved.\n// Copyright 2015 Test\n//\n//\n" +
+		"//    Licensed under the Apache License, Version 2.0 (the \"License\");\n" +
+		"//    you may not use this file except in compliance with the License.\n" +
+		"//    You may obtain a copy of the License at\n//\n//      http://www.apache.org/licenses/LICENSE-2.0\n//\n" +
+		"//    Unless required by applicable law or agreed to in writing, software\n" +
+		"//    distributed under the License is distributed on an \"AS IS\" BASIS,\n" +
+		"//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.\n" +
+		"//    See the License for the specific language governing permissions and\n" +
+		"//    limitations under the License.\n\npackage main\n"
+	ok(t, ioutil.WriteFile(file.Name(), []byte(content), 0640))
+
+	config := &Config{
+		CopyrightOwner:  "Test",
+		LicenseType:     Apache2,
+		Files:           []string{file.Name()},
+		EOLCommentStyle: "//",
+	}
+	_, err = Unset(config)
+	ok(t, err)
+
+	data, err := ioutil.ReadFile(file.Name())
+	ok(t, err)
+	equals(t, "// Copyright 2009 The Go Authors. All rights reserved.\n\npackage main\n", string(data))
+}
+
+func TestGoHeader(t *testing.T) {
+	const doc = "// Package foo is not licensed under the MIT License (MIT).\n//\n" +
+		"// Permission is hereby granted, free of charge, to any person obtaining a copy\n" +
+		"// of this software and associated documentation files (the \"Software\"), to deal\n" +
+		"// in the Software without restriction.\n"
+	code := "package foo\n\n/*\n" + mpl2 + "*/\nimport \"C\"\n"
+
+	tests := []struct {
+		desc      string
+		preamble  string
+		header    string
+		detected  LicenseType
+		unlicense string
+	}{
+		{"top", "", mpl2, MPL2, doc + code},
+		{"below build constraints", "//go:build linux\n\n", mpl2, MPL2, "//go:build linux\n\n" + doc + code},
+		{"above build constraints", "", mpl2 + "//go:build linux\n\n", MPL2, "//go:build linux\n\n" + doc + code},
+		{"merged into the package documentation", "", strings.TrimSuffix(mpl2, "\n"), MPL2, doc + code},
+		{"none", "//go:build linux\n\n", "", UNKNOWN, "//go:build linux\n\n" + doc + code},
+	}
+
+	for _, tt := range tests {
+		file, err := ioutil.TempFile(os.TempDir(), "licentia-tests-*.go")
+		ok(t, err)
+		defer os.Remove(file.Name())
+		ok(t, ioutil.WriteFile(file.Name(), []byte(tt.preamble+tt.header+doc+code), 0640))
+
+		// The package documentation is not mistaken for the license
+		// header, and the cgo preamble is not looked at.
+		expr, err := detectLicense(file.Name())
+		ok(t, err)
+		equals(t, singleLicense(tt.detected), expr)
+
+		_, err = Unset(&Config{LicenseType: MPL2, Files: []string{file.Name()}, EOLCommentStyle: "//"})
+		ok(t, err)
+		data, err := ioutil.ReadFile(file.Name())
+		ok(t, err)
+		assert(t, tt.unlicense == string(data), "%s: unexpected content after unset:\n%s", tt.desc, data)
+	}
+}
@@ -0,0 +1,140 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// Marks the hooks written by InstallHook, which may be overwritten.
+const hookMarker = "# Installed by licentia hook install."
+
+// Installs a git pre-commit hook running licentia with args in the
+// repository of the current directory. A pre-commit hook not installed by
+// licentia is only overwritten when force is set. The hook path is
+// returned.
+func InstallHook(args []string, force bool) (string, error) {
+	out, err := exec.Command("git", "rev-parse", "--git-path", "hooks/pre-commit").Output()
+	if err != nil {
+		return "", fmt.Errorf("locate git hooks: %v", gitError(err))
+	}
+	hook := filepath.FromSlash(strings.TrimSpace(string(out)))
+
+	current, err := ioutil.ReadFile(hook)
+	switch {
+	case os.IsNotExist(err):
+	case err != nil:
+		return "", err
+	case !force && !bytes.Contains(current, []byte(hookMarker)):
+		return "", fmt.Errorf("%s already exists, use --force to overwrite it", hook)
+	}
+
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		quoted[i] = shellQuote(arg)
+	}
+	script := "#!/bin/sh\n" + hookMarker + "\nexec licentia " + strings.Join(quoted, " ") + "\n"
+
+	if err := os.MkdirAll(filepath.Dir(hook), 0755); err != nil {
+		return "", err
+	}
+	if err := ioutil.WriteFile(hook, []byte(script), 0755); err != nil {
+		return "", err
+	}
+	// WriteFile keeps the permissions of existing files.
+	return hook, os.Chmod(hook, 0755)
+}
+
+// Sets the license header of the staged files matching patterns, or of
+// every staged file when there are none, and stages them again. Files
+// already having a license header are skipped unless config.Replace is set,
+// and so are files with unstaged changes, which staging would commit.
+func SetStaged(config *Config, patterns []string) ([]skippedFile, error) {
+	staged, err := stagedFiles(".")
+	if err != nil {
+		return nil, err
+	}
+	unstaged, err := gitFiles(".", "diff", "--name-only", "-z", "--relative")
+	if err != nil {
+		return nil, err
+	}
+	modified := make(map[string]bool, len(unstaged))
+	for _, file := range unstaged {
+		modified[file] = true
+	}
+
+	var skipped []skippedFile
+	var files []string
+	for _, file := range staged {
+		if len(patterns) > 0 && !matchesAny(file, patterns) {
+			continue
+		}
+
+		filename := filepath.FromSlash(file)
+		if modified[file] {
+			skipped = append(skipped, skippedFile{file: filename, reason: "has unstaged changes"})
+			continue
+		}
+		if !config.Replace {
+			if expr, err := detectLicense(filename); err == nil && !expr.IsUnknown() {
+				continue
+			}
+		}
+		files = append(files, filename)
+	}
+
+	setConfig := *config
+	setConfig.Files = files
+	setSkipped, err := Set(&setConfig)
+	skipped = append(skipped, setSkipped...)
+	if err != nil {
+		return skipped, err
+	}
+
+	ignored := make(map[string]bool, len(setSkipped))
+	for _, elt := range setSkipped {
+		ignored[elt.file] = true
+	}
+	var changed []string
+	for _, file := range files {
+		if !ignored[file] {
+			changed = append(changed, file)
+		}
+	}
+	return skipped, stageFiles(changed)
+}
+
+// Tells whether a slash separated path, or its base name, matches one of
+// the glob patterns, or is within one of the directories they name.
+func matchesAny(file string, patterns []string) bool {
+	for _, pattern := range patterns {
+		pattern = strings.TrimSuffix(filepath.ToSlash(pattern), "/")
+		if pattern == "." || strings.HasPrefix(file, pattern+"/") {
+			return true
+		}
+		if ok, _ := path.Match(pattern, file); ok {
+			return true
+		}
+		if ok, _ := path.Match(pattern, path.Base(file)); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Quotes s for POSIX shells when needed.
+func shellQuote(s string) string {
+	if s != "" && strings.Trim(s, "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789-_./=:@+,") == "" {
+		return s
+	}
+	return "'" + strings.Replace(s, "'", `'\''`, -1) + "'"
+}
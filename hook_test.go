@@ -0,0 +1,160 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// Creates a git repository in a temporary directory and makes it the
+// current directory until the returned function is called.
+func gitRepo(t *testing.T) func() {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	dir, err := ioutil.TempDir(os.TempDir(), "licentia-tests-")
+	ok(t, err)
+	wd, err := os.Getwd()
+	ok(t, err)
+	ok(t, os.Chdir(dir))
+	ok(t, exec.Command("git", "init", "-q").Run())
+
+	return func() {
+		os.Chdir(wd)
+		os.RemoveAll(dir)
+	}
+}
+
+func TestSetStaged(t *testing.T) {
+	defer gitRepo(t)()
+
+	files := map[string]string{
+		"main.go":     "package main\n",
+		"licensed.go": mpl2 + "package main\n",
+		"partial.go":  "package main\n",
+		"script.py":   "print(1)\n",
+		"unstaged.go": "package main\n",
+	}
+	for name, content := range files {
+		ok(t, ioutil.WriteFile(name, []byte(content), 0640))
+	}
+	ok(t, exec.Command("git", "add", "main.go", "licensed.go", "partial.go", "script.py").Run())
+	ok(t, ioutil.WriteFile("partial.go", []byte("package main\n\nfunc f() {}\n"), 0640))
+
+	config := &Config{CopyrightOwner: "Test", LicenseType: MPL2, EOLCommentStyle: "//"}
+	skipped, err := SetStaged(config, []string{"*.go"})
+	ok(t, err)
+	equals(t, []skippedFile{{file: "partial.go", reason: "has unstaged changes"}}, skipped)
+
+	for name, expected := range map[string]string{
+		"main.go":     mpl2 + "package main\n",
+		"licensed.go": mpl2 + "package main\n",
+		"partial.go":  "package main\n\nfunc f() {}\n",
+		"script.py":   "print(1)\n",
+		"unstaged.go": "package main\n",
+	} {
+		data, err := ioutil.ReadFile(name)
+		ok(t, err)
+		equals(t, expected, string(data))
+	}
+
+	// Changed files are staged again.
+	out, err := exec.Command("git", "diff", "--name-only").Output()
+	ok(t, err)
+	equals(t, "partial.go\n", string(out))
+}
+
+func TestInstallHook(t *testing.T) {
+	defer gitRepo(t)()
+
+	hook, err := InstallHook([]string{"set", "--staged", "mpl2", "Test Inc", "//"}, false)
+	ok(t, err)
+	equals(t, filepath.Join(".git", "hooks", "pre-commit"), hook)
+	data, err := ioutil.ReadFile(hook)
+	ok(t, err)
+	assert(t, strings.HasSuffix(string(data), "\nexec licentia set --staged mpl2 'Test Inc' //\n"), "Unexpected hook:\n%s", data)
+	fi, err := os.Stat(hook)
+	ok(t, err)
+	assert(t, fi.Mode()&0100 != 0, "The hook should be executable")
+
+	// Hooks installed by licentia are overwritten.
+	_, err = InstallHook([]string{"check", "--staged"}, false)
+	ok(t, err)
+
+	ok(t, ioutil.WriteFile(hook, []byte("#!/bin/sh\nmake lint\n"), 0755))
+	_, err = InstallHook([]string{"check", "--staged"}, false)
+	assert(t, err != nil, "Other hooks should not be overwritten")
+	_, err = InstallHook([]string{"check", "--staged"}, true)
+	ok(t, err)
+}
+
+func TestHookRejectsCommit(t *testing.T) {
+	defer gitRepo(t)()
+
+	// The hook runs licentia from PATH.
+	bin, err := ioutil.TempDir(os.TempDir(), "licentia-tests-")
+	ok(t, err)
+	defer os.RemoveAll(bin)
+	wrapper := "#!/bin/sh\n" + mainEnv + "=1 exec " + shellQuote(os.Args[0]) + " \"$@\"\n"
+	ok(t, ioutil.WriteFile(filepath.Join(bin, "licentia"), []byte(wrapper), 0755))
+	defer os.Setenv("PATH", os.Getenv("PATH"))
+	os.Setenv("PATH", bin+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	_, err = InstallHook([]string{"check", "--staged"}, false)
+	ok(t, err)
+
+	commit := exec.Command("git", "-c", "user.name=Test", "-c", "user.email=test@example.com", "commit", "-q", "-m", "Test")
+	ok(t, ioutil.WriteFile("main.go", []byte("package main\n"), 0640))
+	ok(t, exec.Command("git", "add", "main.go").Run())
+	out, err := commit.CombinedOutput()
+	assert(t, err != nil, "The commit should be rejected, got:\n%s", out)
+	assert(t, strings.Contains(string(out), "main.go:\tmissing copyright and licensing information"), "Unexpected output:\n%s", out)
+
+	// Failing to check is a failure too.
+	output, code := runLicentia(t, os.TempDir(), "check", "--staged", bin)
+	assert(t, code != 0, "check --staged outside a repository should fail, got:\n%s", output)
+}
+
+func TestCheckReuseStaged(t *testing.T) {
+	defer gitRepo(t)()
+
+	ok(t, os.Mkdir("LICENSES", 0750))
+	ok(t, ioutil.WriteFile(filepath.Join("LICENSES", "MIT.txt"), []byte("MIT\n"), 0640))
+	ok(t, ioutil.WriteFile("staged.go", []byte("package main\n"), 0640))
+	ok(t, ioutil.WriteFile("other.go", []byte("package main\n"), 0640))
+	ok(t, exec.Command("git", "add", "staged.go").Run())
+
+	problems, err := CheckReuseStaged(".")
+	ok(t, err)
+	equals(t, []reuseProblem{{"staged.go", "missing copyright and licensing information"}}, problems)
+
+	// The staged content is checked, not the one of the working tree.
+	header := "// SPDX-FileCopyrightText: 2020 Test\n// SPDX-License-Identifier: MIT\n\n"
+	ok(t, ioutil.WriteFile("staged.go", []byte(header+"package main\n"), 0640))
+	problems, err = CheckReuseStaged(".")
+	ok(t, err)
+	equals(t, []reuseProblem{{"staged.go", "missing copyright and licensing information"}}, problems)
+
+	ok(t, exec.Command("git", "add", "staged.go").Run())
+	ok(t, ioutil.WriteFile("staged.go", []byte("package main\n"), 0640))
+	problems, err = CheckReuseStaged(".")
+	ok(t, err)
+	equals(t, 0, len(problems))
+
+	// So are the .license sidecars.
+	ok(t, ioutil.WriteFile("logo.png", []byte("\x89PNG\r\n\x1a\n\x00\x00"), 0640))
+	ok(t, ioutil.WriteFile("logo.png.license", []byte("SPDX-FileCopyrightText: 2020 Test\n"), 0640))
+	ok(t, exec.Command("git", "add", "logo.png", "logo.png.license").Run())
+	ok(t, ioutil.WriteFile("logo.png.license", []byte("SPDX-FileCopyrightText: 2020 Test\nSPDX-License-Identifier: MIT\n"), 0640))
+	problems, err = CheckReuseStaged(".")
+	ok(t, err)
+	equals(t, []reuseProblem{{"logo.png", "missing licensing information"}}, problems)
+}
@@ -0,0 +1,180 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Name of the license file written by default.
+const DefaultLicenseFile = "LICENSE"
+
+// Writes the licenses of expr exactly as Dump renders them. A single license
+// is written to filename, whereas several licenses are written next to it,
+// to the files named by DumpExpression. An existing file holding the same
+// license is left untouched when its copyright notices already cover owner,
+// and is not overwritten otherwise. Files holding another license are only
+// overwritten when force is set. No file is written unless every one of them
+// can be. The files written are returned.
+func WriteLicenses(filename string, expr LicenseExpression, owner string, force bool) ([]string, error) {
+	files, err := licenseFiles(filename, expr, owner, force)
+	if err != nil {
+		return nil, err
+	}
+	return writeLicenseFiles(files)
+}
+
+// License file to write.
+type licenseFile struct {
+	filename string
+	text     string
+}
+
+// Returns the license files WriteLicenses writes, leaving out the ones that
+// already hold their license. An error is returned if one of them may not be
+// written.
+func licenseFiles(filename string, expr LicenseExpression, owner string, force bool) ([]licenseFile, error) {
+	texts := make(map[LicenseType]string, len(expr.Licenses))
+	names := make(map[LicenseType]string, len(expr.Licenses))
+	if len(expr.Licenses) == 1 {
+		text, err := Dump(expr.Licenses[0], owner)
+		if err != nil {
+			return nil, err
+		}
+		texts[expr.Licenses[0]], names[expr.Licenses[0]] = text, filename
+	} else {
+		licenses, err := DumpExpression(expr, owner)
+		if err != nil {
+			return nil, err
+		}
+		for _, ltype := range expr.Licenses {
+			name := licenseFileName(ltype)
+			texts[ltype], names[ltype] = licenses[name], filepath.Join(filepath.Dir(filename), name)
+		}
+	}
+
+	var files []licenseFile
+	for _, ltype := range expr.Licenses {
+		write, err := checkLicenseFile(names[ltype], ltype, texts[ltype], force)
+		if err != nil {
+			return nil, err
+		}
+		if write {
+			files = append(files, licenseFile{filename: names[ltype], text: texts[ltype]})
+		}
+	}
+	return files, nil
+}
+
+func writeLicenseFiles(files []licenseFile) ([]string, error) {
+	var written []string
+	for _, file := range files {
+		if err := os.MkdirAll(filepath.Dir(file.filename), 0755); err != nil {
+			return written, err
+		}
+		if err := writeFile(file.filename, []byte(file.text)); err != nil {
+			return written, err
+		}
+		written = append(written, file.filename)
+	}
+	return written, nil
+}
+
+// Tells whether the license file filename needs to be written with text, the
+// ltype license. An error is returned if filename holds another license, or
+// the same license for other copyright holders, unless force is set.
+func checkLicenseFile(filename string, ltype LicenseType, text string, force bool) (bool, error) {
+	current, err := ioutil.ReadFile(filename)
+	switch {
+	case os.IsNotExist(err):
+		return true, nil
+	case err != nil:
+		return false, err
+	case force:
+		return true, nil
+	}
+
+	if existing := fileLicenseType(current, ltype); existing != ltype {
+		if existing == UNKNOWN {
+			return false, fmt.Errorf("%s already exists and its license was not recognized, use --force to overwrite it", filename)
+		}
+		return false, fmt.Errorf("%s already contains the %s license, use --force to overwrite it", filename, existing)
+	}
+
+	// The file already holds the license. Its copyright notices are kept,
+	// years included, as long as they cover the new ones.
+	holders := make(map[string]bool)
+	for _, holder := range licenseFileHolders(current, ltype) {
+		holders[holder] = true
+	}
+	for _, holder := range licenseFileHolders([]byte(text), ltype) {
+		if !holders[holder] {
+			return false, fmt.Errorf("%s already contains the %s license for other copyright holders than %s, use --force to overwrite it", filename, ltype, holder)
+		}
+	}
+	return false, nil
+}
+
+// Returns the holders of the copyright notices of a license file of ltype.
+// Notices that do not follow the copyright template of ltype are returned
+// whole.
+func licenseFileHolders(data []byte, ltype LicenseType) []string {
+	var notice *regexp.Regexp
+	if tmpl, err := Asset(filepath.Join("licenses", string(ltype)+".copyright")); err == nil {
+		expr := regexp.QuoteMeta(strings.TrimSpace(string(tmpl)))
+		expr = strings.Replace(expr, "@@owner@@", ownerPattern, -1)
+		expr = strings.Replace(expr, "@@year@@", yearPattern, -1)
+		notice, _ = regexp.Compile(`^` + expr + `$`)
+	}
+
+	var holders []string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		m := copyrightLineRe.FindSubmatch(scanner.Bytes())
+		if m == nil {
+			continue
+		}
+		line := strings.TrimSpace(scanner.Text())
+		if notice != nil {
+			if n := notice.FindStringSubmatch(line); n != nil {
+				holders = append(holders, n[notice.SubexpIndex("owner")])
+				continue
+			}
+		}
+		holders = append(holders, string(m[1]))
+	}
+	return holders
+}
+
+// Returns the license of a license file. ltype is returned when the file
+// only differs from its template in the copyright notices.
+func fileLicenseType(data []byte, ltype LicenseType) LicenseType {
+	if tmpl, err := Dump(ltype, ""); err == nil && bytes.Equal(withoutCopyrights(data), withoutCopyrights([]byte(tmpl))) {
+		return ltype
+	}
+
+	guessed, _ := guessLicenseType(string(data))
+	return guessed
+}
+
+// Returns data without its copyright notices and surrounding whitespace.
+func withoutCopyrights(data []byte) []byte {
+	buf := bytes.NewBuffer(make([]byte, 0, len(data)))
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		if !copyrightLineRe.Match(scanner.Bytes()) {
+			buf.Write(bytes.TrimRight(scanner.Bytes(), " \t\r"))
+			buf.WriteByte('\n')
+		}
+	}
+	return bytes.TrimSpace(buf.Bytes())
+}
@@ -0,0 +1,117 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWriteSingleLicense(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "licentia-tests-")
+	ok(t, err)
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "LICENSES", "LICENSE")
+	_, err = WriteLicenses(filename, singleLicense(Apache2), "Test", false)
+	ok(t, err)
+
+	expected, err := Dump(Apache2, "Test")
+	ok(t, err)
+	data, err := ioutil.ReadFile(filename)
+	ok(t, err)
+	equals(t, expected, string(data))
+
+	// The same license keeps its copyright notices.
+	expected = strings.Replace(expected, "Copyright "+strconv.Itoa(time.Now().Year()), "Copyright 2015", 1)
+	ok(t, ioutil.WriteFile(filename, []byte(expected), 0640))
+	_, err = WriteLicenses(filename, singleLicense(Apache2), "Test", false)
+	ok(t, err)
+	data, err = ioutil.ReadFile(filename)
+	ok(t, err)
+	equals(t, expected, string(data))
+
+	_, err = WriteLicenses(filename, singleLicense(Apache2), "Other", false)
+	assert(t, err != nil, "The same license for other copyright holders should not be overwritten")
+	data, err = ioutil.ReadFile(filename)
+	ok(t, err)
+	equals(t, expected, string(data))
+
+	_, err = WriteLicenses(filename, singleLicense(MIT), "Test", false)
+	assert(t, err != nil, "A different license should not be overwritten")
+	data, err = ioutil.ReadFile(filename)
+	ok(t, err)
+	equals(t, expected, string(data))
+
+	ok(t, ioutil.WriteFile(filename, []byte("All rights reserved.\n"), 0640))
+	_, err = WriteLicenses(filename, singleLicense(MIT), "Test", false)
+	assert(t, err != nil, "An unrecognized license should not be overwritten")
+
+	_, err = WriteLicenses(filename, singleLicense(MIT), "Test", true)
+	ok(t, err)
+	expected, err = Dump(MIT, "Test")
+	ok(t, err)
+	data, err = ioutil.ReadFile(filename)
+	ok(t, err)
+	equals(t, expected, string(data))
+}
+
+func TestSeedProjectConfig(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "licentia-tests-")
+	ok(t, err)
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, ProjectConfigFile)
+	ok(t, SeedProjectConfig(filename, singleLicense(MPL2), "Test Inc", false))
+
+	config, err := LoadProjectConfig(filename)
+	ok(t, err)
+	equals(t, "mpl2", config.License)
+	equals(t, "Test Inc", config.Owner)
+	equals(t, []string{"MPL-2.0"}, config.Policy.Allow)
+
+	err = SeedProjectConfig(filename, singleLicense(MIT), "Test Inc", false)
+	assert(t, err != nil, "An existing configuration should not be overwritten")
+
+	expr := LicenseExpression{Licenses: []LicenseType{Apache2, MIT}, Operator: "OR"}
+	ok(t, SeedProjectConfig(filename, expr, "Test Inc", true))
+	config, err = LoadProjectConfig(filename)
+	ok(t, err)
+	equals(t, "apache2 OR mit", config.License)
+	equals(t, []string{"Apache-2.0", "MIT"}, config.Policy.Allow)
+}
+
+func TestInitProject(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "licentia-tests-")
+	ok(t, err)
+	defer os.RemoveAll(dir)
+
+	license := filepath.Join(dir, DefaultLicenseFile)
+	config := filepath.Join(dir, ProjectConfigFile)
+	ok(t, ioutil.WriteFile(config, []byte("license: mit\n"), 0640))
+
+	args := map[string]interface{}{
+		"<type>": "apache2", "<owner>": "Test", "--force": false, "--output": license,
+		"--with-config": true, "--config": config, "<eol-comment-style>": nil,
+	}
+	_, err = initProject(args, DefaultMaxFileSize)
+	assert(t, err != nil, "An existing configuration should not be overwritten")
+	_, err = os.Stat(license)
+	assert(t, os.IsNotExist(err), "No license file should be written when the configuration cannot be")
+
+	args["--force"] = true
+	_, err = initProject(args, DefaultMaxFileSize)
+	ok(t, err)
+	expected, err := Dump(Apache2, "Test")
+	ok(t, err)
+	data, err := ioutil.ReadFile(license)
+	ok(t, err)
+	equals(t, expected, string(data))
+}
@@ -0,0 +1,238 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+// Number of unchanged lines shown around the changes of a diff.
+const diffContext = 3
+
+const applyQuestion = "Apply? [y]es, [n]o, [e]dit owner and year, [a]ll, [q]uit: "
+
+// Change Set would make to a file, pending approval.
+type proposedChange struct {
+	file     string
+	detected LicenseExpression
+	// Current and proposed content of the file.
+	old, new []byte
+}
+
+// Sets the license header of the files in config as Set does, after showing
+// the change to each file on out and asking on in whether to apply it. Each
+// file may be accepted, skipped, or have the owner and year of its copyright
+// notice edited, which then applies to the remaining files, and every
+// remaining file may be accepted at once. Nothing is written until every
+// file was reviewed, or the review is ended, after which only the accepted
+// changes are written. Declined and unreviewed files are returned as
+// skipped.
+func SetInteractive(config *Config, in io.Reader, out io.Writer) ([]skippedFile, error) {
+	if config.Reuse {
+		return nil, fmt.Errorf("REUSE headers cannot be set interactively")
+	}
+
+	var skipped []skippedFile
+	var accepted []proposedChange
+	reader := bufio.NewReader(in)
+	all := false
+	quit := false
+	// Edits of the copyright notice are kept to this review.
+	reviewConfig := *config
+	config = &reviewConfig
+
+	for _, file := range config.Files {
+		if quit {
+			skipped = append(skipped, skippedFile{file: file, reason: "not reviewed"})
+			continue
+		}
+
+		reason, err := skipReason(file, config)
+		if err != nil {
+			return skipped, err
+		}
+		if reason != "" {
+			skipped = append(skipped, skippedFile{file: file, reason: reason})
+			continue
+		}
+
+		for edit := true; edit; {
+			edit = false
+
+			change, err := proposeChange(file, config)
+			if err != nil {
+				return skipped, err
+			}
+			if all {
+				accepted = append(accepted, change)
+				break
+			}
+
+			fmt.Fprintf(out, "%s: current license: %s\n", file, change.detected)
+			writeDiff(out, file, change.old, change.new)
+
+			answer, err := prompt(reader, out, applyQuestion)
+			for err == nil && answer == "" {
+				answer, err = prompt(reader, out, applyQuestion)
+			}
+			if err != nil {
+				// The input ended.
+				answer = "q"
+			}
+
+			switch strings.ToLower(answer) {
+			case "y", "yes":
+				accepted = append(accepted, change)
+			case "a", "all":
+				all = true
+				accepted = append(accepted, change)
+			case "e", "edit":
+				editHolder(config, reader, out)
+				edit = true
+			case "q", "quit":
+				quit = true
+				skipped = append(skipped, skippedFile{file: file, reason: "not reviewed"})
+			default:
+				skipped = append(skipped, skippedFile{file: file, reason: "declined"})
+			}
+		}
+	}
+
+	errors := new(Error)
+	for _, change := range accepted {
+		if err := writeFile(change.file, change.new); err != nil {
+			errors.Append(err)
+		}
+	}
+	if errors.IsEmpty() {
+		return skipped, nil
+	}
+	return skipped, errors
+}
+
+// Returns the content file would have once Set is run on it.
+func proposeChange(file string, config *Config) (proposedChange, error) {
+	change := proposedChange{file: file}
+
+	var err error
+	if change.old, err = ioutil.ReadFile(file); err != nil {
+		return change, err
+	}
+	change.detected, err = detectLicense(file)
+	if err != nil {
+		return change, err
+	}
+
+	data := change.old
+	if config.Replace && !change.detected.IsUnknown() {
+		if data, err = removeHeader(file, data, config.withExpression(change.detected)); err != nil {
+			return change, fmt.Errorf("remove %q license from %q: %v", change.detected, file, err)
+		}
+	}
+
+	licensedFile := bytes.NewBuffer(nil)
+	if err := renderInsertedHeader(licensedFile, config); err != nil {
+		return change, err
+	}
+	licensedFile.Write(data)
+	change.new = licensedFile.Bytes()
+	return change, nil
+}
+
+// Asks for the owner and years of the first copyright notice of config.
+func editHolder(config *Config, reader *bufio.Reader, out io.Writer) {
+	holders := config.holders()
+	holder := CopyrightHolder{}
+	if len(holders) > 0 {
+		holder, holders = holders[0], holders[1:]
+	}
+
+	if name, _ := prompt(reader, out, fmt.Sprintf("Owner [%s]: ", holder.Name)); name != "" {
+		holder.Name = name
+	}
+	if years, _ := prompt(reader, out, fmt.Sprintf("Year [%s]: ", holder.years())); years != "" {
+		holder.Years = years
+	}
+
+	config.CopyrightOwner = ""
+	config.CopyrightHolders = append([]CopyrightHolder{holder}, holders...)
+}
+
+// Writes question to out and returns the answer read from reader. io.EOF is
+// returned if the input ended without an answer.
+func prompt(reader *bufio.Reader, out io.Writer, question string) (string, error) {
+	fmt.Fprint(out, question)
+	line, err := reader.ReadString('\n')
+	line = strings.TrimSpace(line)
+	if line != "" && err == io.EOF {
+		err = nil
+	}
+	return line, err
+}
+
+// Writes the changes from old to new content of file as a unified diff.
+func writeDiff(w io.Writer, file string, old, new []byte) {
+	a := strings.SplitAfter(string(old), "\n")
+	b := strings.SplitAfter(string(new), "\n")
+	if a[len(a)-1] == "" {
+		a = a[:len(a)-1]
+	}
+	if b[len(b)-1] == "" {
+		b = b[:len(b)-1]
+	}
+
+	// Headers are set at the top of files and removed from there, so that
+	// changes are a single hunk between common leading and trailing lines.
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	start := prefix - diffContext
+	if start < 0 {
+		start = 0
+	}
+	after := suffix
+	if after > diffContext {
+		after = diffContext
+	}
+	aEnd, bEnd := len(a)-suffix+after, len(b)-suffix+after
+
+	fmt.Fprintf(w, "--- %s\n+++ %s\n", file, file)
+	fmt.Fprintf(w, "@@ -%s +%s @@\n", hunkRange(start, aEnd-start), hunkRange(start, bEnd-start))
+	writeLines(w, " ", a[start:prefix])
+	writeLines(w, "-", a[prefix:len(a)-suffix])
+	writeLines(w, "+", b[prefix:len(b)-suffix])
+	writeLines(w, " ", a[len(a)-suffix:aEnd])
+}
+
+// Formats the lines of a hunk as unified diffs do.
+func hunkRange(start, n int) string {
+	if n == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	if n == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, n)
+}
+
+func writeLines(w io.Writer, prefix string, lines []string) {
+	for _, line := range lines {
+		fmt.Fprint(w, prefix+line)
+		if !strings.HasSuffix(line, "\n") {
+			fmt.Fprint(w, "\n\\ No newline at end of file\n")
+		}
+	}
+}
@@ -0,0 +1,76 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSetInteractive(t *testing.T) {
+	dir, err := ioutil.TempDir("", "licentia-tests-")
+	ok(t, err)
+	defer os.RemoveAll(dir)
+
+	var files []string
+	for _, name := range []string{"a.go", "b.go", "c.go", "d.go", "e.go"} {
+		file := filepath.Join(dir, name)
+		ok(t, ioutil.WriteFile(file, []byte("package foo\n"), 0640))
+		files = append(files, file)
+	}
+
+	config := &Config{CopyrightOwner: "Test", LicenseType: MIT, EOLCommentStyle: "//", Files: files[:4]}
+	out := bytes.NewBuffer(nil)
+	// Declines a.go, edits b.go before accepting it, and accepts the
+	// remaining files at once.
+	skipped, err := SetInteractive(config, strings.NewReader("n\n\ne\nOther\n2019\ny\nall\n"), out)
+	ok(t, err)
+	equals(t, []skippedFile{{file: files[0], reason: "declined"}}, skipped)
+	equals(t, "Test", config.CopyrightOwner)
+
+	assert(t, strings.Contains(out.String(), files[0]+": current license: unknown\n--- "+files[0]+"\n+++ "+files[0]+"\n@@ -1 +1,23 @@\n+// Copyright (c) "),
+		"Unexpected review of %s:\n%s", files[0], out)
+
+	data, err := ioutil.ReadFile(files[0])
+	ok(t, err)
+	equals(t, "package foo\n", string(data))
+	for _, file := range files[1:4] {
+		data, err := ioutil.ReadFile(file)
+		ok(t, err)
+		assert(t, strings.HasPrefix(string(data), "// Copyright (c) 2019 Other\n//\n// The MIT License (MIT)\n"), "Unexpected header in %s:\n%s", file, data)
+	}
+
+	// Files left when the review ends are not written, accepted ones are.
+	config = &Config{CopyrightOwner: "Test", LicenseType: MPL2, EOLCommentStyle: "//", Replace: true, Files: files[1:]}
+	skipped, err = SetInteractive(config, strings.NewReader("y\n"), ioutil.Discard)
+	ok(t, err)
+	equals(t, []skippedFile{{file: files[2], reason: "not reviewed"}, {file: files[3], reason: "not reviewed"}, {file: files[4], reason: "not reviewed"}}, skipped)
+
+	data, err = ioutil.ReadFile(files[1])
+	ok(t, err)
+	// As with Set, copyright notices of other holders are kept.
+	equals(t, mpl2+"// Copyright (c) 2019 Other\n\npackage foo\n", string(data))
+	data, err = ioutil.ReadFile(files[4])
+	ok(t, err)
+	equals(t, "package foo\n", string(data))
+}
+
+func TestWriteDiff(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	writeDiff(buf, "a.go", []byte("// old\n\n1\n2\n3\n4\n5"), []byte("// new\n\n1\n2\n3\n4\n5"))
+	equals(t, "--- a.go\n+++ a.go\n@@ -1,4 +1,4 @@\n-// old\n+// new\n \n 1\n 2\n", buf.String())
+
+	buf.Reset()
+	writeDiff(buf, "a.go", nil, []byte("// new\n"))
+	equals(t, "--- a.go\n+++ a.go\n@@ -0,0 +1 @@\n+// new\n", buf.String())
+
+	buf.Reset()
+	writeDiff(buf, "a.go", []byte("1\n2\n3\n4\n5"), []byte("1\n2\n3\n4\nfive"))
+	equals(t, "--- a.go\n+++ a.go\n@@ -2,4 +2,4 @@\n 2\n 3\n 4\n-5\n\\ No newline at end of file\n+five\n\\ No newline at end of file\n", buf.String())
+}
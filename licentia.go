@@ -7,6 +7,7 @@ package main
 import (
 	"bufio"
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -20,47 +21,65 @@ import (
 
 	"github.com/docopt/docopt-go"
 	"github.com/ryanuber/go-license"
-
-	_ "github.com/c4milo/licentia/statik"
-	"github.com/rakyll/statik/fs"
 )
 
-//go:generate go get github.com/rakyll/statik
-//go:generate statik -f -src licenses
-
 var Version string
-var statikFS http.FileSystem
 
 func main() {
 	usage := `Licentia.
 
 Usage:
-  licentia set [--replace] <type> <owner> <eol-comment-style> <files>...
-  licentia unset <type> <owner> <eol-comment-style> <files>...
-  licentia detect <files>...
-  licentia dump <type> <owner>
-  licentia list
+  licentia set [--replace | --add-holder | --reuse] [--since=<ref>] [--max-size=<bytes>] <type> <owner> <eol-comment-style> <files>...
+  licentia set --staged [--replace] [--max-size=<bytes>] <type> <owner> <eol-comment-style> [<files>...]
+  licentia set --interactive [--replace] [--since=<ref>] [--max-size=<bytes>] <type> <owner> <eol-comment-style> <files>...
+  licentia unset [--max-size=<bytes>] <type> <owner> <eol-comment-style> <files>...
+  licentia relicense --from=<type> --to=<type> [--report=<file>] [--max-size=<bytes>] <owner> <eol-comment-style> <files>...
+  licentia detect [--since=<ref>] <files>...
+  licentia compat <type> <files>...
+  licentia deps [--site-packages=<dir>] [<dir>]
+  licentia policy [--config=<file>] [--deps=<dir>] [--site-packages=<dir>] [<files>...]
+  licentia sbom [--format=<format>] [--name=<name>] [--output=<file>] [--deps=<dir>] <type> <files>...
+  licentia check [--staged | --since=<ref>] [<dir>]
+  licentia hook install [--force] [<type> <owner> <eol-comment-style> [<files>...]]
+  licentia notice [--check] [--name=<name>] [--template=<file>] [--site-packages=<dir>] <type> <owner> [<dir>]
+  licentia init [--force] [--output=<file>] [--with-config] [--config=<file>] [--max-size=<bytes>] <type> <owner> [<eol-comment-style> <files>...]
+  licentia dump [--reuse | --output=<file> [--force]] <type> <owner>
+  licentia show [--header] <type> [<owner> [<eol-comment-style>]]
+  licentia list [--verbose | --format=<format>]
+  licentia serve [--addr=<addr>]
+  licentia lsp [--config=<file>] [<type> <owner> [<eol-comment-style>]]
   licentia -h | --help
   licentia --version
 
 Supported license types:
 
-* apache2   * gpl3       * gpl2
-* mpl2      * cddl       * unlicense
-* mit       * epl
-* newbsd    * freebsd
-* lgpl3     * lgpl2
+` + licenseTypesUsage() + `
 
 Actions:
   set                Sets a license header to the specified files
   unset              Removes license header from the specified files
+  relicense          Replaces the license header of the files currently licensed under --from
   detect             Detects license type for the specified files
+  compat             Reports files whose license is incompatible with the project license <type>
+  deps               Reports the licenses of the Go, npm, Python and Cargo dependencies of the project in <dir>
+  policy             Evaluates source file and dependency licenses against the policy in the project configuration
+  sbom               Generates a bill of materials for the project licensed under <type> made of the specified files
+  check              Checks the project in <dir> complies with the REUSE specification
+  hook install       Installs a git pre-commit hook running "check --staged", or "set --staged" when <type> is given
+  notice             Writes the NOTICE and THIRD_PARTY_NOTICES.txt files of the project in <dir>
+  init               Writes the LICENSE file of the project and optionally sets license headers to the specified files
   dump               Dumps to stdout a given license using the specified owner and the current year
+  show               Shows the metadata of a license and the header "set" inserts for <owner> and <eol-comment-style>
   list               List supported licenses
+  serve              Serves the detect, header, check and list operations as an HTTP JSON API on --addr
+  lsp                Runs a Language Server Protocol server on stdio reporting missing or outdated license headers.
+                     <type> and <owner> default to the ones of the project configuration
 
 Arguments:
   type               License type to set. Ex: apache2, mpl2, mit, newbsd, lgpl3
+                     "set", "unset", "init" and "dump" also take license expressions. Ex: "apache2 OR mit"
   owner              Copyright owner. Ex: "YourCompany Inc"
+  dir                Project directory. Defaults to the current directory.
   files              Source files to set the license header. It supports globbing patterns, individual files or folders. Ex: *.go
   eol-comment-style  End-of-line comment style. Ex: #, ;, //, --, ', etc.
 
@@ -68,6 +87,33 @@ Options:
   -h --help     Show this screen.
   --version     Show version.
   --replace     Try to replace the old license with the new one in "set".
+  --add-holder  Add owner to the copyright notices of an existing license header in "set".
+  --staged      Only process files with staged changes in "set" and "check". <files> then filter them.
+                "set" skips files already licensed, unless --replace is given, and stages the files it changes.
+  --interactive      Show the current license and the change to each file in "set", and ask whether to apply it,
+                     skip it, edit the owner and year, or apply every change. Accepted changes are written once done.
+  --reuse       Set SPDX tags, or .license files for files that cannot have comments, in "set".
+                Write the license to LICENSES/ instead of stdout in "dump".
+  --since=<ref>      Only process files added or modified since the git commit or branch ref in "set", "detect" and "check".
+  --from=<type>      License type files are relicensed from.
+  --to=<type>        License type files are relicensed to.
+  --report=<file>    Write the relicensing report as JSON to file.
+  --site-packages=<dir>  Python site-packages directory. Defaults to the project virtualenv, if any.
+  --config=<file>    Project configuration file [default: .licentia.yml].
+  --deps=<dir>       Also include the dependencies of the project in dir in "policy" and "sbom".
+  --format=<format>  Bill of materials format: spdx-json, spdx-tv, cyclonedx-json or cyclonedx-xml. Defaults to spdx-json.
+                     Output format of "list": text or json. Defaults to text.
+  --header           Only write the header in "show", as the license header analyzer expects it.
+  --verbose          Also list the SPDX identifier and name of every license in "list".
+  --name=<name>      Project name. Defaults to the name of the project directory.
+  --check            Report stale notice files instead of writing them in "notice".
+  --template=<file>  Template file redefining the "NOTICE" or "THIRD_PARTY_NOTICES.txt" templates in "notice".
+  --output=<file>    Write to file instead of stdout. Defaults to LICENSE in "init".
+  --force            Overwrite a license file holding another license or other copyright holders, or the project configuration, in "init" and "dump".
+                     Overwrite a pre-commit hook not installed by licentia in "hook install".
+  --with-config      Also write the project configuration file given by --config in "init".
+  --max-size=<bytes>  Skip files bigger than this size. Defaults to 1MiB.
+  --addr=<addr>      Address "serve" listens on. Defaults to localhost:8080.
 `
 
 	args, err := docopt.Parse(usage, nil, true, Version, false)
@@ -75,54 +121,128 @@ Options:
 		panic(err)
 	}
 
-	if statikFS, err = fs.New(); err != nil {
-		panic(err)
+	var maxSize int64
+	if val, ok := args["--max-size"].(string); ok {
+		if maxSize, err = strconv.ParseInt(val, 10, 64); err != nil {
+			fmt.Printf("invalid --max-size %q: %v\n", val, err)
+			os.Exit(1)
+		}
 	}
 
 	var files []string
-	if val, ok := args["set"]; ok && val.(bool) {
-		if files, err = globFiles(args["<files>"].([]string)); err == nil {
+	var skipped []skippedFile
+	var exitCode int
+	if val, ok := args["set"]; ok && val.(bool) && args["--staged"].(bool) {
+		config := &Config{
+			CopyrightOwner:  args["<owner>"].(string),
+			EOLCommentStyle: args["<eol-comment-style>"].(string),
+			Replace:         args["--replace"].(bool),
+			MaxFileSize:     maxSize,
+		}
+		if err = config.setLicense(args["<type>"].(string)); err == nil {
+			skipped, err = SetStaged(config, args["<files>"].([]string))
+		}
+	} else if ok && val.(bool) {
+		if files, err = selectFiles(args); err == nil {
 			config := &Config{
-				LicenseType:     LicenseType(args["<type>"].(string)),
 				CopyrightOwner:  args["<owner>"].(string),
 				EOLCommentStyle: args["<eol-comment-style>"].(string),
 				Files:           files,
 				Replace:         args["--replace"].(bool),
+				Reuse:           args["--reuse"].(bool),
+				MaxFileSize:     maxSize,
+			}
+			if err = config.setLicense(args["<type>"].(string)); err == nil {
+				if args["--add-holder"].(bool) {
+					skipped, err = AddHolder(config)
+				} else if args["--interactive"].(bool) {
+					skipped, err = SetInteractive(config, os.Stdin, os.Stdout)
+				} else {
+					skipped, err = Set(config)
+				}
 			}
-			err = Set(config)
 		}
 	}
 
 	if val, ok := args["unset"]; ok && val.(bool) {
 		if files, err = globFiles(args["<files>"].([]string)); err == nil {
 			config := &Config{
-				LicenseType:     LicenseType(args["<type>"].(string)),
 				CopyrightOwner:  args["<owner>"].(string),
 				EOLCommentStyle: args["<eol-comment-style>"].(string),
 				Files:           files,
+				MaxFileSize:     maxSize,
+			}
+			if err = config.setLicense(args["<type>"].(string)); err == nil {
+				skipped, err = Unset(config)
+			}
+		}
+	}
+
+	if val, ok := args["relicense"]; ok && val.(bool) {
+		var from, to LicenseType
+		if from, err = ParseLicenseType(args["--from"].(string)); err == nil {
+			to, err = ParseLicenseType(args["--to"].(string))
+		}
+		if err == nil {
+			files, err = globFiles(args["<files>"].([]string))
+		}
+		if err == nil {
+			config := &Config{
+				LicenseType:     to,
+				CopyrightOwner:  args["<owner>"].(string),
+				EOLCommentStyle: args["<eol-comment-style>"].(string),
+				Files:           files,
+				MaxFileSize:     maxSize,
+			}
+			var results []relicenseResult
+			results, err = Relicense(config, from)
+			for _, elt := range results {
+				switch {
+				case elt.Reason != "":
+					fmt.Printf("%s:\t%s: %s\n", elt.File, elt.Status, elt.Reason)
+				case elt.Status == SkippedDifferentLicense:
+					fmt.Printf("%s:\t%s: %s\n", elt.File, elt.Status, elt.Detected)
+				default:
+					fmt.Printf("%s:\t%s\n", elt.File, elt.Status)
+				}
+			}
+
+			if report, ok := args["--report"].(string); ok {
+				if rerr := writeRelicenseReport(report, from, config.LicenseType, results); rerr != nil {
+					fmt.Println(rerr)
+				}
 			}
-			err = Unset(config)
 		}
 	}
 
+	if val, ok := args["init"]; ok && val.(bool) {
+		skipped, err = initProject(args, maxSize)
+	}
+
 	if val, ok := args["list"]; ok && val.(bool) {
-		var types []string
-		types, err = List()
+		format, _ := args["--format"].(string)
+		err = WriteList(os.Stdout, format, args["--verbose"].(bool))
+	}
 
-		fmt.Println("Supported licenses: ")
-		for _, t := range types {
-			fmt.Println("* " + t)
+	if val, ok := args["show"]; ok && val.(bool) {
+		var ltype LicenseType
+		if ltype, err = ParseLicenseType(args["<type>"].(string)); err == nil {
+			owner, _ := args["<owner>"].(string)
+			eol, _ := args["<eol-comment-style>"].(string)
+			if args["--header"].(bool) {
+				err = ShowHeader(os.Stdout, ltype, owner, eol)
+			} else {
+				err = Show(os.Stdout, ltype, owner, eol)
+			}
 		}
 	}
 
 	if val, ok := args["dump"]; ok && val.(bool) {
-		var license string
-		license, err = Dump(LicenseType(args["<type>"].(string)), args["<owner>"].(string))
-		fmt.Println(license)
+		err = dump(args)
 	}
 
 	if val, ok := args["detect"]; ok && val.(bool) {
-		if files, err = globFiles(args["<files>"].([]string)); err == nil {
+		if files, err = selectFiles(args); err == nil {
 			config := &Config{Files: files}
 			var types []fileLicense
 			types, err = Detect(config)
@@ -132,9 +252,385 @@ Options:
 		}
 	}
 
+	for _, elt := range skipped {
+		fmt.Printf("%s:\tskipped: %s\n", elt.file, elt.reason)
+	}
+
+	if val, ok := args["compat"]; ok && val.(bool) {
+		if files, err = globFiles(args["<files>"].([]string)); err == nil {
+			config := &Config{Files: files}
+			var project LicenseType
+			var types []fileLicense
+			var conflicts []fileConflict
+			if project, err = ParseLicenseType(args["<type>"].(string)); err == nil {
+				if types, err = Detect(config); err == nil {
+					conflicts, err = Compat(project, types)
+				}
+			}
+			for _, elt := range conflicts {
+				fmt.Printf("%s:\t%s: %s\n", elt.file, elt.license, elt.explanation)
+			}
+			if len(conflicts) > 0 {
+				exitCode = 1
+			}
+		}
+	}
+
+	if val, ok := args["deps"]; ok && val.(bool) {
+		dir := "."
+		if val, ok := args["<dir>"].(string); ok {
+			dir = val
+		}
+
+		sitePackages, _ := args["--site-packages"].(string)
+
+		var deps []dependency
+		deps, err = ScanDependencies(dir, sitePackages)
+		for _, elt := range deps {
+			if elt.reason != "" {
+				fmt.Printf("%s\t%s@%s:\t%s: %s\n", elt.ecosystem, elt.name, elt.version, elt.spdx(), elt.reason)
+				continue
+			}
+			fmt.Printf("%s\t%s@%s:\t%s\n", elt.ecosystem, elt.name, elt.version, elt.spdx())
+		}
+	}
+
+	if val, ok := args["policy"]; ok && val.(bool) {
+		var project *ProjectConfig
+		project, err = LoadProjectConfig(args["--config"].(string))
+
+		var types []fileLicense
+		if err == nil {
+			if files, err = globFiles(args["<files>"].([]string)); err == nil {
+				types, err = Detect(&Config{Files: files})
+			}
+		}
+
+		var deps []dependency
+		if dir, ok := args["--deps"].(string); ok && err == nil {
+			sitePackages, _ := args["--site-packages"].(string)
+			deps, err = ScanDependencies(dir, sitePackages)
+		}
+
+		if err == nil {
+			for _, elt := range project.Policy.Check(types, deps, time.Now()) {
+				fmt.Printf("%s:\t%s: %s: %s\n", elt.subject, elt.verdict, elt.license, elt.reason)
+				if elt.verdict == Denied {
+					exitCode = 1
+				}
+			}
+		}
+	}
+
+	if val, ok := args["check"]; ok && val.(bool) {
+		dir := "."
+		if val, ok := args["<dir>"].(string); ok {
+			dir = val
+		}
+
+		var problems []reuseProblem
+		if ref, ok := args["--since"].(string); ok {
+			if files, err = changedFiles(dir, ref); err == nil {
+				problems, err = CheckReuseFiles(dir, files)
+			}
+		} else if args["--staged"].(bool) {
+			problems, err = CheckReuseStaged(dir)
+		} else {
+			problems, err = CheckReuse(dir)
+		}
+		for _, elt := range problems {
+			fmt.Printf("%s:\t%s\n", elt.subject, elt.problem)
+		}
+		if len(problems) > 0 {
+			exitCode = 1
+		}
+	}
+
+	if val, ok := args["hook"]; ok && val.(bool) {
+		err = installHook(args)
+	}
+
+	if val, ok := args["serve"]; ok && val.(bool) {
+		addr, ok := args["--addr"].(string)
+		if !ok {
+			addr = DefaultServeAddr
+		}
+		fmt.Printf("Listening on %s\n", addr)
+		server := &http.Server{
+			Addr:         addr,
+			Handler:      NewServer(),
+			ReadTimeout:  serveReadTimeout,
+			WriteTimeout: serveWriteTimeout,
+		}
+		err = server.ListenAndServe()
+	}
+
+	if val, ok := args["lsp"]; ok && val.(bool) {
+		err = serveLSP(args)
+	}
+
+	if val, ok := args["sbom"]; ok && val.(bool) {
+		err = writeSBOM(args)
+	}
+
+	if val, ok := args["notice"]; ok && val.(bool) {
+		var stale []string
+		stale, err = writeNotices(args)
+		for _, file := range stale {
+			fmt.Printf("%s:\tout of date\n", file)
+		}
+		if len(stale) > 0 {
+			exitCode = 1
+		}
+	}
+
 	if err != nil {
 		fmt.Println(err)
+		exitCode = 1
+	}
+	os.Exit(exitCode)
+}
+
+// Installs the pre-commit hook running "set --staged" with the given
+// arguments, or "check --staged" when none are given.
+func installHook(args map[string]interface{}) error {
+	hookArgs := []string{"check", "--staged"}
+	if ltype, ok := args["<type>"].(string); ok {
+		if _, err := ParseLicenseExpression(ltype); err != nil {
+			return err
+		}
+		hookArgs = append([]string{"set", "--staged", ltype, args["<owner>"].(string), args["<eol-comment-style>"].(string)}, args["<files>"].([]string)...)
+	}
+
+	hook, err := InstallHook(hookArgs, args["--force"].(bool))
+	if err == nil {
+		fmt.Println(hook)
+	}
+	return err
+}
+
+// Runs the language server for the license and owner given as arguments or,
+// when there are none, in the project configuration.
+func serveLSP(args map[string]interface{}) error {
+	ltype, _ := args["<type>"].(string)
+	owner, _ := args["<owner>"].(string)
+	if ltype == "" {
+		filename := args["--config"].(string)
+		project, err := LoadProjectConfig(filename)
+		if err != nil {
+			return err
+		}
+		if project.License == "" {
+			return fmt.Errorf("%s: no license configured", filename)
+		}
+		ltype, owner = project.License, project.Owner
+	}
+
+	eol, _ := args["<eol-comment-style>"].(string)
+	config := &Config{CopyrightOwner: owner, EOLCommentStyle: eol}
+	if err := config.setLicense(ltype); err != nil {
+		return err
+	}
+	return ServeLSP(os.Stdin, os.Stdout, config)
+}
+
+func writeSBOM(args map[string]interface{}) error {
+	files, err := globFiles(args["<files>"].([]string))
+	if err != nil {
+		return err
+	}
+
+	name, err := projectName(args, ".")
+	if err != nil {
+		return err
+	}
+
+	ltype, err := ParseLicenseType(args["<type>"].(string))
+	if err != nil {
+		return err
+	}
+
+	depsDir, _ := args["--deps"].(string)
+	scan, err := ScanProject(name, ltype, files, depsDir)
+	if err != nil {
+		return err
+	}
+
+	w := io.Writer(os.Stdout)
+	if output, ok := args["--output"].(string); ok {
+		fh, err := os.Create(output)
+		if err != nil {
+			return err
+		}
+		defer fh.Close()
+		w = fh
+	}
+
+	format, _ := args["--format"].(string)
+	if format == "" {
+		format = "spdx-json"
+	}
+
+	switch format {
+	case "spdx-json":
+		return BuildSPDX(scan).WriteJSON(w)
+	case "spdx-tv":
+		return BuildSPDX(scan).WriteTagValue(w)
+	case "cyclonedx-json":
+		return BuildCycloneDX(scan).WriteJSON(w)
+	case "cyclonedx-xml":
+		return BuildCycloneDX(scan).WriteXML(w)
+	default:
+		return fmt.Errorf("unsupported bill of materials format %q", format)
+	}
+}
+
+// Dumps licenses to stdout, LICENSES/ or --output. Projects under several
+// licenses get a license file per license, ex: LICENSE-APACHE and
+// LICENSE-MIT, next to --output or in the current directory.
+func dump(args map[string]interface{}) error {
+	expr, err := ParseLicenseExpression(args["<type>"].(string))
+	if err != nil {
+		return err
+	}
+	owner := args["<owner>"].(string)
+
+	output, hasOutput := args["--output"].(string)
+	if len(expr.Licenses) > 1 || hasOutput {
+		if !hasOutput {
+			output = DefaultLicenseFile
+		}
+		written, err := WriteLicenses(output, expr, owner, args["--force"].(bool))
+		if len(expr.Licenses) > 1 {
+			for _, filename := range written {
+				fmt.Println(filename)
+			}
+		}
+		return err
+	}
+
+	if args["--reuse"].(bool) {
+		filename, err := DumpReuse(".", expr.Licenses[0], owner)
+		if err == nil {
+			fmt.Println(filename)
+		}
+		return err
+	}
+
+	license, err := Dump(expr.Licenses[0], owner)
+	fmt.Print(license)
+	return err
+}
+
+// Writes the license file and, if asked to, the project configuration and
+// license headers of the specified files.
+func initProject(args map[string]interface{}, maxSize int64) ([]skippedFile, error) {
+	config := &Config{
+		CopyrightOwner: args["<owner>"].(string),
+		MaxFileSize:    maxSize,
+	}
+	if err := config.setLicense(args["<type>"].(string)); err != nil {
+		return nil, err
+	}
+	expr := config.licenseExpression()
+	force := args["--force"].(bool)
+
+	output := DefaultLicenseFile
+	if val, ok := args["--output"].(string); ok {
+		output = val
+	}
+	// Nothing is written unless the license files and the configuration
+	// can all be.
+	licenses, err := licenseFiles(output, expr, config.CopyrightOwner, force)
+	if err != nil {
+		return nil, err
+	}
+	if args["--with-config"].(bool) {
+		if err := SeedProjectConfig(args["--config"].(string), expr, config.CopyrightOwner, force); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := writeLicenseFiles(licenses); err != nil {
+		return nil, err
 	}
+
+	eol, ok := args["<eol-comment-style>"].(string)
+	if !ok {
+		return nil, nil
+	}
+
+	files, err := globFiles(args["<files>"].([]string))
+	if err != nil {
+		return nil, err
+	}
+	config.EOLCommentStyle = eol
+	config.Files = files
+	return Set(config)
+}
+
+// Writes the notice files of the project, or returns the stale ones when
+// --check is given.
+func writeNotices(args map[string]interface{}) ([]string, error) {
+	dir := "."
+	if val, ok := args["<dir>"].(string); ok {
+		dir = val
+	}
+
+	name, err := projectName(args, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	ltype, err := ParseLicenseType(args["<type>"].(string))
+	if err != nil {
+		return nil, err
+	}
+
+	sitePackages, _ := args["--site-packages"].(string)
+	deps, err := ScanDependencies(dir, sitePackages)
+	if err != nil && !errors.Is(err, errNoManifests) {
+		// Projects without dependencies still get notices.
+		return nil, err
+	}
+
+	config := &Config{
+		LicenseType:    ltype,
+		CopyrightOwner: args["<owner>"].(string),
+	}
+	data, err := BuildNotices(name, config, deps)
+	if err != nil {
+		return nil, err
+	}
+
+	templateFile, _ := args["--template"].(string)
+	files, err := RenderNotices(data, templateFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if args["--check"].(bool) {
+		return StaleNotices(dir, files)
+	}
+
+	for name, data := range files {
+		if err := writeFile(filepath.Join(dir, name), data); err != nil {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+// Returns the name given with --name, or the name of the project directory.
+func projectName(args map[string]interface{}, dir string) (string, error) {
+	if name, ok := args["--name"].(string); ok && name != "" {
+		return name, nil
+	}
+
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Base(abs), nil
 }
 
 // License type
@@ -144,29 +640,146 @@ const (
 	Apache2   LicenseType = "apache2"
 	Freebsd   LicenseType = "freebsd"
 	LGPL3     LicenseType = "lgpl3"
+	LGPL3Only LicenseType = "lgpl3-only"
 	LGPL2     LicenseType = "lgpl2"
+	LGPL2Only LicenseType = "lgpl2-only"
 	MIT       LicenseType = "mit"
 	MPL2      LicenseType = "mpl2"
 	NewBSD    LicenseType = "newbsd"
 	GPL3      LicenseType = "gpl3"
+	GPL3Only  LicenseType = "gpl3-only"
 	GPL2      LicenseType = "gpl2"
+	GPL2Only  LicenseType = "gpl2-only"
 	CDDL      LicenseType = "cddl"
 	EPL       LicenseType = "epl"
 	UNLICENSE LicenseType = "unlicense"
+	UPL       LicenseType = "upl"
+	AGPL3     LicenseType = "agpl3"
+	AGPL3Only LicenseType = "agpl3-only"
+	ISC       LicenseType = "isc"
+	ZeroBSD   LicenseType = "0bsd"
+	Zlib      LicenseType = "zlib"
+	BSL       LicenseType = "bsl"
+	EUPL      LicenseType = "eupl"
+	EPL2      LicenseType = "epl2"
+	CC0       LicenseType = "cc0"
+	CCBY      LicenseType = "ccby"
 	UNKNOWN   LicenseType = "unknown"
 )
 
+// Copyright holder and the years its notice covers.
+type CopyrightHolder struct {
+	Name string
+	// Ex: 2015, 2015-2019 or 2015, 2017. Defaults to the current year.
+	Years string
+}
+
+func (h CopyrightHolder) years() string {
+	if h.Years == "" {
+		return strconv.Itoa(time.Now().Year())
+	}
+	return h.Years
+}
+
 type Config struct {
 	// The owner of the copyright
 	CopyrightOwner string
+	// Additional copyright holders, each one rendered in its own notice
+	// after CopyrightOwner.
+	CopyrightHolders []CopyrightHolder
 	// License type
 	LicenseType LicenseType
+	// Licenses of the files when there is more than one, ex: apache2 OR
+	// mit. Headers then carry an SPDX-License-Identifier tag instead of the
+	// license header, and LicenseType, the first license of the expression,
+	// only provides the copyright notice.
+	Expression *LicenseExpression
 	// Invidiviual file or folder as well as glob patterns are recognized
 	Files []string
 	// Style of end-of-line comment that will be used to insert the license.
 	// Ex: //, #, --, !, ', ;
 	EOLCommentStyle string
 	Replace         bool
+	// Sets REUSE compliant SPDX tags instead of the license header, and
+	// .license sidecars for files that cannot carry comments.
+	Reuse bool
+	// Files bigger than this number of bytes are skipped. DefaultMaxFileSize
+	// is used when zero.
+	MaxFileSize int64
+}
+
+// Sets the license type, and the expression if there is more than one
+// license, from a license type or expression such as apache2 OR mit.
+func (c *Config) setLicense(expr string) error {
+	e, err := ParseLicenseExpression(expr)
+	if err != nil {
+		return err
+	}
+
+	c.LicenseType = e.Licenses[0]
+	c.Expression = nil
+	if len(e.Licenses) > 1 {
+		c.Expression = &e
+	}
+	return nil
+}
+
+// Returns a copy of the configuration for the licenses of expr.
+func (c *Config) withExpression(expr LicenseExpression) *Config {
+	config := *c
+	config.LicenseType = expr.Licenses[0]
+	config.Expression = nil
+	if len(expr.Licenses) > 1 {
+		config.Expression = &expr
+	}
+	return &config
+}
+
+// Returns the licenses of the files.
+func (c *Config) licenseExpression() LicenseExpression {
+	if c.Expression != nil {
+		return *c.Expression
+	}
+	return singleLicense(c.LicenseType)
+}
+
+// Returns every configured copyright holder. CopyrightOwner comes first and
+// gets the current year.
+func (c *Config) holders() []CopyrightHolder {
+	holders := make([]CopyrightHolder, 0, len(c.CopyrightHolders)+1)
+	if c.CopyrightOwner != "" {
+		holders = append(holders, CopyrightHolder{Name: c.CopyrightOwner})
+	}
+	return append(holders, c.CopyrightHolders...)
+}
+
+// Tells whether owner is one of the configured copyright holders. Every owner
+// is considered a holder when none is configured.
+func (c *Config) isHolder(owner string) bool {
+	holders := c.holders()
+	if len(holders) == 0 {
+		return owner != ""
+	}
+
+	for _, h := range holders {
+		if h.Name == owner {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the files matching the <files> patterns, only keeping the ones
+// changed since --since, if given.
+func selectFiles(args map[string]interface{}) ([]string, error) {
+	files, err := globFiles(args["<files>"].([]string))
+	if err != nil {
+		return nil, err
+	}
+	if ref, ok := args["--since"].(string); ok {
+		return onlyChanged(files, ref)
+	}
+	return files, nil
 }
 
 func globFiles(args []string) ([]string, error) {
@@ -183,11 +796,19 @@ func globFiles(args []string) ([]string, error) {
 
 // Dumps license to stdout setting the owner and year in the copyright notice
 func Dump(ltype LicenseType, owner string) (string, error) {
+	info := lookupLicense(ltype)
+	if info == nil {
+		return "", fmt.Errorf("unsupported license type %q", ltype)
+	}
+	if !info.Text {
+		return "", fmt.Errorf("the full text of the %s license is not bundled, see %s", info.SPDX, info.URL)
+	}
+
 	replacer := strings.NewReplacer(
 		"@@owner@@", owner,
 		"@@year@@", strconv.Itoa(time.Now().Year()),
 	)
-	data, err := Asset(filepath.Join("licenses", string(ltype)))
+	data, err := Asset(info.textAsset())
 	if err != nil {
 		return "", err
 	}
@@ -198,53 +819,56 @@ func Dump(ltype LicenseType, owner string) (string, error) {
 	return replacer.Replace(string(data)), nil
 }
 
-// Sets license
-func Set(config *Config) error {
-	errors := new(Error)
-
-	var wg sync.WaitGroup
-	replacer := strings.NewReplacer(
-		"@@owner@@", config.CopyrightOwner,
-		"@@year@@", strconv.Itoa(time.Now().Year()),
-	)
-
-	removeConfig := *config
-
-	for _, file := range config.Files {
-		wg.Add(1)
-		go func(file string) {
-			defer wg.Done()
+// Sets license. Binary, generated and oversized files are left untouched and
+// returned along with the reason they were skipped.
+func Set(config *Config) ([]skippedFile, error) {
+	if config.Reuse {
+		return setReuse(config)
+	}
 
-			if config.Replace {
-				// Detect old license and remove before adding another one.
-				old, err := detectLicense(file)
-				//fmt.Fprintf(os.Stderr, "OLD:%s err=%v\n", old, err)
-				if err == nil && old != UNKNOWN {
-					removeConfig.LicenseType = old
-					removeConfig.Files = []string{file}
-					if err = removeLicense(file, &removeConfig); err != nil {
-						errors.Append(fmt.Errorf("remove %q license from %q: %v", old, file, err))
-					}
+	return forEachFile(config, func(file string) error {
+		if config.Replace {
+			// Detect old license and remove before adding another one.
+			old, err := detectLicense(file)
+			if err != nil {
+				return fmt.Errorf("detect license of %q: %v", file, err)
+			}
+			if !old.IsUnknown() {
+				removeConfig := config.withExpression(old)
+				removeConfig.Files = []string{file}
+				if err = removeLicense(file, removeConfig); err != nil {
+					return fmt.Errorf("remove %q license from %q: %v", old, file, err)
 				}
 			}
+		}
 
-			if err := insertLicense(file, replacer, config); err != nil {
-				errors.Append(err)
-			}
-		}(file)
-	}
-	wg.Wait()
+		return insertLicense(file, config)
+	})
+}
 
-	if errors.IsEmpty() {
-		return nil
-	}
+// Removes license. Binary, generated and oversized files are left untouched
+// and returned along with the reason they were skipped. Copyright notices of
+// holders other than the configured ones are kept.
+func Unset(config *Config) ([]skippedFile, error) {
+	return forEachFile(config, func(file string) error {
+		return removeLicense(file, config)
+	})
+}
 
-	return errors
+// Adds the configured copyright holders to the existing license header of
+// each file. Holders already present in the header are left as they are.
+func AddHolder(config *Config) ([]skippedFile, error) {
+	return forEachFile(config, func(file string) error {
+		return addHolder(file, config)
+	})
 }
 
-// Removes license
-func Unset(config *Config) error {
+// Concurrently runs fn on every file in config that is not binary, generated
+// or oversized.
+func forEachFile(config *Config, fn func(file string) error) ([]skippedFile, error) {
 	errors := new(Error)
+	var skippedMtx sync.Mutex
+	var skipped []skippedFile
 
 	var wg sync.WaitGroup
 	for _, file := range config.Files {
@@ -252,7 +876,19 @@ func Unset(config *Config) error {
 		go func(file string) {
 			defer wg.Done()
 
-			if err := removeLicense(file, config); err != nil {
+			reason, err := skipReason(file, config)
+			if err != nil {
+				errors.Append(err)
+				return
+			}
+			if reason != "" {
+				skippedMtx.Lock()
+				skipped = append(skipped, skippedFile{file: file, reason: reason})
+				skippedMtx.Unlock()
+				return
+			}
+
+			if err := fn(file); err != nil {
 				errors.Append(err)
 			}
 		}(file)
@@ -260,111 +896,218 @@ func Unset(config *Config) error {
 	wg.Wait()
 
 	if errors.IsEmpty() {
-		return nil
+		return skipped, nil
 	}
 
-	return errors
+	return skipped, errors
 }
 
-// Removes license header from file represented by filename
+// Removes license header from file represented by filename. Only the leading
+// header block is removed, and only if it still matches the license template.
 func removeLicense(filename string, config *Config) error {
-	lbuffer := bytes.NewBuffer(nil)
-	lheader, err := Asset(filepath.Join("licenses", string(config.LicenseType)+".header"))
+	licensedFile, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+
+	unlicensedFile, err := removeHeader(filename, licensedFile, config)
+	if err != nil || bytes.Equal(unlicensedFile, licensedFile) {
+		return err
+	}
+	return writeFile(filename, unlicensedFile)
+}
+
+// Returns licensedFile, the content of filename, without its license header.
+// Copyright notices of holders other than the configured ones are kept.
+func removeHeader(filename string, licensedFile []byte, config *Config) ([]byte, error) {
+	_, err := Asset(filepath.Join("licenses", string(config.LicenseType)+".header"))
 	if err != nil {
 		// This license does require a license header in the source file.
 		// Do not remove anything
-		return nil
+		return licensedFile, nil
+	}
+
+	tmpl, err := newHeaderTemplate(config)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := findHeader(licensedFile, tmpl, filename)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", filename, err)
+	}
+
+	if header == nil {
+		// No license header to remove.
+		return licensedFile, nil
 	}
 
-	err = prependEOLComment(lbuffer, config.EOLCommentStyle, lheader)
+	unlicensedFile := bytes.NewBuffer(make([]byte, 0, len(licensedFile)))
+	unlicensedFile.Write(licensedFile[:header.start])
+
+	// Copyright notices from third parties stay where they were.
+	var foreign int
+	for _, c := range header.copyrights {
+		if !config.isHolder(c.owner) {
+			unlicensedFile.Write(licensedFile[c.start:c.end])
+			foreign++
+		}
+	}
+	if foreign > 0 {
+		unlicensedFile.WriteByte('\n')
+	}
+
+	unlicensedFile.Write(licensedFile[header.end:])
+
+	return unlicensedFile.Bytes(), nil
+}
+
+// Appends the copyright notice of each configured holder missing from the
+// license header of filename.
+func addHolder(filename string, config *Config) error {
+	tmpl, err := newHeaderTemplate(config)
 	if err != nil {
 		return err
 	}
 
-	license := lbuffer.String()
+	if tmpl.copyright == nil {
+		return fmt.Errorf("%s: %s license does not use copyright notices", filename, config.LicenseType)
+	}
 
 	licensedFile, err := ioutil.ReadFile(filename)
-	buf := bytes.NewBuffer(licensedFile)
-	unlicensedFile := bytes.NewBuffer(nil)
+	if err != nil {
+		return err
+	}
 
-	scanner := bufio.NewScanner(buf)
-	for scanner.Scan() {
-		if bytes.HasPrefix(scanner.Bytes(), []byte(config.EOLCommentStyle+" Copyright")) {
+	header, err := findHeader(licensedFile, tmpl, filename)
+	if err != nil {
+		return fmt.Errorf("%s: %v", filename, err)
+	}
+
+	if header == nil || len(header.copyrights) == 0 {
+		return fmt.Errorf("%s: no %s license header found", filename, config.LicenseType)
+	}
+
+	notices := bytes.NewBuffer(nil)
+	for _, holder := range config.holders() {
+		found := false
+		for _, c := range header.copyrights {
+			if c.owner == holder.Name {
+				found = true
+				break
+			}
+		}
+		if found {
 			continue
 		}
-		line := scanner.Text()
-		_, err := unlicensedFile.WriteString(line + "\n")
-		if err != nil {
+
+		if err := renderCopyright(notices, config, holder); err != nil {
 			return err
 		}
 	}
 
-	if err := scanner.Err(); err != nil {
-		return fmt.Errorf("Scanner error: %v", err)
+	if notices.Len() == 0 {
+		return nil
 	}
 
-	unlicensedData := unlicensedFile.String()
-
-	//fmt.Fprintf(os.Stderr, "unl=%q\nlic=%q\n", unlicensedData, license)
-	unlicensedData = strings.Replace(unlicensedData, license, "", -1)
+	// New holders go right after the last copyright notice.
+	at := header.copyrights[len(header.copyrights)-1].end
 
-	// Strip multiple empty lines from before package.
-	if i := strings.Index(unlicensedData, "\npackage"); i >= 3 {
-		unlicensedData = strings.TrimRight(unlicensedData[:i], "\n") + unlicensedData[i:]
-	}
+	holderFile := bytes.NewBuffer(make([]byte, 0, len(licensedFile)+notices.Len()))
+	holderFile.Write(licensedFile[:at])
+	holderFile.Write(notices.Bytes())
+	holderFile.Write(licensedFile[at:])
 
-	mode := os.FileMode(0640)
-	fi, err := os.Stat(filename)
-	if err == nil {
-		mode = fi.Mode()
-	}
-	return ioutil.WriteFile(filename, []byte(unlicensedData), mode)
+	return writeFile(filename, holderFile.Bytes())
 }
 
 // Inserts license header to file represented by filename
-func insertLicense(filename string, replacer *strings.Replacer, config *Config) error {
+func insertLicense(filename string, config *Config) error {
 	licensedFile := bytes.NewBuffer(nil)
 
-	lcopyright, err := Asset(filepath.Join("licenses", string(config.LicenseType)+".copyright"))
+	if err := renderInsertedHeader(licensedFile, config); err != nil {
+		return err
+	}
+
+	fh, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(licensedFile, fh)
+	fh.Close()
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filename, licensedFile.Bytes(), 0640)
+}
+
+// Renders the license header exactly as Set inserts it at the top of files
+// into licensedFile.
+func renderInsertedHeader(licensedFile *bytes.Buffer, config *Config) error {
+	if err := renderHeader(licensedFile, config, config.holders()); err != nil {
+		return err
+	}
+	// Extra newline for separating license code from package docs.
+	return licensedFile.WriteByte('\n')
+}
 
+// Renders a copyright notice per holder followed by the license header, as
+// end-of-line comments, into licensedFile.
+func renderHeader(licensedFile *bytes.Buffer, config *Config, holders []CopyrightHolder) error {
 	cr := false
-	if err == nil {
-		err = prependEOLComment(licensedFile, config.EOLCommentStyle,
-			[]byte(replacer.Replace(string(lcopyright))))
-		if err != nil {
+	for _, holder := range holders {
+		if err := renderCopyright(licensedFile, config, holder); err != nil {
+			if os.IsNotExist(err) {
+				// This license does not use a copyright notice.
+				break
+			}
 			return err
 		}
 		cr = true
 	}
 
 	lheader, err := Asset(filepath.Join("licenses", string(config.LicenseType)+".header"))
+	if config.Expression != nil {
+		lheader, err = []byte("SPDX-License-Identifier: "+config.Expression.SPDX()+"\n"), nil
+	}
 	if err == nil {
 		plus := ""
 		if cr {
 			plus = "\n"
 		}
-		err := prependEOLComment(licensedFile, config.EOLCommentStyle,
-			[]byte(replacer.Replace(plus+string(lheader))))
+		err := prependEOLComment(licensedFile, config.EOLCommentStyle, []byte(plus+string(lheader)))
 		if err != nil {
 			return err
 		}
 	}
-	// Extra newline for separating license code from package docs.
-	licensedFile.WriteByte('\n')
-
-	// Only use the replacer for the license, not the whole file.
+	return nil
+}
 
-	fh, err := os.Open(filename)
-	if err != nil {
-		return err
-	}
-	_, err = io.Copy(licensedFile, fh)
-	fh.Close()
+// Renders the copyright notice of holder, as an end-of-line comment, into
+// licensedFile.
+func renderCopyright(licensedFile *bytes.Buffer, config *Config, holder CopyrightHolder) error {
+	lcopyright, err := Asset(filepath.Join("licenses", string(config.LicenseType)+".copyright"))
 	if err != nil {
 		return err
 	}
 
-	return ioutil.WriteFile(filename, licensedFile.Bytes(), 0640)
+	replacer := strings.NewReplacer(
+		"@@owner@@", holder.Name,
+		"@@year@@", holder.years(),
+	)
+	return prependEOLComment(licensedFile, config.EOLCommentStyle,
+		[]byte(replacer.Replace(string(lcopyright))))
+}
+
+// Writes data to filename keeping its current permissions.
+func writeFile(filename string, data []byte) error {
+	mode := os.FileMode(0640)
+	fi, err := os.Stat(filename)
+	if err == nil {
+		mode = fi.Mode()
+	}
+	return ioutil.WriteFile(filename, data, mode)
 }
 
 // Prepends end-of-line comment to newdata and returns it in licensedFile
@@ -396,24 +1139,16 @@ func prependEOLComment(licensedFile *bytes.Buffer, eol string, newdata []byte) e
 
 // List supported license types
 func List() ([]string, error) {
-	licenses, err := AssetDir("licenses")
-	if err != nil {
-		return nil, err
-	}
-
-	types := make([]string, 0, len(licenses))
-	for _, l := range licenses {
-		if strings.HasSuffix(l, "header") || strings.HasSuffix(l, "copyright") {
-			continue
-		}
-		types = append(types, l)
+	types := make([]string, len(catalog))
+	for i, info := range catalog {
+		types[i] = string(info.Type)
 	}
 	return types, nil
 }
 
 type fileLicense struct {
 	file    string
-	license LicenseType
+	license LicenseExpression
 }
 
 // Detect the licenses.
@@ -446,20 +1181,58 @@ func Detect(config *Config) ([]fileLicense, error) {
 	return types, errors
 }
 
-func detectLicense(filepath string) (LicenseType, error) {
-	fh, err := os.Open(filepath)
+// Detects the licenses of a file. Files under several licenses are
+// recognized by their SPDX-License-Identifier tag. Only the comments
+// preceding the package clause of Go files are considered.
+func detectLicense(filename string) (LicenseExpression, error) {
+	if isGoFile(filename) {
+		data, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return LicenseExpression{}, err
+		}
+		return detectGoLicense(data)
+	}
+
+	fh, err := os.Open(filename)
 	if err != nil {
-		return UNKNOWN, err
+		return LicenseExpression{}, err
 	}
 	defer fh.Close()
+	return detectLicenseIn(fh)
+}
 
-	var buf bytes.Buffer
-	scanner := bufio.NewScanner(fh)
-	for scanner.Scan() {
-		if bytes.HasPrefix(scanner.Bytes(), []byte("package ")) {
+// Detects the licenses of the file contents read from r, as detectLicense
+// does, up to a line starting a package clause. Lines may be of any length,
+// unlike the tokens of a bufio.Scanner.
+func detectLicenseIn(r io.Reader) (LicenseExpression, error) {
+	var lines [][]byte
+	reader := bufio.NewReader(r)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return LicenseExpression{}, err
+		}
+		if len(line) == 0 || bytes.HasPrefix(line, []byte("package ")) {
+			break
+		}
+		lines = append(lines, bytes.TrimSuffix(bytes.TrimSuffix(line, []byte("\n")), []byte("\r")))
+		if err == io.EOF {
 			break
 		}
-		line := bytes.TrimSuffix(bytes.TrimPrefix(bytes.TrimPrefix(scanner.Bytes(),
+	}
+	return detectLicenseLines(lines)
+}
+
+// Detects the licenses of the comment lines of a license header.
+func detectLicenseLines(lines [][]byte) (LicenseExpression, error) {
+	var buf bytes.Buffer
+	for _, line := range lines {
+		if m := spdxLicenseTagRe.FindSubmatch(line); m != nil {
+			if expr, err := ParseLicenseExpression(string(m[1])); err == nil && expr.SPDX() != "" {
+				return expr, nil
+			}
+		}
+		line = bytes.TrimSuffix(bytes.TrimPrefix(bytes.TrimPrefix(line,
 			[]byte("//")), []byte("/*")), []byte("*/"))
 		if len(line) > 0 && (line[0] == '+' || bytes.HasPrefix(bytes.TrimSpace(line), []byte("Copyright"))) {
 			continue
@@ -468,72 +1241,98 @@ func detectLicense(filepath string) (LicenseType, error) {
 		buf.WriteByte('\n')
 	}
 	//fmt.Fprintf(os.Stderr, "DETECT %q\n", strings.TrimSpace(buf.String()))
-	l := license.New("", strings.TrimSpace(buf.String()))
-	l.File = filepath
-	if err = l.GuessType(); err != nil {
-		if err == license.ErrUnrecognizedLicense {
-			return UNKNOWN, scanner.Err()
+	ltype, err := guessLicenseType(strings.TrimSpace(buf.String()))
+	if err != nil && err != license.ErrUnrecognizedLicense {
+		return LicenseExpression{}, err
+	}
+
+	return singleLicense(ltype), nil
+}
+
+// Distinctive phrases of the licenses go-license does not recognize, or
+// mistakes for others, in lower case and with single spaces.
+var licenseSignatures = []struct {
+	ltype   LicenseType
+	phrases []string
+}{
+	{GPL2Only, []string{"under the terms of the gnu general public license version 2 as published by the free software foundation"}},
+	{GPL3Only, []string{"under the terms of the gnu general public license version 3 as published by the free software foundation"}},
+	{LGPL2Only, []string{"under the terms of the gnu lesser general public license version 2.1 as published by the free software foundation"}},
+	{LGPL3Only, []string{"under the terms of the gnu lesser general public license version 3 as published by the free software foundation"}},
+	{AGPL3Only, []string{"under the terms of the gnu affero general public license version 3 as published by the free software foundation"}},
+	{AGPL3, []string{"under the terms of the gnu affero general public license as published by the free software foundation, either version 3"}},
+	// The LGPL texts mention the GPL.
+	{LGPL2, []string{"under the terms of the gnu lesser general public license as published by the free software foundation; either version 2.1"}},
+	{LGPL3, []string{"under the terms of the gnu lesser general public license as published by the free software foundation, either version 3"}},
+	{GPL2, []string{"under the terms of the gnu general public license as published by the free software foundation; either version 2"}},
+	{GPL3, []string{"under the terms of the gnu general public license as published by the free software foundation, either version 3"}},
+	{EPL, []string{"eclipse public license v1.0"}},
+	{CDDL, []string{"common development and distribution license"}},
+	{UNLICENSE, []string{"free and unencumbered software released into the public domain"}},
+	{UPL, []string{"the universal permissive license (upl), version 1.0"}},
+	{ZeroBSD, []string{"with or without fee is hereby granted. the software is provided"}},
+	{Zlib, []string{"permission is granted to anyone to use this software for any purpose, including commercial applications, and to alter it and redistribute it freely"}},
+	{BSL, []string{"boost software license"}},
+	{EUPL, []string{"european union public licence v. 1.2", "licensed under the eupl, version 1.2"}},
+	{EPL2, []string{"eclipse public license - v 2.0", "eclipse public license 2.0"}},
+	// The CC BY text mentions the CC0 dedication.
+	{CCBY, []string{"creative commons attribution 4.0 international"}},
+	{CC0, []string{"cc0 1.0 universal", "cc0 public domain dedication"}},
+}
+
+// Guesses the license of a license text or header. An error is returned if
+// it is not recognized.
+func guessLicenseType(text string) (LicenseType, error) {
+	comp := strings.ToLower(strings.Join(strings.Fields(text), " "))
+	for _, sig := range licenseSignatures {
+		for _, phrase := range sig.phrases {
+			if strings.Contains(comp, phrase) {
+				return sig.ltype, nil
+			}
 		}
+	}
+
+	l := license.New("", text)
+	if err := l.GuessType(); err != nil {
 		return UNKNOWN, err
 	}
+	if ltype := goLicenseType(l.Type); ltype != UNKNOWN {
+		return ltype, nil
+	}
+	return UNKNOWN, fmt.Errorf("unsupported license %s", l.Type)
+}
 
-	err = scanner.Err()
-	switch l.Type {
+// Maps license types recognized by go-license to ours.
+func goLicenseType(ltype string) LicenseType {
+	switch ltype {
 	case license.LicenseMIT:
-		return MIT, err
+		return MIT
 	case license.LicenseNewBSD:
-		return NewBSD, err
+		return NewBSD
 	case license.LicenseFreeBSD:
-		return Freebsd, err
+		return Freebsd
 	case license.LicenseApache20:
-		return Apache2, err
+		return Apache2
 	case license.LicenseMPL20:
-		return MPL2, err
+		return MPL2
 	case license.LicenseGPL20:
-		return GPL2, err
+		return GPL2
 	case license.LicenseGPL30:
-		return GPL3, err
+		return GPL3
 	case license.LicenseLGPL21:
-		return LGPL2, err
+		return LGPL2
 	case license.LicenseLGPL30:
-		return LGPL2, err
+		return LGPL3
 	case license.LicenseCDDL10:
-		return CDDL, err
+		return CDDL
 	case license.LicenseEPL10:
-		return EPL, err
-	}
-	return UNKNOWN, err
-}
-
-func assetPath(path string) string {
-	if !strings.HasPrefix(path, "/") {
-		path = "/" + path
-	}
-	path = strings.TrimPrefix(path, "/licenses")
-	if !strings.HasPrefix(path, "/") {
-		path = "/" + path
-	}
-	return path
-}
-func Asset(path string) ([]byte, error) {
-	fh, err := statikFS.Open(assetPath(path))
-	if err != nil {
-		return nil, err
-	}
-	defer fh.Close()
-	return ioutil.ReadAll(fh)
-}
-
-func AssetDir(path string) ([]string, error) {
-	dh, err := statikFS.Open(assetPath(path))
-	if err != nil {
-		return nil, err
-	}
-	defer dh.Close()
-	fis, err := dh.Readdir(-1)
-	names := make([]string, len(fis))
-	for i, fi := range fis {
-		names[i] = fi.Name()
+		return EPL
+	case license.LicenseAGPL30:
+		return AGPL3
+	case license.LicenseISC:
+		return ISC
+	case license.LicenseUnlicense:
+		return UNLICENSE
 	}
-	return names, err
+	return UNKNOWN
 }
@@ -5,15 +5,42 @@
 package main
 
 import (
+	"bytes"
 	"fmt"
 	"io/ioutil"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"reflect"
 	"runtime"
+	"strings"
 	"testing"
 )
 
+// Set in the environment of the test binary to run licentia instead of the
+// tests, see runLicentia.
+const mainEnv = "LICENTIA_TEST_MAIN"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(mainEnv) == "1" {
+		main()
+	}
+	os.Exit(m.Run())
+}
+
+// Runs licentia with args in dir and returns its output and exit code.
+func runLicentia(t *testing.T, dir string, args ...string) (string, int) {
+	cmd := exec.Command(os.Args[0], args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), mainEnv+"=1")
+	out, err := cmd.CombinedOutput()
+	if exitErr, isExit := err.(*exec.ExitError); isExit {
+		return string(out), exitErr.ExitCode()
+	}
+	ok(t, err)
+	return string(out), 0
+}
+
 var mpl2 = `// This Source Code Form is subject to the terms of the Mozilla Public
 // License, version 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
@@ -35,7 +62,7 @@ func TestSetUnset(t *testing.T) {
 		EOLCommentStyle: "//",
 	}
 
-	err = Set(config)
+	_, err = Set(config)
 	ok(t, err)
 
 	data, err := ioutil.ReadFile(filepath)
@@ -43,19 +70,44 @@ func TestSetUnset(t *testing.T) {
 
 	equals(t, mpl2, string(data))
 
-	err = Unset(config)
+	_, err = Unset(config)
 	ok(t, err)
 
 	data, err = ioutil.ReadFile(filepath)
 	ok(t, err)
 
-	equals(t, "\n", string(data))
+	equals(t, "", string(data))
+}
+
+func TestSetReplaceLongLines(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "licentia-tests-")
+	ok(t, err)
+	defer os.RemoveAll(dir)
+
+	// A line longer than the 64KB tokens of bufio.Scanner, in a file that
+	// is not minified.
+	file := filepath.Join(dir, "data.js")
+	code := strings.Repeat("console.log(data);\n", 1<<10) + "var data = '" + strings.Repeat("x", 70<<10) + "';\n"
+	ok(t, ioutil.WriteFile(file, []byte(code), 0640))
+
+	config := &Config{CopyrightOwner: "Test", LicenseType: MIT, Files: []string{file}, EOLCommentStyle: "//"}
+	skipped, err := Set(config)
+	ok(t, err)
+	equals(t, 0, len(skipped))
+
+	config.LicenseType, config.Replace = MPL2, true
+	_, err = Set(config)
+	ok(t, err)
+
+	data, err := ioutil.ReadFile(file)
+	ok(t, err)
+	equals(t, mpl2+code, string(data))
 }
 
 func TestList(t *testing.T) {
 	types, err := List()
 	ok(t, err)
-	assert(t, len(types) == 12, "Number of supported licenses should be 12")
+	assert(t, len(types) == 27, "Number of supported licenses should be 27")
 }
 
 func TestDump(t *testing.T) {
@@ -69,7 +121,69 @@ func TestDump(t *testing.T) {
 }
 
 func TestDetect(t *testing.T) {
-	//TODO(c4milo)
+	for _, info := range catalog {
+		ltype := info.Type
+		file, err := ioutil.TempFile(os.TempDir(), "licentia-tests-")
+		ok(t, err)
+		defer os.Remove(file.Name())
+		ok(t, ioutil.WriteFile(file.Name(), []byte("package main\n"), 0640))
+
+		config := &Config{
+			CopyrightOwner:  "Test Inc.",
+			LicenseType:     ltype,
+			Files:           []string{file.Name()},
+			EOLCommentStyle: "//",
+		}
+		_, err = Set(config)
+		ok(t, err)
+
+		detected, err := detectLicense(file.Name())
+		ok(t, err)
+		equals(t, singleLicense(ltype), detected)
+
+		if !info.Text {
+			continue
+		}
+		text, err := Dump(ltype, "Test Inc.")
+		ok(t, err)
+		guessed, err := guessLicenseType(text)
+		ok(t, err)
+		// The -only licenses share the text of their or-later version.
+		equals(t, orLater(ltype), guessed)
+	}
+}
+
+func TestDetectOnlyVersions(t *testing.T) {
+	for _, ltype := range []LicenseType{GPL2Only, GPL3Only, LGPL2Only, LGPL3Only, AGPL3Only} {
+		header := bytes.NewBuffer(nil)
+		ok(t, renderInsertedHeader(header, &Config{CopyrightOwner: "Test Inc.", LicenseType: ltype, EOLCommentStyle: "//"}))
+
+		detected, err := detectLicenseIn(header)
+		ok(t, err)
+		equals(t, singleLicense(ltype), detected)
+	}
+}
+
+func TestExitCode(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "licentia-tests-")
+	ok(t, err)
+	defer os.RemoveAll(dir)
+
+	ok(t, ioutil.WriteFile(filepath.Join(dir, "main.go"), []byte(mpl2+"package main\n"), 0640))
+
+	for _, args := range [][]string{
+		{"policy", "--config=missing.yml", "main.go"},
+		{"compat", "foo", "main.go"},
+		{"notice", "--check", "foo", "Test"},
+		{"relicense", "--from=foo", "--to=mit", "Test", "//", "main.go"},
+	} {
+		out, code := runLicentia(t, dir, args...)
+		assert(t, code != 0, "licentia %v should fail, got:\n%s", args, out)
+	}
+
+	out, code := runLicentia(t, dir, "compat", "mpl2", "main.go")
+	equals(t, 0, code)
+	equals(t, "", out)
 }
 
 // assert fails the test if the condition is false.
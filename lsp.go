@@ -0,0 +1,498 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/textproto"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Language Server Protocol support. See
+// https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/
+
+// JSON-RPC error codes.
+const (
+	lspParseError     = -32700
+	lspMethodNotFound = -32601
+	lspInvalidParams  = -32602
+)
+
+// Largest message accepted from the client. Messages carry whole documents,
+// which may exceed the size of the files licentia processes.
+const lspMaxContentLength = 16 << 20
+
+// Diagnostic severities.
+const (
+	lspSeverityError   = 1
+	lspSeverityWarning = 2
+)
+
+// End-of-line comment style of the documents, by language identifier.
+// Documents in other languages are given Config.EOLCommentStyle, if any.
+var lspCommentStyles = map[string]string{
+	"c": "//", "cpp": "//", "csharp": "//", "dart": "//", "go": "//",
+	"groovy": "//", "java": "//", "javascript": "//", "javascriptreact": "//",
+	"kotlin": "//", "php": "//", "proto": "//", "rust": "//", "scala": "//",
+	"swift": "//", "typescript": "//", "typescriptreact": "//",
+	"dockerfile": "#", "elixir": "#", "makefile": "#", "perl": "#",
+	"powershell": "#", "python": "#", "r": "#", "ruby": "#", "shellscript": "#",
+	"toml": "#", "yaml": "#",
+	"haskell": "--", "lua": "--", "sql": "--",
+}
+
+// Range of the years of a copyright notice ending with one.
+var yearRangeRe = regexp.MustCompile(`(\d{4})\s*-\s*\d{4}$`)
+
+// Request or notification, which has no ID.
+type lspMessage struct {
+	JSONRPC string           `json:"jsonrpc"`
+	ID      *json.RawMessage `json:"id,omitempty"`
+	Method  string           `json:"method"`
+	Params  json.RawMessage  `json:"params,omitempty"`
+}
+
+// Error returned to the client in the response to a request.
+type lspError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *lspError) Error() string {
+	return e.Message
+}
+
+type lspPosition struct {
+	Line      int `json:"line"`
+	Character int `json:"character"`
+}
+
+type lspRange struct {
+	Start lspPosition `json:"start"`
+	End   lspPosition `json:"end"`
+}
+
+type lspTextEdit struct {
+	Range   lspRange `json:"range"`
+	NewText string   `json:"newText"`
+}
+
+type lspDiagnostic struct {
+	Range    lspRange `json:"range"`
+	Severity int      `json:"severity"`
+	Source   string   `json:"source"`
+	Message  string   `json:"message"`
+}
+
+type lspCodeAction struct {
+	Title       string          `json:"title"`
+	Kind        string          `json:"kind"`
+	Diagnostics []lspDiagnostic `json:"diagnostics"`
+	Edit        struct {
+		Changes map[string][]lspTextEdit `json:"changes"`
+	} `json:"edit"`
+}
+
+type lspDocument struct {
+	URI        string `json:"uri"`
+	LanguageID string `json:"languageId"`
+	Text       string `json:"text"`
+}
+
+// Returns the name of doc, which tells whether it is a Go file.
+func (d *lspDocument) name() string {
+	if d.LanguageID == "go" && !isGoFile(d.URI) {
+		return d.URI + ".go"
+	}
+	return d.URI
+}
+
+// Problem found in a document, along with the edit fixing it, if any.
+type lspProblem struct {
+	diagnostic lspDiagnostic
+	title      string
+	edit       *lspTextEdit
+}
+
+type lspServer struct {
+	config *Config
+	// Open documents, by URI.
+	docs     map[string]*lspDocument
+	w        io.Writer
+	shutdown bool
+	// Returns the current time, which tells whether copyright years are
+	// outdated.
+	now func() time.Time
+}
+
+// Speaks the Language Server Protocol over r and w until the client exits.
+// Open documents are checked for the license header of config when opened
+// and saved, and quick fixes inserting the header or updating copyright
+// years are offered as code actions.
+func ServeLSP(r io.Reader, w io.Writer, config *Config) error {
+	s := &lspServer{config: config, docs: make(map[string]*lspDocument), w: w, now: time.Now}
+	return s.serve(r)
+}
+
+func (s *lspServer) serve(r io.Reader) error {
+	reader := textproto.NewReader(bufio.NewReader(r))
+	for {
+		header, err := reader.ReadMIMEHeader()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		length, err := strconv.Atoi(header.Get("Content-Length"))
+		if err != nil {
+			return fmt.Errorf("invalid Content-Length: %v", err)
+		}
+		if length < 0 || length > lspMaxContentLength {
+			return fmt.Errorf("invalid Content-Length: %d is not between 0 and %d", length, lspMaxContentLength)
+		}
+		body := make([]byte, length)
+		if _, err := io.ReadFull(reader.R, body); err != nil {
+			return err
+		}
+
+		var msg lspMessage
+		if err := json.Unmarshal(body, &msg); err != nil {
+			null := json.RawMessage("null")
+			if err := s.reply(&null, nil, &lspError{lspParseError, err.Error()}); err != nil {
+				return err
+			}
+			continue
+		}
+		if msg.Method == "exit" {
+			if !s.shutdown {
+				return fmt.Errorf("exit requested before shutdown")
+			}
+			return nil
+		}
+
+		result, err := s.handle(msg.Method, msg.Params)
+		rerr, ok := err.(*lspError)
+		if err != nil && !ok {
+			return err
+		}
+		if msg.ID == nil {
+			// Notifications get no response.
+			continue
+		}
+		if err := s.reply(msg.ID, result, rerr); err != nil {
+			return err
+		}
+	}
+}
+
+// Handles a request or notification. Errors other than *lspError are
+// failures to write to the client.
+func (s *lspServer) handle(method string, params json.RawMessage) (interface{}, error) {
+	var p struct {
+		TextDocument   lspDocument `json:"textDocument"`
+		ContentChanges []struct {
+			Text string `json:"text"`
+		} `json:"contentChanges"`
+		Text  *string  `json:"text"`
+		Range lspRange `json:"range"`
+	}
+	if len(params) > 0 {
+		if err := json.Unmarshal(params, &p); err != nil {
+			return nil, &lspError{lspInvalidParams, err.Error()}
+		}
+	}
+	doc := s.docs[p.TextDocument.URI]
+
+	switch method {
+	case "initialize":
+		return map[string]interface{}{
+			"capabilities": map[string]interface{}{
+				"textDocumentSync": map[string]interface{}{
+					"openClose": true,
+					// Full document text on every change.
+					"change": 1,
+					"save":   map[string]bool{"includeText": true},
+				},
+				"codeActionProvider": map[string][]string{"codeActionKinds": {"quickfix"}},
+			},
+			"serverInfo": map[string]string{"name": "licentia", "version": Version},
+		}, nil
+	case "shutdown":
+		s.shutdown = true
+		return nil, nil
+	case "textDocument/didOpen":
+		doc := p.TextDocument
+		s.docs[doc.URI] = &doc
+		return nil, s.publish(&doc)
+	case "textDocument/didChange":
+		if doc != nil && len(p.ContentChanges) > 0 {
+			doc.Text = p.ContentChanges[len(p.ContentChanges)-1].Text
+		}
+		return nil, nil
+	case "textDocument/didSave":
+		if doc == nil {
+			return nil, nil
+		}
+		if p.Text != nil {
+			doc.Text = *p.Text
+		}
+		return nil, s.publish(doc)
+	case "textDocument/didClose":
+		delete(s.docs, p.TextDocument.URI)
+		return nil, s.notify("textDocument/publishDiagnostics", map[string]interface{}{
+			"uri":         p.TextDocument.URI,
+			"diagnostics": []lspDiagnostic{},
+		})
+	case "textDocument/codeAction":
+		actions := []lspCodeAction{}
+		if doc == nil {
+			return actions, nil
+		}
+		for _, problem := range s.check(doc) {
+			if problem.edit == nil || !overlaps(problem.diagnostic.Range, p.Range) {
+				continue
+			}
+			action := lspCodeAction{Title: problem.title, Kind: "quickfix", Diagnostics: []lspDiagnostic{problem.diagnostic}}
+			action.Edit.Changes = map[string][]lspTextEdit{doc.URI: {*problem.edit}}
+			actions = append(actions, action)
+		}
+		return actions, nil
+	case "initialized", "$/cancelRequest", "$/setTrace":
+		return nil, nil
+	}
+	return nil, &lspError{lspMethodNotFound, fmt.Sprintf("method %q is not supported", method)}
+}
+
+// Publishes the diagnostics of doc.
+func (s *lspServer) publish(doc *lspDocument) error {
+	diagnostics := []lspDiagnostic{}
+	for _, problem := range s.check(doc) {
+		diagnostics = append(diagnostics, problem.diagnostic)
+	}
+	return s.notify("textDocument/publishDiagnostics", map[string]interface{}{
+		"uri":         doc.URI,
+		"diagnostics": diagnostics,
+	})
+}
+
+// Checks that doc starts with the configured license header, as Set inserts
+// it, and that the copyright notices of the configured holders are up to
+// date. Binary, generated and minified documents, and the ones whose comment
+// style is unknown, are not checked.
+func (s *lspServer) check(doc *lspDocument) []lspProblem {
+	data := []byte(doc.Text)
+	if sniff(data, true) != "" {
+		return nil
+	}
+
+	config := *s.config
+	if style, ok := lspCommentStyles[doc.LanguageID]; ok {
+		config.EOLCommentStyle = style
+	}
+	if config.EOLCommentStyle == "" {
+		return nil
+	}
+	// Inserted notices get the current year of the server.
+	year := strconv.Itoa(s.now().Year())
+	config.CopyrightHolders = nil
+	for _, holder := range s.config.holders() {
+		config.CopyrightHolders = append(config.CopyrightHolders, CopyrightHolder{Name: holder.Name, Years: year})
+	}
+	config.CopyrightOwner = ""
+
+	expected := config.licenseExpression()
+	problem := func(severity int, message string) lspProblem {
+		return lspProblem{diagnostic: lspDiagnostic{
+			Range:    lspRange{End: lspPosition{Line: 1}},
+			Severity: severity,
+			Source:   "licentia",
+			Message:  message,
+		}}
+	}
+
+	tmpl, err := newHeaderTemplate(&config)
+	if err != nil {
+		return []lspProblem{problem(lspSeverityError, err.Error())}
+	}
+	header, err := findHeader(data, tmpl, doc.name())
+	if err != nil {
+		return []lspProblem{problem(lspSeverityError, err.Error())}
+	}
+
+	if header == nil {
+		var detected LicenseExpression
+		if isGoFile(doc.name()) {
+			detected, _ = detectGoLicense(data)
+		} else {
+			detected, _ = detectLicenseIn(bytes.NewReader(data))
+		}
+		if detected.IsUnknown() {
+			p := problem(lspSeverityError, "missing license header")
+			p.title = fmt.Sprintf("Insert %s header", spdxOrType(expected))
+			if p.edit, err = insertHeaderEdit(&config); err != nil {
+				p.edit = nil
+			}
+			return []lspProblem{p}
+		}
+
+		p := problem(lspSeverityError, fmt.Sprintf("licensed under %s instead of %s", detected, expected))
+		p.title = fmt.Sprintf("Replace %s header with %s header", spdxOrType(detected), spdxOrType(expected))
+		if p.edit, err = replaceHeaderEdit(data, doc.name(), &config, detected); err != nil {
+			p.edit = nil
+		}
+		return []lspProblem{p}
+	}
+
+	var problems []lspProblem
+	for _, notice := range header.copyrights {
+		if notice.owner == "" || !config.isHolder(notice.owner) {
+			continue
+		}
+		years := strings.TrimSpace(notice.years)
+		if years[len(years)-4:] >= year {
+			continue
+		}
+
+		updated := years + "-" + year
+		if m := yearRangeRe.FindStringSubmatch(years); m != nil {
+			updated = years[:len(years)-len(m[0])] + m[1] + "-" + year
+		}
+		line := bytes.NewBuffer(nil)
+		if err := renderCopyright(line, &config, CopyrightHolder{Name: notice.owner, Years: updated}); err != nil {
+			continue
+		}
+
+		rng := lspRange{Start: lspPos(data, notice.start), End: lspPos(data, notice.end)}
+		problems = append(problems, lspProblem{
+			diagnostic: lspDiagnostic{
+				Range:    rng,
+				Severity: lspSeverityWarning,
+				Source:   "licentia",
+				Message:  fmt.Sprintf("copyright year of %s is outdated", notice.owner),
+			},
+			title: "Update copyright year",
+			edit:  &lspTextEdit{Range: rng, NewText: line.String()},
+		})
+	}
+	return problems
+}
+
+// Returns the edit inserting the license header at the top of data, as
+// insertLicense does.
+func insertHeaderEdit(config *Config) (*lspTextEdit, error) {
+	header := bytes.NewBuffer(nil)
+	if err := renderInsertedHeader(header, config); err != nil {
+		return nil, err
+	}
+	return &lspTextEdit{NewText: header.String()}, nil
+}
+
+// Returns the edit replacing the header of the detected license by the
+// configured one in the document name, as Set does with Config.Replace.
+// Copyright notices of holders other than the configured ones are kept.
+func replaceHeaderEdit(data []byte, name string, config *Config, detected LicenseExpression) (*lspTextEdit, error) {
+	tmpl, err := newHeaderTemplate(config.withExpression(detected))
+	if err != nil {
+		return nil, err
+	}
+	header, err := findHeader(data, tmpl, name)
+	if err != nil {
+		return nil, err
+	}
+	if header == nil {
+		return nil, fmt.Errorf("no %s license header found", detected)
+	}
+
+	replaced := bytes.NewBuffer(nil)
+	if err := renderInsertedHeader(replaced, config); err != nil {
+		return nil, err
+	}
+	replaced.Write(data[:header.start])
+	var foreign int
+	for _, c := range header.copyrights {
+		if !config.isHolder(c.owner) {
+			replaced.Write(data[c.start:c.end])
+			foreign++
+		}
+	}
+	if foreign > 0 {
+		replaced.WriteByte('\n')
+	}
+
+	return &lspTextEdit{
+		Range:   lspRange{End: lspPos(data, header.end)},
+		NewText: replaced.String(),
+	}, nil
+}
+
+// Returns the position of offset in data. Characters are counted in UTF-16
+// code units, as the protocol requires.
+func lspPos(data []byte, offset int) lspPosition {
+	var pos lspPosition
+	for _, r := range string(data[:offset]) {
+		if r == '\n' {
+			pos.Line++
+			pos.Character = 0
+			continue
+		}
+		if r >= 0x10000 {
+			// Surrogate pair.
+			pos.Character++
+		}
+		pos.Character++
+	}
+	return pos
+}
+
+func overlaps(a, b lspRange) bool {
+	return !before(a.End, b.Start) && !before(b.End, a.Start)
+}
+
+func before(a, b lspPosition) bool {
+	return a.Line < b.Line || (a.Line == b.Line && a.Character < b.Character)
+}
+
+func spdxOrType(expr LicenseExpression) string {
+	if id := expr.SPDX(); id != "" {
+		return id
+	}
+	return expr.String()
+}
+
+func (s *lspServer) reply(id *json.RawMessage, result interface{}, rerr *lspError) error {
+	resp := map[string]interface{}{"jsonrpc": "2.0", "id": id}
+	if rerr != nil {
+		resp["error"] = rerr
+	} else {
+		// Successful responses have a result, even if null.
+		resp["result"] = result
+	}
+	return s.write(resp)
+}
+
+func (s *lspServer) notify(method string, params interface{}) error {
+	data, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	return s.write(lspMessage{JSONRPC: "2.0", Method: method, Params: data})
+}
+
+func (s *lspServer) write(msg interface{}) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(s.w, "Content-Length: %d\r\n\r\n%s", len(data), data)
+	return err
+}
@@ -0,0 +1,157 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Frames the given messages as an LSP client would.
+func lspInput(t *testing.T, messages ...interface{}) io.Reader {
+	buf := bytes.NewBuffer(nil)
+	for _, msg := range messages {
+		data, err := json.Marshal(msg)
+		ok(t, err)
+		fmt.Fprintf(buf, "Content-Length: %d\r\n\r\n%s", len(data), data)
+	}
+	return buf
+}
+
+// Returns the messages written by the server.
+func lspOutput(t *testing.T, r io.Reader) []map[string]json.RawMessage {
+	var messages []map[string]json.RawMessage
+	reader := textproto.NewReader(bufio.NewReader(r))
+	for {
+		header, err := reader.ReadMIMEHeader()
+		if err == io.EOF {
+			return messages
+		}
+		ok(t, err)
+		length, err := strconv.Atoi(header.Get("Content-Length"))
+		ok(t, err)
+		body := make([]byte, length)
+		_, err = io.ReadFull(reader.R, body)
+		ok(t, err)
+
+		var msg map[string]json.RawMessage
+		ok(t, json.Unmarshal(body, &msg))
+		messages = append(messages, msg)
+	}
+}
+
+// Returns the header Set inserts in Go files for a single holder.
+func lspHeader(t *testing.T, ltype LicenseType, owner, years string) string {
+	header := bytes.NewBuffer(nil)
+	ok(t, renderInsertedHeader(header, &Config{CopyrightHolders: []CopyrightHolder{{Name: owner, Years: years}}, LicenseType: ltype, EOLCommentStyle: "//"}))
+	return header.String()
+}
+
+func TestLSP(t *testing.T) {
+	const uri = "file:///project/main.go"
+	open := func(text string) map[string]interface{} {
+		return map[string]interface{}{"jsonrpc": "2.0", "method": "textDocument/didOpen", "params": map[string]interface{}{
+			"textDocument": map[string]interface{}{"uri": uri, "languageId": "go", "version": 1, "text": text},
+		}}
+	}
+	codeAction := func(id int) map[string]interface{} {
+		return map[string]interface{}{"jsonrpc": "2.0", "id": id, "method": "textDocument/codeAction", "params": map[string]interface{}{
+			"textDocument": map[string]string{"uri": uri},
+			"range":        lspRange{End: lspPosition{Line: 1}},
+		}}
+	}
+
+	outdated := lspHeader(t, MIT, "Test", "2015") + "package main\n"
+
+	in := lspInput(t,
+		map[string]interface{}{"jsonrpc": "2.0", "id": 1, "method": "initialize", "params": map[string]interface{}{}},
+		map[string]interface{}{"jsonrpc": "2.0", "method": "initialized", "params": map[string]interface{}{}},
+		open("package main\n"),
+		codeAction(2),
+		open(outdated),
+		codeAction(3),
+		map[string]interface{}{"jsonrpc": "2.0", "id": 4, "method": "workspace/symbol", "params": map[string]interface{}{}},
+		map[string]interface{}{"jsonrpc": "2.0", "id": 5, "method": "shutdown"},
+		map[string]interface{}{"jsonrpc": "2.0", "method": "exit"},
+	)
+	out := bytes.NewBuffer(nil)
+
+	config := &Config{CopyrightOwner: "Test", LicenseType: MIT}
+	s := &lspServer{config: config, docs: make(map[string]*lspDocument), w: out, now: func() time.Time {
+		return time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	}}
+	ok(t, s.serve(in))
+
+	messages := lspOutput(t, out)
+	equals(t, 7, len(messages))
+
+	var diagnostics struct {
+		URI         string
+		Diagnostics []lspDiagnostic
+	}
+	ok(t, json.Unmarshal(messages[1]["params"], &diagnostics))
+	equals(t, uri, diagnostics.URI)
+	equals(t, 1, len(diagnostics.Diagnostics))
+	equals(t, "missing license header", diagnostics.Diagnostics[0].Message)
+
+	var actions []lspCodeAction
+	ok(t, json.Unmarshal(messages[2]["result"], &actions))
+	equals(t, 1, len(actions))
+	equals(t, "Insert MIT header", actions[0].Title)
+	equals(t, []lspTextEdit{{NewText: lspHeader(t, MIT, "Test", "2020")}}, actions[0].Edit.Changes[uri])
+
+	ok(t, json.Unmarshal(messages[3]["params"], &diagnostics))
+	equals(t, 1, len(diagnostics.Diagnostics))
+	equals(t, "copyright year of Test is outdated", diagnostics.Diagnostics[0].Message)
+	equals(t, lspSeverityWarning, diagnostics.Diagnostics[0].Severity)
+
+	ok(t, json.Unmarshal(messages[4]["result"], &actions))
+	equals(t, 1, len(actions))
+	equals(t, "Update copyright year", actions[0].Title)
+	equals(t, []lspTextEdit{{Range: lspRange{End: lspPosition{Line: 1}}, NewText: "// Copyright (c) 2015-2020 Test\n"}}, actions[0].Edit.Changes[uri])
+
+	var rerr lspError
+	ok(t, json.Unmarshal(messages[5]["error"], &rerr))
+	equals(t, lspMethodNotFound, rerr.Code)
+
+	equals(t, "null", string(messages[6]["result"]))
+}
+
+func TestLSPContentLength(t *testing.T) {
+	for _, length := range []string{"-1", strconv.Itoa(lspMaxContentLength + 1), "x"} {
+		s := &lspServer{config: &Config{}, docs: make(map[string]*lspDocument), w: ioutil.Discard, now: time.Now}
+		err := s.serve(strings.NewReader("Content-Length: " + length + "\r\n\r\n{}"))
+		assert(t, err != nil, "Content-Length %s should be rejected", length)
+	}
+}
+
+func TestLSPReplaceHeader(t *testing.T) {
+	config := &Config{CopyrightOwner: "Test", LicenseType: MPL2}
+	s := &lspServer{config: config, now: time.Now}
+
+	old := lspHeader(t, MIT, "Other", "2015")
+	doc := &lspDocument{LanguageID: "go", Text: old + "package main\n"}
+	problems := s.check(doc)
+	equals(t, 1, len(problems))
+	equals(t, "licensed under mit instead of mpl2", problems[0].diagnostic.Message)
+	equals(t, "Replace MIT header with MPL-2.0 header", problems[0].title)
+	equals(t, lspPosition{Line: strings.Count(old, "\n")}, problems[0].edit.Range.End)
+	equals(t, mpl2+"// Copyright (c) 2015 Other\n\n", problems[0].edit.NewText)
+
+	// Documents that cannot carry comments are not checked.
+	doc = &lspDocument{LanguageID: "json", Text: "{}\n"}
+	equals(t, 0, len(s.check(doc)))
+
+	equals(t, lspPosition{Line: 1, Character: 3}, lspPos([]byte("a\n\U0001F600b"), 7))
+}
@@ -0,0 +1,191 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// Files generated by Notices, which are also the names of the templates
+// rendering them.
+const (
+	NoticeFile           = "NOTICE"
+	ThirdPartyNoticeFile = "THIRD_PARTY_NOTICES.txt"
+)
+
+// Default templates. A template file given to Notices may redefine any of
+// them with {{define "NOTICE"}}...{{end}}.
+const noticeTemplates = `{{define "NOTICE"}}{{.Name}}
+{{range .Copyrights}}{{.}}
+{{end}}{{range .Dependencies}}{{if .Notice}}
+-------------------------------------------------------------------------------
+{{.Name}} {{.Version}}
+
+{{.Notice}}
+{{end}}{{end}}{{end}}
+
+{{- define "THIRD_PARTY_NOTICES.txt"}}Third-party software notices for {{.Name}}
+{{range .Dependencies}}
+===============================================================================
+{{.Name}} {{.Version}} ({{.Ecosystem}})
+License: {{.License}}
+{{range .LicenseTexts}}
+{{.}}
+{{end}}{{if .Notice}}
+{{.Notice}}
+{{end}}{{end}}{{end}}`
+
+// Data available to notice templates.
+type noticeData struct {
+	// Project name.
+	Name string
+	// Copyright notices of the project, as rendered by the copyright
+	// template of its license.
+	Copyrights   []string
+	Dependencies []noticeDependency
+}
+
+type noticeDependency struct {
+	Ecosystem string
+	Name      string
+	Version   string
+	// SPDX license expression.
+	License string
+	// Contents of the license files of the dependency.
+	LicenseTexts []string
+	// Contents of the NOTICE files of the dependency, if any.
+	Notice string
+}
+
+// Collects the copyright notices of the project and the license texts and
+// notices of its dependencies.
+func BuildNotices(name string, config *Config, deps []dependency) (*noticeData, error) {
+	data := &noticeData{Name: name}
+
+	copyrightConfig := *config
+	copyrightConfig.EOLCommentStyle = ""
+	for _, holder := range config.holders() {
+		buf := bytes.NewBuffer(nil)
+		err := renderCopyright(buf, &copyrightConfig, holder)
+		if os.IsNotExist(err) {
+			// This license does not use a copyright notice.
+			fmt.Fprintf(buf, "Copyright %s %s\n", holder.years(), holder.Name)
+		} else if err != nil {
+			return nil, err
+		}
+		data.Copyrights = append(data.Copyrights, strings.Split(strings.TrimSpace(buf.String()), "\n")...)
+	}
+
+	for _, dep := range deps {
+		nd := noticeDependency{
+			Ecosystem: dep.ecosystem,
+			Name:      dep.name,
+			Version:   dep.version,
+			License:   dep.spdx(),
+		}
+
+		fis, err := ioutil.ReadDir(dep.dir)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+
+		var notices []string
+		for _, fi := range fis {
+			if fi.IsDir() {
+				continue
+			}
+
+			isNotice := isNoticeFile(fi.Name())
+			if !isNotice && !isLicenseFile(fi.Name()) {
+				continue
+			}
+
+			text, err := ioutil.ReadFile(filepath.Join(dep.dir, fi.Name()))
+			if err != nil {
+				return nil, err
+			}
+
+			if isNotice {
+				notices = append(notices, normalizeNotice(text))
+			} else {
+				nd.LicenseTexts = append(nd.LicenseTexts, normalizeNotice(text))
+			}
+		}
+		nd.Notice = strings.Join(notices, "\n\n")
+
+		data.Dependencies = append(data.Dependencies, nd)
+	}
+
+	sort.SliceStable(data.Dependencies, func(i, j int) bool {
+		a, b := data.Dependencies[i], data.Dependencies[j]
+		if a.Ecosystem != b.Ecosystem {
+			return a.Ecosystem < b.Ecosystem
+		}
+		return a.Name < b.Name
+	})
+	return data, nil
+}
+
+// Renders NOTICE and THIRD_PARTY_NOTICES.txt, by file name, using the
+// default templates as redefined by templateFile, if not empty.
+func RenderNotices(data *noticeData, templateFile string) (map[string][]byte, error) {
+	tmpl, err := template.New("notices").Parse(noticeTemplates)
+	if err != nil {
+		return nil, err
+	}
+
+	if templateFile != "" {
+		if tmpl, err = tmpl.ParseFiles(templateFile); err != nil {
+			return nil, err
+		}
+	}
+
+	files := make(map[string][]byte, 2)
+	for _, name := range []string{NoticeFile, ThirdPartyNoticeFile} {
+		buf := bytes.NewBuffer(nil)
+		if err := tmpl.ExecuteTemplate(buf, name, data); err != nil {
+			return nil, err
+		}
+		files[name] = buf.Bytes()
+	}
+	return files, nil
+}
+
+// Returns the notice files in dir whose contents differ from the rendered
+// ones, sorted.
+func StaleNotices(dir string, files map[string][]byte) ([]string, error) {
+	var stale []string
+	for name, data := range files {
+		current, err := ioutil.ReadFile(filepath.Join(dir, name))
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		if err != nil || !bytes.Equal(current, data) {
+			stale = append(stale, name)
+		}
+	}
+	sort.Strings(stale)
+	return stale, nil
+}
+
+// Tells whether name is a conventional notice file name, such as NOTICE or
+// NOTICE.txt.
+func isNoticeFile(name string) bool {
+	name = strings.ToLower(name)
+	return name == "notice" || strings.HasPrefix(name, "notice.")
+}
+
+// Normalizes line endings and trailing blank lines, which vary between
+// packages.
+func normalizeNotice(text []byte) string {
+	return strings.TrimRight(strings.Replace(string(text), "\r\n", "\n", -1), " \t\n")
+}
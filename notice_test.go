@@ -0,0 +1,93 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNotices(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "licentia-tests-")
+	ok(t, err)
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"deps/lib/LICENSE":    "Apache License\r\nVersion 2.0\r\n\r\n",
+		"deps/lib/NOTICE.txt": "Lib\nCopyright 2015 Lib Authors\n",
+		"deps/other/COPYING":  "MIT\n",
+	}
+	for name, content := range files {
+		name = filepath.Join(dir, filepath.FromSlash(name))
+		ok(t, os.MkdirAll(filepath.Dir(name), 0750))
+		ok(t, ioutil.WriteFile(name, []byte(content), 0640))
+	}
+
+	deps := []dependency{
+		{ecosystem: "npm", name: "other", version: "1.0.0", license: MIT, dir: filepath.Join(dir, "deps", "other")},
+		{ecosystem: "go", name: "example.com/lib", version: "v1.0.0", license: Apache2, dir: filepath.Join(dir, "deps", "lib")},
+		{ecosystem: "go", name: "example.com/missing", version: "v1.0.0", license: UNKNOWN, dir: filepath.Join(dir, "deps", "missing")},
+	}
+
+	config := &Config{
+		LicenseType:      Apache2,
+		CopyrightOwner:   "Test",
+		CopyrightHolders: []CopyrightHolder{{Name: "Other", Years: "2015"}},
+	}
+	data, err := BuildNotices("project", config, deps)
+	ok(t, err)
+
+	year := CopyrightHolder{}.years()
+	equals(t, []string{"Copyright " + year + " Test", "Copyright 2015 Other"}, data.Copyrights)
+	equals(t, "example.com/lib", data.Dependencies[0].Name)
+	equals(t, []string{"Apache License\nVersion 2.0"}, data.Dependencies[0].LicenseTexts)
+	equals(t, "Lib\nCopyright 2015 Lib Authors", data.Dependencies[0].Notice)
+	equals(t, "NOASSERTION", data.Dependencies[1].License)
+	equals(t, "other", data.Dependencies[2].Name)
+
+	rendered, err := RenderNotices(data, "")
+	ok(t, err)
+	equals(t, "project\nCopyright "+year+" Test\nCopyright 2015 Other\n"+
+		"\n-------------------------------------------------------------------------------\n"+
+		"example.com/lib v1.0.0\n\nLib\nCopyright 2015 Lib Authors\n", string(rendered[NoticeFile]))
+
+	// Templates not redefined keep their default.
+	tmpl := filepath.Join(dir, "notice.tmpl")
+	ok(t, ioutil.WriteFile(tmpl, []byte(`{{define "NOTICE"}}{{.Name}}{{range .Dependencies}} {{.Name}}{{end}}{{end}}`), 0640))
+	custom, err := RenderNotices(data, tmpl)
+	ok(t, err)
+	equals(t, "project example.com/lib example.com/missing other", string(custom[NoticeFile]))
+	equals(t, rendered[ThirdPartyNoticeFile], custom[ThirdPartyNoticeFile])
+
+	stale, err := StaleNotices(dir, rendered)
+	ok(t, err)
+	equals(t, []string{NoticeFile, ThirdPartyNoticeFile}, stale)
+
+	ok(t, ioutil.WriteFile(filepath.Join(dir, NoticeFile), rendered[NoticeFile], 0640))
+	ok(t, ioutil.WriteFile(filepath.Join(dir, ThirdPartyNoticeFile), custom[NoticeFile], 0640))
+	stale, err = StaleNotices(dir, rendered)
+	ok(t, err)
+	equals(t, []string{ThirdPartyNoticeFile}, stale)
+}
+
+func TestNoticesWithoutDependencies(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "licentia-tests-")
+	ok(t, err)
+	defer os.RemoveAll(dir)
+
+	out, code := runLicentia(t, dir, "notice", "--name=project", "apache2", "Test")
+	equals(t, 0, code)
+	equals(t, "", out)
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, NoticeFile))
+	ok(t, err)
+	equals(t, "project\nCopyright "+CopyrightHolder{}.years()+" Test\n", string(data))
+
+	out, code = runLicentia(t, dir, "notice", "--check", "--name=project", "apache2", "Test")
+	equals(t, 0, code)
+	equals(t, "", out)
+}
@@ -0,0 +1,220 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Layout of exception expiry dates.
+const policyDateLayout = "2006-01-02"
+
+// Licenses allowed, denied or requiring review in a product. Licenses are
+// SPDX identifiers and may contain glob patterns, ex: BSD-*.
+type Policy struct {
+	Allow []string `yaml:"allow,omitempty"`
+	Deny  []string `yaml:"deny,omitempty"`
+	// Licenses a person needs to look into before they can be used.
+	Review     []string          `yaml:"review,omitempty"`
+	Exceptions []PolicyException `yaml:"exceptions,omitempty"`
+}
+
+// Exempts files or dependencies from the policy.
+type PolicyException struct {
+	// File path or dependency name. It may contain glob patterns, and a
+	// trailing /** matches everything below a directory.
+	Path string `yaml:"path"`
+	// Limits the exception to this license, if set.
+	License string `yaml:"license"`
+	// Date, as YYYY-MM-DD, from which the exception no longer applies.
+	Expires string `yaml:"expires"`
+	Reason  string `yaml:"reason"`
+}
+
+type Verdict string
+
+// Verdicts from the least to the most severe.
+const (
+	Allowed     Verdict = "allowed"
+	NeedsReview Verdict = "review"
+	Denied      Verdict = "denied"
+)
+
+var severities = map[Verdict]int{Allowed: 0, NeedsReview: 1, Denied: 2}
+
+type policyResult struct {
+	// File path or dependency.
+	subject string
+	license string
+	verdict Verdict
+	reason  string
+}
+
+// Parentheses and identifiers of SPDX license expressions, operators being
+// identifiers.
+func (p *Policy) validate() error {
+	patterns := append(append(append([]string{}, p.Allow...), p.Deny...), p.Review...)
+	for _, e := range p.Exceptions {
+		if e.Path == "" {
+			return fmt.Errorf("policy exception without path")
+		}
+		patterns = append(patterns, e.Path, e.License)
+
+		if e.Expires != "" {
+			if _, err := time.Parse(policyDateLayout, e.Expires); err != nil {
+				return fmt.Errorf("policy exception for %s: invalid expiry date: %v", e.Path, err)
+			}
+		}
+	}
+
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid policy pattern %q: %v", pattern, err)
+		}
+	}
+	return nil
+}
+
+// Evaluates the licenses detected in source files and the licenses of
+// dependencies against the policy. Only results that are not allowed are
+// returned, sorted by subject. Source files of unknown license are ignored.
+func (p *Policy) Check(licenses []fileLicense, deps []dependency, now time.Time) []policyResult {
+	var results []policyResult
+	for _, l := range licenses {
+		if l.license.IsUnknown() {
+			continue
+		}
+
+		result := p.evaluate(l.file, l.license.SPDX(), now)
+		if result.verdict != Allowed {
+			results = append(results, result)
+		}
+	}
+
+	for _, dep := range deps {
+		result := p.evaluate(dep.name, dep.spdx(), now)
+		if result.verdict != Allowed {
+			result.subject = fmt.Sprintf("%s:%s@%s", dep.ecosystem, dep.name, dep.version)
+			results = append(results, result)
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].subject < results[j].subject
+	})
+	return results
+}
+
+// Evaluates the SPDX license expression of a file or dependency, applying the
+// exceptions matching subject.
+func (p *Policy) evaluate(subject, expr string, now time.Time) policyResult {
+	result := policyResult{subject: subject, license: expr}
+	result.verdict, result.reason = p.expression(expr)
+	if result.verdict == Allowed {
+		return result
+	}
+
+	for _, e := range p.Exceptions {
+		if !matchPath(e.Path, subject) || (e.License != "" && !matchLicense(e.License, expr)) {
+			continue
+		}
+
+		if e.Expires != "" {
+			expires, _ := time.Parse(policyDateLayout, e.Expires)
+			if !now.Before(expires) {
+				result.reason += fmt.Sprintf("; exception expired on %s", e.Expires)
+				continue
+			}
+		}
+
+		result.verdict, result.reason = Allowed, e.Reason
+		break
+	}
+	return result
+}
+
+// Returns the verdict of an SPDX license expression. Any allowed alternative
+// is enough for licenses combined with OR, whereas licenses combined with
+// AND need every one of them to be allowed. AND takes precedence over OR,
+// and parentheses group licenses as usual. Expressions that cannot be
+// parsed need review.
+func (p *Policy) expression(expr string) (Verdict, string) {
+	if strings.TrimSpace(expr) == "" {
+		return p.license("NOASSERTION")
+	}
+
+	e, err := parseSPDXExpression(expr)
+	if err != nil {
+		return NeedsReview, err.Error()
+	}
+	return p.combination(e)
+}
+
+// Returns the verdict of a parsed license expression, the least severe
+// verdict of its operands applying for OR and the most severe for AND.
+func (p *Policy) combination(e spdxExpression) (Verdict, string) {
+	if e.Operator == "" {
+		return p.license(e.License)
+	}
+
+	verdict, reason := p.combination(e.Operands[0])
+	for _, operand := range e.Operands[1:] {
+		v, r := p.combination(operand)
+		if e.Operator == "OR" && severities[v] < severities[verdict] || e.Operator == "AND" && severities[v] > severities[verdict] {
+			verdict, reason = v, r
+		}
+	}
+	return verdict, reason
+}
+
+// Returns the verdict of a single license.
+func (p *Policy) license(id string) (Verdict, string) {
+	if id == "NOASSERTION" {
+		return NeedsReview, "unknown license"
+	}
+
+	for _, pattern := range p.Deny {
+		if matchLicense(pattern, id) {
+			return Denied, fmt.Sprintf("%s is denied", id)
+		}
+	}
+
+	for _, pattern := range p.Review {
+		if matchLicense(pattern, id) {
+			return NeedsReview, fmt.Sprintf("%s requires review", id)
+		}
+	}
+
+	if len(p.Allow) == 0 {
+		return Allowed, ""
+	}
+	for _, pattern := range p.Allow {
+		if matchLicense(pattern, id) {
+			return Allowed, ""
+		}
+	}
+	return Denied, fmt.Sprintf("%s is not in the allow list", id)
+}
+
+func matchLicense(pattern, id string) bool {
+	ok, _ := path.Match(strings.ToLower(pattern), strings.ToLower(id))
+	return ok
+}
+
+// Matches a file path or dependency name against pattern. A trailing /**
+// in pattern matches everything below a directory.
+func matchPath(pattern, name string) bool {
+	name = strings.TrimPrefix(path.Clean(strings.Replace(name, "\\", "/", -1)), "./")
+	if strings.HasSuffix(pattern, "/**") {
+		dir := strings.TrimSuffix(pattern, "/**")
+		return strings.HasPrefix(name, dir+"/") || name == dir
+	}
+	ok, _ := path.Match(pattern, name)
+	return ok
+}
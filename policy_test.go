@@ -0,0 +1,103 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestPolicyCheck(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "licentia-tests-")
+	ok(t, err)
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, ProjectConfigFile)
+	ok(t, ioutil.WriteFile(filename, []byte(`policy:
+  allow: [MIT, Apache-2.0, BSD-*, MPL-2.0]
+  deny: [GPL-*]
+  review: [LGPL-*]
+  exceptions:
+    - path: third_party/**
+      license: GPL-2.0-or-later
+      expires: 2030-01-01
+      reason: vendored until upstream relicenses
+    - path: legacy.go
+      expires: 2020-01-01
+`), 0640))
+
+	config, err := LoadProjectConfig(filename)
+	ok(t, err)
+
+	licenses := []fileLicense{
+		{file: "main.go", license: singleLicense(MPL2)},
+		{file: "bsd.go", license: singleLicense(NewBSD)},
+		{file: "gpl.go", license: singleLicense(GPL3)},
+		{file: "legacy.go", license: singleLicense(GPL2)},
+		{file: "third_party/x/gpl.go", license: singleLicense(GPL2)},
+		{file: "nolicense.go", license: singleLicense(UNKNOWN)},
+	}
+	deps := []dependency{
+		{ecosystem: "npm", name: "dual", version: "1.0.0", declared: "MIT OR GPL-3.0-only", license: UNKNOWN},
+		{ecosystem: "npm", name: "both", version: "1.0.0", declared: "MIT AND LGPL-2.1-only", license: UNKNOWN},
+		{ecosystem: "go", name: "example.com/unknown", version: "v1.0.0", license: UNKNOWN},
+		{ecosystem: "go", name: "example.com/wtfpl", version: "v1.0.0", declared: "WTFPL", license: UNKNOWN},
+	}
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	var report []string
+	for _, r := range config.Policy.Check(licenses, deps, now) {
+		report = append(report, r.subject+" "+string(r.verdict)+" "+r.reason)
+	}
+
+	equals(t, []string{
+		"go:example.com/unknown@v1.0.0 review unknown license",
+		"go:example.com/wtfpl@v1.0.0 denied WTFPL is not in the allow list",
+		"gpl.go denied GPL-3.0-or-later is denied",
+		"legacy.go denied GPL-2.0-or-later is denied; exception expired on 2020-01-01",
+		"npm:both@1.0.0 review LGPL-2.1-only requires review",
+	}, report)
+}
+
+func TestPolicyValidate(t *testing.T) {
+	p := &Policy{Exceptions: []PolicyException{{Path: "a.go", Expires: "next week"}}}
+	assert(t, p.validate() != nil, "Invalid expiry dates should fail")
+
+	p = &Policy{Allow: []string{"BSD-["}}
+	assert(t, p.validate() != nil, "Invalid patterns should fail")
+}
+
+func TestPolicyExpression(t *testing.T) {
+	p := &Policy{Allow: []string{"MIT", "Apache-2.0"}, Deny: []string{"GPL-*"}, Review: []string{"LGPL-*"}}
+
+	tests := []struct {
+		expr    string
+		verdict Verdict
+	}{
+		{"MIT", Allowed},
+		{"MIT AND GPL-3.0-only", Denied},
+		{"MIT OR GPL-3.0-only", Allowed},
+		// AND takes precedence over OR.
+		{"MIT AND GPL-3.0-only OR Apache-2.0", Allowed},
+		{"GPL-3.0-only OR MIT AND LGPL-2.1-only", NeedsReview},
+		{"(MIT OR GPL-3.0-only) AND LGPL-2.1-only", NeedsReview},
+		{"MIT AND (GPL-3.0-only OR Apache-2.0)", Allowed},
+		{"((MIT)) and (GPL-3.0-only or LGPL-2.1-only)", NeedsReview},
+		{"GPL-2.0-only WITH Classpath-exception-2.0 OR MIT", Allowed},
+		{"GPL-2.0-only WITH Classpath-exception-2.0", Denied},
+		{"", NeedsReview},
+		{"(MIT OR GPL-3.0-only", NeedsReview},
+		{"MIT AND", NeedsReview},
+		{"MIT Apache-2.0", NeedsReview},
+		{"MIT WITH", NeedsReview},
+	}
+	for _, tt := range tests {
+		verdict, reason := p.expression(tt.expr)
+		assert(t, verdict == tt.verdict, "%q: expected %s, got %s (%s)", tt.expr, tt.verdict, verdict, reason)
+	}
+}
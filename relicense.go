@@ -0,0 +1,161 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"sync"
+)
+
+// Outcome of relicensing a single file.
+type RelicenseStatus string
+
+const (
+	Relicensed              RelicenseStatus = "changed"
+	SkippedDifferentLicense RelicenseStatus = "skipped-different-license"
+	SkippedNoHeader         RelicenseStatus = "skipped-no-header"
+	SkippedIneligible       RelicenseStatus = "skipped-ineligible" // binary, generated or too big
+	RelicenseFailed         RelicenseStatus = "failed"
+)
+
+type relicenseResult struct {
+	File     string          `json:"file"`
+	Status   RelicenseStatus `json:"status"`
+	Detected LicenseType     `json:"detected,omitempty"`
+	Reason   string          `json:"reason,omitempty"`
+}
+
+// Replaces the license header of files licensed under from with the license
+// in config. Unlike Set with Replace, files are only changed if their
+// current license is from. A result is returned for every file, sorted by
+// file name.
+func Relicense(config *Config, from LicenseType) ([]relicenseResult, error) {
+	var resultsMtx sync.Mutex
+	results := make([]relicenseResult, 0, len(config.Files))
+	errors := new(Error)
+
+	var wg sync.WaitGroup
+	for _, file := range config.Files {
+		wg.Add(1)
+		go func(file string) {
+			defer wg.Done()
+
+			result := relicenseFile(file, config, from)
+			resultsMtx.Lock()
+			results = append(results, result)
+			resultsMtx.Unlock()
+			if result.Status == RelicenseFailed {
+				errors.Append(fmt.Errorf("%s: %s", file, result.Reason))
+			}
+		}(file)
+	}
+	wg.Wait()
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].File < results[j].File
+	})
+
+	if errors.IsEmpty() {
+		return results, nil
+	}
+
+	return results, errors
+}
+
+func relicenseFile(file string, config *Config, from LicenseType) relicenseResult {
+	result := relicenseResult{File: file}
+
+	reason, err := skipReason(file, config)
+	if err != nil {
+		result.Status, result.Reason = RelicenseFailed, err.Error()
+		return result
+	}
+	if reason != "" {
+		result.Status, result.Reason = SkippedIneligible, reason
+		return result
+	}
+
+	detected, err := detectLicense(file)
+	if err != nil {
+		result.Status, result.Reason = RelicenseFailed, err.Error()
+		return result
+	}
+
+	result.Detected = UNKNOWN
+	if !detected.IsUnknown() {
+		result.Detected = LicenseType(detected.String())
+	}
+	if result.Detected != UNKNOWN && result.Detected != from {
+		result.Status = SkippedDifferentLicense
+		return result
+	}
+
+	fromConfig := *config
+	fromConfig.LicenseType = from
+	fromConfig.Files = []string{file}
+
+	// Headers are short and do not always carry enough text for detectLicense
+	// to recognize them. Matching the template confirms the license either
+	// way, and guarantees the header can be removed.
+	tmpl, err := newHeaderTemplate(&fromConfig)
+	if err != nil {
+		result.Status, result.Reason = RelicenseFailed, err.Error()
+		return result
+	}
+
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		result.Status, result.Reason = RelicenseFailed, err.Error()
+		return result
+	}
+
+	header, err := findHeader(data, tmpl, file)
+	if err != nil {
+		result.Status, result.Reason = RelicenseFailed, err.Error()
+		return result
+	}
+
+	if header == nil {
+		if result.Detected == from {
+			result.Status = RelicenseFailed
+			result.Reason = fmt.Sprintf("%s license detected but its header does not match the template", from)
+			return result
+		}
+		result.Status = SkippedNoHeader
+		return result
+	}
+	result.Detected = from
+
+	if err := removeLicense(file, &fromConfig); err != nil {
+		result.Status, result.Reason = RelicenseFailed, err.Error()
+		return result
+	}
+
+	if err := insertLicense(file, config); err != nil {
+		result.Status, result.Reason = RelicenseFailed, err.Error()
+		return result
+	}
+
+	result.Status = Relicensed
+	return result
+}
+
+// Writes the relicensing report as JSON to filename.
+func writeRelicenseReport(filename string, from, to LicenseType, results []relicenseResult) error {
+	report := struct {
+		From    LicenseType       `json:"from"`
+		To      LicenseType       `json:"to"`
+		Results []relicenseResult `json:"results"`
+	}{from, to, results}
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filename, append(data, '\n'), 0640)
+}
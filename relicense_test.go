@@ -0,0 +1,66 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRelicense(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "licentia-tests-")
+	ok(t, err)
+	defer os.RemoveAll(dir)
+
+	files := map[string]LicenseType{
+		"gpl2.go": GPL2,
+		"mit.go":  MIT,
+		"none.go": "",
+	}
+
+	var names []string
+	for name, ltype := range files {
+		file := filepath.Join(dir, name)
+		names = append(names, file)
+		ok(t, ioutil.WriteFile(file, []byte("package main\n"), 0640))
+		if ltype == "" {
+			continue
+		}
+
+		_, err := Set(&Config{
+			CopyrightOwner:  "Test",
+			LicenseType:     ltype,
+			Files:           []string{file},
+			EOLCommentStyle: "//",
+		})
+		ok(t, err)
+	}
+
+	config := &Config{
+		CopyrightOwner:  "Test",
+		LicenseType:     MPL2,
+		Files:           names,
+		EOLCommentStyle: "//",
+	}
+	generated := filepath.Join(dir, "generated.go")
+	ok(t, ioutil.WriteFile(generated, []byte("// Code generated by stringer. DO NOT EDIT.\n\npackage main\n"), 0640))
+	config.Files = append(config.Files, generated)
+
+	results, err := Relicense(config, GPL2)
+	ok(t, err)
+
+	equals(t, []relicenseResult{
+		{File: generated, Status: SkippedIneligible, Reason: "generated file"},
+		{File: filepath.Join(dir, "gpl2.go"), Status: Relicensed, Detected: GPL2},
+		{File: filepath.Join(dir, "mit.go"), Status: SkippedDifferentLicense, Detected: MIT},
+		{File: filepath.Join(dir, "none.go"), Status: SkippedNoHeader, Detected: UNKNOWN},
+	}, results)
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, "gpl2.go"))
+	ok(t, err)
+	equals(t, mpl2+"package main\n", string(data))
+}
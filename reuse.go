@@ -0,0 +1,898 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Support for the REUSE specification. See https://reuse.software/spec-3.3/
+
+const (
+	// Directory holding the text of every license used in a project.
+	reuseLicensesDir = "LICENSES"
+	// Suffix of the files holding the licensing information of files that
+	// cannot carry comments.
+	reuseSidecarSuffix = ".license"
+	reuseTOMLFile      = "REUSE.toml"
+	reuseDep5File      = ".reuse/dep5"
+	// Number of bytes read from the beginning of a file to find its
+	// copyright and licensing information.
+	reuseScanLen = 64 << 10
+)
+
+// Precedence of REUSE.toml annotations over the information found in the
+// files they annotate.
+const (
+	precedenceClosest   = "closest"
+	precedenceAggregate = "aggregate"
+	precedenceOverride  = "override"
+)
+
+// Files that cannot carry comments and get a .license sidecar instead of a
+// header.
+var uncommentableExts = map[string]bool{
+	".bmp": true, ".eot": true, ".gif": true, ".gz": true, ".ico": true,
+	".jar": true, ".jpeg": true, ".jpg": true, ".json": true, ".mp3": true,
+	".mp4": true, ".ogg": true, ".otf": true, ".pdf": true, ".png": true,
+	".tar": true, ".tgz": true, ".ttf": true, ".wav": true, ".webp": true,
+	".woff": true, ".woff2": true, ".zip": true,
+}
+
+var (
+	spdxLicenseTagRe   = regexp.MustCompile(`SPDX-License-Identifier:\s*(.*?)\s*(?:\*/|-->)?\s*$`)
+	spdxCopyrightTagRe = regexp.MustCompile(`SPDX-(?:File|Snippet)CopyrightText:\s*(.*?)\s*(?:\*/|-->)?\s*$`)
+	// License files excluded from REUSE compliance, at any depth.
+	reuseLicenseFileRe = regexp.MustCompile(`^(?:LICEN[CS]E|COPYING)(?:[-.].*)?$`)
+	dep5ParagraphRe    = regexp.MustCompile(`\n\s*\n`)
+)
+
+// Copyright and licensing information of a file.
+type reuseInfo struct {
+	copyrights []string
+	// SPDX license expressions.
+	licenses []string
+}
+
+// Copyright and licensing information that REUSE.toml or .reuse/dep5
+// attach to the files matching any of its patterns.
+type reuseAnnotation struct {
+	patterns   []*regexp.Regexp
+	precedence string
+	reuseInfo
+}
+
+type reuseProblem struct {
+	// File path relative to the project, or license text in LICENSES/.
+	subject string
+	problem string
+}
+
+// Sets REUSE compliant licensing information: SPDX tags as header for files
+// that can carry comments and .license sidecars for the ones that cannot,
+// binary, generated and oversized files included.
+func setReuse(config *Config) ([]skippedFile, error) {
+	if config.licenseExpression().SPDX() == "" {
+		return nil, fmt.Errorf("%s license has no SPDX identifier", config.LicenseType)
+	}
+
+	var sidecars []string
+	var skipped []skippedFile
+	headerConfig := *config
+	headerConfig.Files = nil
+	for _, file := range config.Files {
+		switch {
+		case strings.HasSuffix(file, reuseSidecarSuffix):
+			skipped = append(skipped, skippedFile{file: file, reason: "REUSE sidecar"})
+		case uncommentableExts[strings.ToLower(filepath.Ext(file))]:
+			sidecars = append(sidecars, file)
+		default:
+			headerConfig.Files = append(headerConfig.Files, file)
+		}
+	}
+
+	errors := new(Error)
+	var skippedMtx sync.Mutex
+	notCommentable, err := forEachFile(&headerConfig, func(file string) error {
+		reason, err := insertReuseHeader(file, config)
+		if reason != "" {
+			skippedMtx.Lock()
+			skipped = append(skipped, skippedFile{file: file, reason: reason})
+			skippedMtx.Unlock()
+		}
+		return err
+	})
+	if err != nil {
+		errors.Append(err)
+	}
+
+	for _, s := range notCommentable {
+		if s.reason == "is a directory" {
+			skipped = append(skipped, s)
+			continue
+		}
+		sidecars = append(sidecars, s.file)
+	}
+
+	for _, file := range sidecars {
+		reason, err := writeSidecar(file, config)
+		if err != nil {
+			errors.Append(err)
+		}
+		if reason != "" {
+			skipped = append(skipped, skippedFile{file: file, reason: reason})
+		}
+	}
+
+	if errors.IsEmpty() {
+		return skipped, nil
+	}
+	return skipped, errors
+}
+
+// Inserts SPDX copyright and license tags, as end-of-line comments, at the
+// top of filename, after any shebang, build constraint or other preamble
+// line that must stay first. Tags already at the top of filename are merged
+// with as writeSidecar does, and files holding tags for another license are
+// left untouched. The reason filename was left untouched is returned, if it
+// was.
+func insertReuseHeader(filename string, config *Config) (string, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return "", err
+	}
+
+	merged, reason := mergeReuseTags(data, config)
+	if reason != "" {
+		return reason, nil
+	}
+	if merged != nil {
+		if bytes.Equal(merged, data) {
+			return "", nil
+		}
+		return "", writeFile(filename, merged)
+	}
+
+	tags := bytes.NewBuffer(nil)
+	renderReuseTags(tags, config)
+
+	licensedFile := bytes.NewBuffer(nil)
+	preamble := data[:preambleLen(data)]
+	if len(preamble) > 0 {
+		licensedFile.Write(preamble)
+		if !bytes.HasSuffix(preamble, []byte("\n")) {
+			licensedFile.WriteByte('\n')
+		}
+		if !bytes.HasSuffix(bytes.TrimRight(preamble, " \t\r"), []byte("\n\n")) {
+			licensedFile.WriteByte('\n')
+		}
+	}
+	if err := prependEOLComment(licensedFile, config.EOLCommentStyle, tags.Bytes()); err != nil {
+		return "", err
+	}
+	licensedFile.WriteByte('\n')
+	licensedFile.Write(data[len(preamble):])
+
+	return "", writeFile(filename, licensedFile.Bytes())
+}
+
+// Adds the copyright tags of the holders in config missing from the SPDX tags
+// at the top of data, and the license tag if there is none, in the comment
+// style of the existing tags. nil is returned if data has no tags, and data
+// itself if it holds every tag already. The reason data cannot be merged with
+// is returned when its tags give another license.
+func mergeReuseTags(data []byte, config *Config) ([]byte, string) {
+	id := config.licenseExpression().SPDX()
+	lines := bytes.SplitAfter(data, []byte("\n"))
+
+	license, lastCopyright := -1, -1
+	var copyrights []string
+	for i := 0; i < len(lines) && i < copyrightScanLines; i++ {
+		if m := spdxLicenseTagRe.FindSubmatch(lines[i]); m != nil {
+			if string(m[1]) != id {
+				return nil, fmt.Sprintf("licensed under %s", m[1])
+			}
+			if license < 0 {
+				license = i
+			}
+		} else if m := spdxCopyrightTagRe.FindSubmatch(lines[i]); m != nil {
+			copyrights = append(copyrights, string(m[1]))
+			lastCopyright = i
+		}
+	}
+	if license < 0 && lastCopyright < 0 {
+		return nil, ""
+	}
+
+	// New tags follow the last copyright tag, or precede the license tag,
+	// and are commented as it is.
+	var at int
+	var template []byte
+	var tagRe *regexp.Regexp
+	if lastCopyright >= 0 {
+		at, template, tagRe = lastCopyright+1, lines[lastCopyright], spdxCopyrightTagRe
+	} else {
+		at, template, tagRe = license, lines[license], spdxLicenseTagRe
+	}
+	m := tagRe.FindSubmatchIndex(template)
+	prefix := template[:bytes.Index(template, []byte("SPDX-"))]
+	suffix := template[m[3]:]
+	if !bytes.HasSuffix(suffix, []byte("\n")) {
+		suffix = append(suffix[:len(suffix):len(suffix)], '\n')
+	}
+
+	tags := bytes.NewBuffer(nil)
+	for _, holder := range config.holders() {
+		if !hasCopyrightOf(copyrights, holder.Name) {
+			fmt.Fprintf(tags, "%sSPDX-FileCopyrightText: %s %s%s", prefix, holder.years(), holder.Name, suffix)
+		}
+	}
+	if license < 0 {
+		fmt.Fprintf(tags, "%sSPDX-License-Identifier: %s%s", prefix, id, suffix)
+	}
+	if tags.Len() == 0 {
+		return data, ""
+	}
+
+	merged := bytes.NewBuffer(make([]byte, 0, len(data)+tags.Len()+1))
+	merged.Write(bytes.Join(lines[:at], nil))
+	if at > 0 && !bytes.HasSuffix(lines[at-1], []byte("\n")) {
+		merged.WriteByte('\n')
+	}
+	merged.Write(tags.Bytes())
+	merged.Write(bytes.Join(lines[at:], nil))
+	return merged.Bytes(), ""
+}
+
+// Writes the SPDX copyright and license tags of filename to its .license
+// sidecar. The copyright notices of an existing sidecar are kept, and the
+// sidecar is left untouched if it gives another license. The reason the
+// sidecar was left untouched is returned, if it was.
+func writeSidecar(filename string, config *Config) (string, error) {
+	sidecar := filename + reuseSidecarSuffix
+	data, err := ioutil.ReadFile(sidecar)
+	if err != nil && !os.IsNotExist(err) {
+		return "", err
+	}
+	existing := extractReuseInfo(data, -1)
+
+	id := config.licenseExpression().SPDX()
+	for _, l := range existing.licenses {
+		if l != id {
+			return fmt.Sprintf("sidecar licensed under %s", l), nil
+		}
+	}
+
+	tags := bytes.NewBuffer(nil)
+	for _, c := range existing.copyrights {
+		fmt.Fprintf(tags, "SPDX-FileCopyrightText: %s\n", c)
+	}
+	for _, holder := range config.holders() {
+		if !hasCopyrightOf(existing.copyrights, holder.Name) {
+			fmt.Fprintf(tags, "SPDX-FileCopyrightText: %s %s\n", holder.years(), holder.Name)
+		}
+	}
+	fmt.Fprintf(tags, "SPDX-License-Identifier: %s\n", id)
+	return "", ioutil.WriteFile(sidecar, tags.Bytes(), 0644)
+}
+
+// Tells whether one of copyrights, SPDX copyright texts, is held by name.
+func hasCopyrightOf(copyrights []string, name string) bool {
+	for _, c := range copyrights {
+		if c == name || strings.HasSuffix(c, " "+name) {
+			return true
+		}
+	}
+	return false
+}
+
+func renderReuseTags(buf *bytes.Buffer, config *Config) {
+	for _, holder := range config.holders() {
+		fmt.Fprintf(buf, "SPDX-FileCopyrightText: %s %s\n", holder.years(), holder.Name)
+	}
+	fmt.Fprintf(buf, "SPDX-License-Identifier: %s\n", config.licenseExpression().SPDX())
+}
+
+// Writes the text of a license to the LICENSES directory of the project in
+// dir, as REUSE expects it, and returns the path of the file written.
+func DumpReuse(dir string, ltype LicenseType, owner string) (string, error) {
+	info := lookupLicense(ltype)
+	if info == nil || info.SPDX == "" {
+		return "", fmt.Errorf("%s license has no SPDX identifier", ltype)
+	}
+	id := info.SPDX
+
+	data, err := Asset(info.textAsset())
+	if err != nil {
+		return "", err
+	}
+
+	replacer := strings.NewReplacer(
+		"@@owner@@", owner,
+		"@@year@@", CopyrightHolder{}.years(),
+	)
+
+	if err := os.MkdirAll(filepath.Join(dir, reuseLicensesDir), 0755); err != nil {
+		return "", err
+	}
+
+	filename := filepath.Join(dir, reuseLicensesDir, id+".txt")
+	return filename, ioutil.WriteFile(filename, []byte(replacer.Replace(string(data))), 0644)
+}
+
+// Checks the project in dir against the REUSE specification: every file has
+// copyright and licensing information, in a header, a .license sidecar or a
+// REUSE.toml or .reuse/dep5 annotation, every license used has its text in
+// LICENSES/, and every text in LICENSES/ is used. Problems are returned
+// sorted by subject.
+func CheckReuse(dir string) ([]reuseProblem, error) {
+	files, err := reuseFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	return checkReuse(dir, files, workingTreeReader(dir), true)
+}
+
+// Checks files of the project in dir, slash separated paths relative to
+// dir, as CheckReuse does, except that unused license texts are not
+// reported.
+func CheckReuseFiles(dir string, files []string) ([]reuseProblem, error) {
+	return checkReuse(dir, filterReuseFiles(dir, files), workingTreeReader(dir), false)
+}
+
+// Checks the files of the project in dir with staged changes as
+// CheckReuseFiles does, from their content and .license sidecars as staged
+// rather than as in the working tree.
+func CheckReuseStaged(dir string) ([]reuseProblem, error) {
+	files, err := stagedFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	read, err := indexReader(dir)
+	if err != nil {
+		return nil, err
+	}
+	return checkReuse(dir, filterReuseFiles(dir, files), read, false)
+}
+
+// Reads up to n bytes from the beginning of a file of the project, a slash
+// separated path relative to the project directory, or the whole file if n
+// is negative.
+type reuseReader func(file string, n int) ([]byte, error)
+
+// Reads files from the working tree of the project in dir.
+func workingTreeReader(dir string) reuseReader {
+	return func(file string, n int) ([]byte, error) {
+		filename := filepath.Join(dir, filepath.FromSlash(file))
+		if n < 0 {
+			return ioutil.ReadFile(filename)
+		}
+		return readHead(filename, n)
+	}
+}
+
+// Reads files from the git index of the project in dir. Files missing from
+// the index are reported as not existing.
+func indexReader(dir string) (reuseReader, error) {
+	index, err := gitFiles(dir, "ls-files", "-z", "--cached")
+	if err != nil {
+		return nil, err
+	}
+	indexed := make(map[string]bool, len(index))
+	for _, file := range index {
+		indexed[file] = true
+	}
+
+	return func(file string, n int) ([]byte, error) {
+		if !indexed[file] {
+			return nil, &os.PathError{Op: "read", Path: ":" + file, Err: os.ErrNotExist}
+		}
+		data, err := exec.Command("git", "-C", dir, "show", ":./"+file).Output()
+		if err != nil {
+			return nil, fmt.Errorf("git show: %v", gitError(err))
+		}
+		if n >= 0 && len(data) > n {
+			data = data[:n]
+		}
+		return data, nil
+	}, nil
+}
+
+// Checks files, read with read, against the REUSE specification, and
+// reports unused license texts if files are all the files of the project.
+func checkReuse(dir string, files []string, read reuseReader, complete bool) ([]reuseProblem, error) {
+	annotations, err := loadReuseAnnotations(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var problems []reuseProblem
+	// License identifiers used and the files using them.
+	used := make(map[string][]string)
+	for _, file := range files {
+		info, err := fileReuseInfo(read, file, annotations)
+		if err != nil {
+			return nil, err
+		}
+
+		var missing []string
+		if len(info.copyrights) == 0 {
+			missing = append(missing, "copyright")
+		}
+		if len(info.licenses) == 0 {
+			missing = append(missing, "licensing")
+		}
+		if len(missing) > 0 {
+			problems = append(problems, reuseProblem{file, fmt.Sprintf("missing %s information", strings.Join(missing, " and "))})
+		}
+
+		for _, expr := range info.licenses {
+			parsed, err := parseSPDXExpression(expr)
+			if err != nil {
+				problems = append(problems, reuseProblem{file, err.Error()})
+				continue
+			}
+			for _, id := range parsed.IDs() {
+				used[id] = append(used[id], file)
+			}
+		}
+	}
+
+	texts, err := reuseLicenseTexts(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	for id, users := range used {
+		if _, ok := texts[id]; !ok {
+			problems = append(problems, reuseProblem{
+				path.Join(reuseLicensesDir, id+".txt"),
+				fmt.Sprintf("missing license text, used by %s", strings.Join(users, ", ")),
+			})
+		}
+	}
+
+	for id, file := range texts {
+		if _, ok := used[id]; !ok && complete {
+			problems = append(problems, reuseProblem{file, "unused license"})
+		}
+	}
+
+	sort.Slice(problems, func(i, j int) bool {
+		return problems[i].subject < problems[j].subject
+	})
+	return problems, nil
+}
+
+// Returns the copyright and licensing information of file, a slash separated
+// path relative to the project directory, from its .license sidecar or its
+// header, read with read, and from the last annotation matching it.
+func fileReuseInfo(read reuseReader, file string, annotations []reuseAnnotation) (reuseInfo, error) {
+	var info reuseInfo
+	if data, err := read(file+reuseSidecarSuffix, -1); err == nil {
+		info = extractReuseInfo(data, -1)
+	} else if !os.IsNotExist(err) {
+		return info, err
+	} else {
+		data, err := read(file, reuseScanLen)
+		if err != nil {
+			return info, err
+		}
+		if sniff(data, len(data) < reuseScanLen) != "binary file" {
+			info = extractReuseInfo(data, copyrightScanLines)
+		}
+	}
+
+	var annotation *reuseAnnotation
+	for i := range annotations {
+		for _, re := range annotations[i].patterns {
+			if re.MatchString(file) {
+				annotation = &annotations[i]
+				break
+			}
+		}
+	}
+	if annotation == nil {
+		return info, nil
+	}
+
+	switch annotation.precedence {
+	case precedenceOverride:
+		info = annotation.reuseInfo
+	case precedenceAggregate:
+		info.copyrights = append(info.copyrights, annotation.copyrights...)
+		info.licenses = append(info.licenses, annotation.licenses...)
+	default:
+		if len(info.copyrights) == 0 {
+			info.copyrights = annotation.copyrights
+		}
+		if len(info.licenses) == 0 {
+			info.licenses = annotation.licenses
+		}
+	}
+	return info, nil
+}
+
+// Returns the SPDX tags and copyright notices in the first lines of data, or
+// in all of them if lines is negative.
+func extractReuseInfo(data []byte, lines int) reuseInfo {
+	var info reuseInfo
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, len(data)+bufio.MaxScanTokenSize)
+	for n := 0; n != lines && scanner.Scan(); n++ {
+		line := scanner.Bytes()
+		if m := spdxLicenseTagRe.FindSubmatch(line); m != nil {
+			if len(m[1]) > 0 {
+				info.licenses = append(info.licenses, string(m[1]))
+			}
+			continue
+		}
+		if m := spdxCopyrightTagRe.FindSubmatch(line); m != nil {
+			if len(m[1]) > 0 {
+				info.copyrights = append(info.copyrights, string(m[1]))
+			}
+			continue
+		}
+		if m := copyrightLineRe.FindSubmatch(line); m != nil {
+			info.copyrights = append(info.copyrights, string(m[1]))
+		}
+	}
+	return info
+}
+
+// Reads up to n bytes from the beginning of filename.
+func readHead(filename string, n int) ([]byte, error) {
+	fh, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer fh.Close()
+	return ioutil.ReadAll(io.LimitReader(fh, int64(n)))
+}
+
+// Returns the files of the project in dir covered by REUSE, as slash
+// separated paths relative to dir. Files ignored by git are left out when
+// dir is in a git repository.
+func reuseFiles(dir string) ([]string, error) {
+	var candidates []string
+	if out, err := exec.Command("git", "-C", dir, "ls-files", "-z", "--cached", "--others", "--exclude-standard").Output(); err == nil {
+		for _, file := range strings.Split(string(out), "\x00") {
+			if file != "" {
+				candidates = append(candidates, file)
+			}
+		}
+	} else {
+		err := filepath.Walk(dir, func(p string, fi os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if fi.IsDir() {
+				switch fi.Name() {
+				case ".git", ".hg", ".svn":
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			rel, err := filepath.Rel(dir, p)
+			if err != nil {
+				return err
+			}
+			candidates = append(candidates, filepath.ToSlash(rel))
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return filterReuseFiles(dir, candidates), nil
+}
+
+// Returns the files covered by REUSE among candidates, slash separated
+// paths relative to dir, sorted.
+func filterReuseFiles(dir string, candidates []string) []string {
+	var files []string
+	for _, file := range candidates {
+		name := path.Base(file)
+		switch {
+		case strings.HasPrefix(file, reuseLicensesDir+"/"),
+			strings.HasPrefix(file, ".reuse/"),
+			name == reuseTOMLFile,
+			strings.HasSuffix(name, reuseSidecarSuffix),
+			reuseLicenseFileRe.MatchString(name):
+			continue
+		}
+
+		// Deleted files still in the git index, directories of submodules,
+		// symlinks and empty files are not covered.
+		fi, err := os.Lstat(filepath.Join(dir, filepath.FromSlash(file)))
+		if err != nil || !fi.Mode().IsRegular() || fi.Size() == 0 {
+			continue
+		}
+		files = append(files, file)
+	}
+	sort.Strings(files)
+	return files
+}
+
+// Returns the license texts in the LICENSES directory of dir, by license
+// identifier.
+func reuseLicenseTexts(dir string) (map[string]string, error) {
+	texts := make(map[string]string)
+	fis, err := ioutil.ReadDir(filepath.Join(dir, reuseLicensesDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return texts, nil
+		}
+		return nil, err
+	}
+
+	for _, fi := range fis {
+		if fi.IsDir() || strings.HasSuffix(fi.Name(), reuseSidecarSuffix) {
+			continue
+		}
+		id := strings.TrimSuffix(fi.Name(), filepath.Ext(fi.Name()))
+		texts[id] = path.Join(reuseLicensesDir, fi.Name())
+	}
+	return texts, nil
+}
+
+// Loads the annotations from the REUSE.toml or .reuse/dep5 file of the
+// project in dir, if any.
+func loadReuseAnnotations(dir string) ([]reuseAnnotation, error) {
+	toml, tomlErr := ioutil.ReadFile(filepath.Join(dir, reuseTOMLFile))
+	if tomlErr != nil && !os.IsNotExist(tomlErr) {
+		return nil, tomlErr
+	}
+
+	dep5, dep5Err := ioutil.ReadFile(filepath.Join(dir, filepath.FromSlash(reuseDep5File)))
+	if dep5Err != nil && !os.IsNotExist(dep5Err) {
+		return nil, dep5Err
+	}
+
+	switch {
+	case tomlErr == nil && dep5Err == nil:
+		return nil, fmt.Errorf("%s and %s cannot be used together", reuseTOMLFile, reuseDep5File)
+	case tomlErr == nil:
+		annotations, err := parseReuseTOML(toml)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", reuseTOMLFile, err)
+		}
+		return annotations, nil
+	case dep5Err == nil:
+		return parseDep5(dep5), nil
+	}
+	return nil, nil
+}
+
+// Parses the annotations of a REUSE.toml file. Only the subset of TOML used
+// by REUSE.toml is supported: a version key followed by [[annotations]]
+// tables of strings and arrays of strings.
+func parseReuseTOML(data []byte) ([]reuseAnnotation, error) {
+	var annotations []reuseAnnotation
+	var annotation *reuseAnnotation
+	var version string
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for n := 1; scanner.Scan(); n++ {
+		line := stripTOMLComment(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			if strings.Trim(line, "[] ") != "annotations" {
+				return nil, fmt.Errorf("line %d: unsupported table %s", n, line)
+			}
+			annotations = append(annotations, reuseAnnotation{precedence: precedenceClosest})
+			annotation = &annotations[len(annotations)-1]
+			continue
+		}
+
+		i := strings.Index(line, "=")
+		if i < 0 {
+			return nil, fmt.Errorf("line %d: expected key = value", n)
+		}
+		key := strings.Trim(strings.TrimSpace(line[:i]), `"`)
+		value := strings.TrimSpace(line[i+1:])
+
+		// Arrays may span several lines.
+		for strings.HasPrefix(value, "[") && !strings.HasSuffix(value, "]") && scanner.Scan() {
+			n++
+			value += " " + stripTOMLComment(scanner.Text())
+		}
+
+		values, err := parseTOMLStrings(value)
+		if err != nil {
+			if annotation == nil && key == "version" {
+				version = value
+				continue
+			}
+			return nil, fmt.Errorf("line %d: %v", n, err)
+		}
+
+		if annotation == nil {
+			continue
+		}
+
+		switch key {
+		case "path":
+			for _, pattern := range values {
+				annotation.patterns = append(annotation.patterns, reuseGlob(pattern, false))
+			}
+		case "precedence":
+			switch p := strings.Join(values, ""); p {
+			case precedenceClosest, precedenceAggregate, precedenceOverride:
+				annotation.precedence = p
+			default:
+				return nil, fmt.Errorf("line %d: invalid precedence %q", n, p)
+			}
+		case "SPDX-FileCopyrightText":
+			annotation.copyrights = append(annotation.copyrights, values...)
+		case "SPDX-License-Identifier":
+			annotation.licenses = append(annotation.licenses, values...)
+		}
+	}
+
+	if version != "1" {
+		return nil, fmt.Errorf("unsupported version %q", version)
+	}
+	return annotations, scanner.Err()
+}
+
+// Returns line without its comment, if any, and surrounding spaces.
+func stripTOMLComment(line string) string {
+	var quote byte
+	for i := 0; i < len(line); i++ {
+		switch c := line[i]; {
+		case quote == 0 && c == '#':
+			return strings.TrimSpace(line[:i])
+		case quote == 0 && (c == '"' || c == '\''):
+			quote = c
+		case quote == '"' && c == '\\':
+			i++
+		case c == quote:
+			quote = 0
+		}
+	}
+	return strings.TrimSpace(line)
+}
+
+// Parses a TOML string or array of strings.
+func parseTOMLStrings(value string) ([]string, error) {
+	var values []string
+	array := strings.HasPrefix(value, "[")
+	if array {
+		value = value[1:]
+	}
+
+	for {
+		value = strings.TrimLeft(value, " \t,")
+		if value == "" {
+			break
+		}
+		if array && value[0] == ']' {
+			array = false
+			value = value[1:]
+			continue
+		}
+
+		var s string
+		switch value[0] {
+		case '\'':
+			end := strings.IndexByte(value[1:], '\'')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated string %s", value)
+			}
+			s, value = value[1:end+1], value[end+2:]
+		case '"':
+			end := 1
+			for ; end < len(value) && value[end] != '"'; end++ {
+				if value[end] == '\\' {
+					end++
+				}
+			}
+			if end >= len(value) {
+				return nil, fmt.Errorf("unterminated string %s", value)
+			}
+			var err error
+			if s, err = strconv.Unquote(value[:end+1]); err != nil {
+				return nil, fmt.Errorf("invalid string %s: %v", value[:end+1], err)
+			}
+			value = value[end+1:]
+		default:
+			return nil, fmt.Errorf("expected string, got %s", value)
+		}
+		values = append(values, s)
+	}
+
+	if array {
+		return nil, fmt.Errorf("unterminated array")
+	}
+	return values, nil
+}
+
+// Parses the Files paragraphs of a .reuse/dep5 file, in the machine-readable
+// debian/copyright format. Its information is aggregated with the one found
+// in files.
+func parseDep5(data []byte) []reuseAnnotation {
+	var annotations []reuseAnnotation
+	for _, paragraph := range dep5ParagraphRe.Split(string(data), -1) {
+		fields := make(map[string][]string)
+		var key string
+		for _, line := range strings.Split(paragraph, "\n") {
+			if strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t") {
+				if key != "" {
+					fields[key] = append(fields[key], strings.TrimSpace(line))
+				}
+				continue
+			}
+			if i := strings.Index(line, ":"); i > 0 {
+				key = strings.TrimSpace(line[:i])
+				fields[key] = append(fields[key], strings.TrimSpace(line[i+1:]))
+			}
+		}
+
+		if len(fields["Files"]) == 0 {
+			continue
+		}
+
+		annotation := reuseAnnotation{precedence: precedenceAggregate}
+		for _, pattern := range strings.Fields(strings.Join(fields["Files"], " ")) {
+			annotation.patterns = append(annotation.patterns, reuseGlob(pattern, true))
+		}
+		for _, c := range fields["Copyright"] {
+			if c != "" {
+				annotation.copyrights = append(annotation.copyrights, c)
+			}
+		}
+		// The lines following the license expression hold the license text.
+		if l := fields["License"]; len(l) > 0 && l[0] != "" {
+			annotation.licenses = append(annotation.licenses, l[0])
+		}
+		annotations = append(annotations, annotation)
+	}
+	return annotations
+}
+
+// Compiles a path pattern. In REUSE.toml, * matches anything but a slash,
+// ** matches anything and \* a literal asterisk. In dep5, * matches
+// anything and ? any single character.
+func reuseGlob(pattern string, dep5 bool) *regexp.Regexp {
+	re := bytes.NewBufferString("^")
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case dep5 && c == '*':
+			re.WriteString(".*")
+		case dep5 && c == '?':
+			re.WriteString(".")
+		case !dep5 && c == '\\' && i+1 < len(pattern):
+			i++
+			re.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		case !dep5 && strings.HasPrefix(pattern[i:], "**"):
+			i++
+			re.WriteString(".*")
+		case !dep5 && c == '*':
+			re.WriteString("[^/]*")
+		default:
+			re.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	re.WriteString("$")
+	return regexp.MustCompile(re.String())
+}
@@ -0,0 +1,264 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSetReuse(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "licentia-tests-")
+	ok(t, err)
+	defer os.RemoveAll(dir)
+
+	source := filepath.Join(dir, "main.go")
+	ok(t, ioutil.WriteFile(source, []byte("package main\n"), 0640))
+
+	image := filepath.Join(dir, "logo.png")
+	ok(t, ioutil.WriteFile(image, []byte("\x89PNG\r\n\x1a\n\x00\x00"), 0640))
+
+	data := filepath.Join(dir, "data.json")
+	ok(t, ioutil.WriteFile(data, []byte("{}\n"), 0640))
+
+	config := &Config{
+		CopyrightOwner:   "Test",
+		CopyrightHolders: []CopyrightHolder{{Name: "Other", Years: "2015"}},
+		LicenseType:      MPL2,
+		Files:            []string{source, image, data},
+		EOLCommentStyle:  "//",
+		Reuse:            true,
+	}
+
+	skipped, err := Set(config)
+	ok(t, err)
+	equals(t, 0, len(skipped))
+
+	year := CopyrightHolder{}.years()
+	tags := "SPDX-FileCopyrightText: " + year + " Test\nSPDX-FileCopyrightText: 2015 Other\nSPDX-License-Identifier: MPL-2.0\n"
+
+	content, err := ioutil.ReadFile(source)
+	ok(t, err)
+	equals(t, "// SPDX-FileCopyrightText: "+year+" Test\n// SPDX-FileCopyrightText: 2015 Other\n// SPDX-License-Identifier: MPL-2.0\n\npackage main\n", string(content))
+
+	for _, file := range []string{image, data} {
+		content, err := ioutil.ReadFile(file + ".license")
+		ok(t, err)
+		equals(t, tags, string(content))
+	}
+
+	content, err = ioutil.ReadFile(data)
+	ok(t, err)
+	equals(t, "{}\n", string(content))
+
+	filename, err := DumpReuse(dir, MPL2, "Test")
+	ok(t, err)
+	equals(t, filepath.Join(dir, "LICENSES", "MPL-2.0.txt"), filename)
+
+	problems, err := CheckReuse(dir)
+	ok(t, err)
+	equals(t, 0, len(problems))
+}
+
+func TestSetReuseExisting(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "licentia-tests-")
+	ok(t, err)
+	defer os.RemoveAll(dir)
+
+	script := filepath.Join(dir, "run.sh")
+	ok(t, ioutil.WriteFile(script, []byte("#!/bin/sh\necho\n"), 0640))
+
+	image := filepath.Join(dir, "logo.png")
+	ok(t, ioutil.WriteFile(image, []byte("\x89PNG\r\n\x1a\n\x00\x00"), 0640))
+	ok(t, ioutil.WriteFile(image+".license", []byte("SPDX-FileCopyrightText: 2015 Other\nSPDX-FileCopyrightText: 2016 Test\nSPDX-License-Identifier: MPL-2.0\n"), 0644))
+
+	icon := filepath.Join(dir, "icon.png")
+	ok(t, ioutil.WriteFile(icon, []byte("\x89PNG\r\n\x1a\n\x00\x00"), 0640))
+	iconTags := "SPDX-FileCopyrightText: 2015 Other\nSPDX-License-Identifier: MIT\n"
+	ok(t, ioutil.WriteFile(icon+".license", []byte(iconTags), 0644))
+
+	config := &Config{
+		CopyrightHolders: []CopyrightHolder{{Name: "Test", Years: "2020"}, {Name: "New", Years: "2020"}},
+		LicenseType:      MPL2,
+		Files:            []string{script, image, icon},
+		EOLCommentStyle:  "#",
+		Reuse:            true,
+	}
+
+	skipped, err := Set(config)
+	ok(t, err)
+	equals(t, []skippedFile{{file: icon, reason: "sidecar licensed under MIT"}}, skipped)
+
+	content, err := ioutil.ReadFile(script)
+	ok(t, err)
+	equals(t, "#!/bin/sh\n\n# SPDX-FileCopyrightText: 2020 Test\n# SPDX-FileCopyrightText: 2020 New\n# SPDX-License-Identifier: MPL-2.0\n\necho\n", string(content))
+
+	content, err = ioutil.ReadFile(image + ".license")
+	ok(t, err)
+	equals(t, "SPDX-FileCopyrightText: 2015 Other\nSPDX-FileCopyrightText: 2016 Test\nSPDX-FileCopyrightText: 2020 New\nSPDX-License-Identifier: MPL-2.0\n", string(content))
+
+	content, err = ioutil.ReadFile(icon + ".license")
+	ok(t, err)
+	equals(t, iconTags, string(content))
+}
+
+func TestSetReuseTagged(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "licentia-tests-")
+	ok(t, err)
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"new.py":      "print(1)\n",
+		"tagged.py":   "# SPDX-FileCopyrightText: 2015 Other\n# SPDX-License-Identifier: MPL-2.0\n\nprint(1)\n",
+		"nolicense.c": "/* SPDX-FileCopyrightText: 2015 Test */\nint x;\n",
+		"mit.py":      "# SPDX-FileCopyrightText: 2015 Other\n# SPDX-License-Identifier: MIT\n\nprint(1)\n",
+	}
+	var names []string
+	for name, content := range files {
+		names = append(names, filepath.Join(dir, name))
+		ok(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0640))
+	}
+
+	config := &Config{
+		CopyrightHolders: []CopyrightHolder{{Name: "Test", Years: "2020"}},
+		LicenseType:      MPL2,
+		Files:            names,
+		EOLCommentStyle:  "#",
+		Reuse:            true,
+	}
+
+	expected := map[string]string{
+		"new.py":      "# SPDX-FileCopyrightText: 2020 Test\n# SPDX-License-Identifier: MPL-2.0\n\nprint(1)\n",
+		"tagged.py":   "# SPDX-FileCopyrightText: 2015 Other\n# SPDX-FileCopyrightText: 2020 Test\n# SPDX-License-Identifier: MPL-2.0\n\nprint(1)\n",
+		"nolicense.c": "/* SPDX-FileCopyrightText: 2015 Test */\n/* SPDX-License-Identifier: MPL-2.0 */\nint x;\n",
+		"mit.py":      files["mit.py"],
+	}
+
+	// Setting tags twice leaves files as they were after the first time.
+	for i := 0; i < 2; i++ {
+		skipped, err := Set(config)
+		ok(t, err)
+		equals(t, []skippedFile{{file: filepath.Join(dir, "mit.py"), reason: "licensed under MIT"}}, skipped)
+
+		for name, content := range expected {
+			data, err := ioutil.ReadFile(filepath.Join(dir, name))
+			ok(t, err)
+			equals(t, content, string(data))
+		}
+	}
+}
+
+func TestCheckReuse(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "licentia-tests-")
+	ok(t, err)
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"main.go":                 "// Copyright 2015 Test\n// SPDX-License-Identifier: MIT\n\npackage main\n",
+		"nolicense.go":            "// Copyright 2015 Test\n\npackage main\n",
+		"invalid.go":              "// Copyright 2015 Test\n// SPDX-License-Identifier: MIT OR\n\npackage main\n",
+		"vendor/lib/lib.go":       "package lib\n",
+		"docs/guide.md":           "# Guide\n",
+		"docs/img/a.png":          "\x89PNG\x00",
+		"override.go":             "// SPDX-FileCopyrightText: 2015 Test\n// SPDX-License-Identifier: GPL-3.0-or-later\n\npackage main\n",
+		"LICENSE":                 "MIT\n",
+		"LICENSES/MIT.txt":        "MIT\n",
+		"LICENSES/Zlib.txt":       "Zlib\n",
+		"REUSE.toml":              "",
+		"empty.go":                "",
+		"assets/font.ttf":         "\x00\x01",
+		"assets/font.ttf.license": "SPDX-FileCopyrightText: 2015 Foundry\nSPDX-License-Identifier: OFL-1.1\n",
+	}
+	files["REUSE.toml"] = `version = 1
+
+[[annotations]]
+path = "vendor/**"
+SPDX-FileCopyrightText = ["2015 Vendor", '2016 Vendor']
+SPDX-License-Identifier = "Apache-2.0 OR MIT"
+
+[[annotations]]
+# Only files directly in docs.
+path = ["docs/*", "override.go"]
+precedence = "override"
+SPDX-FileCopyrightText = "2015 Test"
+SPDX-License-Identifier = "CC-BY-4.0"
+`
+
+	for name, content := range files {
+		filename := filepath.Join(dir, filepath.FromSlash(name))
+		ok(t, os.MkdirAll(filepath.Dir(filename), 0755))
+		ok(t, ioutil.WriteFile(filename, []byte(content), 0640))
+	}
+
+	problems, err := CheckReuse(dir)
+	ok(t, err)
+	equals(t, []reuseProblem{
+		{"LICENSES/Apache-2.0.txt", "missing license text, used by vendor/lib/lib.go"},
+		{"LICENSES/CC-BY-4.0.txt", "missing license text, used by docs/guide.md, override.go"},
+		{"LICENSES/OFL-1.1.txt", "missing license text, used by assets/font.ttf"},
+		{"LICENSES/Zlib.txt", "unused license"},
+		{"docs/img/a.png", "missing copyright and licensing information"},
+		{"invalid.go", `invalid license expression "MIT OR": missing license`},
+		{"nolicense.go", "missing licensing information"},
+	}, problems)
+
+	// Annotations in .reuse/dep5 are aggregated with file information.
+	ok(t, os.Remove(filepath.Join(dir, "REUSE.toml")))
+	ok(t, os.MkdirAll(filepath.Join(dir, ".reuse"), 0755))
+	ok(t, ioutil.WriteFile(filepath.Join(dir, ".reuse", "dep5"), []byte(`Format: https://www.debian.org/doc/packaging-manuals/copyright-format/1.0/
+Upstream-Name: test
+
+Files: docs/* vendor/*
+Copyright: 2015 Test
+ 2016 Other
+License: MIT
+
+Files: *.go
+Copyright: 2015 Test
+License: MIT
+`), 0640))
+
+	problems, err = CheckReuse(dir)
+	ok(t, err)
+	equals(t, []reuseProblem{
+		{"LICENSES/GPL-3.0-or-later.txt", "missing license text, used by override.go"},
+		{"LICENSES/OFL-1.1.txt", "missing license text, used by assets/font.ttf"},
+		{"LICENSES/Zlib.txt", "unused license"},
+		{"invalid.go", `invalid license expression "MIT OR": missing license`},
+	}, problems)
+}
+
+func TestParseDep5(t *testing.T) {
+	annotations := parseDep5([]byte(`Format: https://www.debian.org/doc/packaging-manuals/copyright-format/1.0/
+
+Files: vendor/*
+Copyright: 2015 Test
+License: Custom
+ Permission is hereby granted to use this software.
+ .
+ It is provided as is.
+`))
+	equals(t, 1, len(annotations))
+	equals(t, []string{"Custom"}, annotations[0].licenses)
+	equals(t, []string{"2015 Test"}, annotations[0].copyrights)
+}
+
+func TestParseReuseTOML(t *testing.T) {
+	_, err := parseReuseTOML([]byte("version = 2\n"))
+	assert(t, err != nil, "Unsupported versions should be rejected")
+
+	_, err = parseReuseTOML([]byte("version = 1\n[[annotations]]\npath = \"*\"\nprecedence = \"closest-ish\"\n"))
+	assert(t, err != nil, "Invalid precedences should be rejected")
+
+	annotations, err := parseReuseTOML([]byte("version = 1\n\n[[annotations]]\npath = [\n  \"a/**\", # comment\n  'b\\*',\n]\nSPDX-License-Identifier = \"MIT\"\n"))
+	ok(t, err)
+	equals(t, 1, len(annotations))
+	equals(t, precedenceClosest, annotations[0].precedence)
+	equals(t, []string{"MIT"}, annotations[0].licenses)
+	equals(t, "^a/.*$", annotations[0].patterns[0].String())
+	equals(t, `^b\*$`, annotations[0].patterns[1].String())
+}
@@ -0,0 +1,124 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Number of lines at the top of a file searched for copyright notices.
+const copyrightScanLines = 50
+
+var copyrightLineRe = regexp.MustCompile(`^\s*(?://+|#+|--|;+|'|\*|/\*+|!|%+|REM\b)?\s*((?i:copyright\b|\(c\)|©).*?)\s*(?:\*/)?\s*$`)
+
+// Everything known about a project that goes into a bill of materials.
+type projectScan struct {
+	name    string
+	license LicenseType
+	files   []scannedFile
+	deps    []dependency
+	created time.Time
+}
+
+type scannedFile struct {
+	// Slash separated path, relative to the project when possible.
+	path       string
+	license    LicenseExpression
+	copyrights []string
+	sha1       string
+	sha256     string
+}
+
+// Scans the license, copyright notices and checksums of files, and the
+// licenses of the dependencies of the project in depsDir, if not empty.
+func ScanProject(name string, project LicenseType, files []string, depsDir string) (*projectScan, error) {
+	scan := &projectScan{name: name, license: project, created: buildTime()}
+
+	licenses, err := Detect(&Config{Files: files})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, l := range licenses {
+		data, err := ioutil.ReadFile(l.file)
+		if err != nil {
+			return nil, err
+		}
+
+		sum1 := sha1.Sum(data)
+		sum256 := sha256.Sum256(data)
+		scan.files = append(scan.files, scannedFile{
+			path:       filepath.ToSlash(filepath.Clean(l.file)),
+			license:    l.license,
+			copyrights: extractCopyrights(data),
+			sha1:       hex.EncodeToString(sum1[:]),
+			sha256:     hex.EncodeToString(sum256[:]),
+		})
+	}
+
+	sort.Slice(scan.files, func(i, j int) bool {
+		return scan.files[i].path < scan.files[j].path
+	})
+
+	if depsDir != "" {
+		if scan.deps, err = ScanDependencies(depsDir, ""); err != nil {
+			return nil, err
+		}
+	}
+	return scan, nil
+}
+
+// Returns the copyright notices found in the comments at the top of data,
+// without comment markers.
+func extractCopyrights(data []byte) []string {
+	var copyrights []string
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, len(data)+bufio.MaxScanTokenSize)
+	for n := 0; n < copyrightScanLines && scanner.Scan(); n++ {
+		if m := copyrightLineRe.FindSubmatch(scanner.Bytes()); m != nil {
+			copyrights = append(copyrights, string(m[1]))
+		}
+	}
+	return copyrights
+}
+
+// Returns the time the bill of materials is created at. SOURCE_DATE_EPOCH is
+// honored for reproducible builds.
+func buildTime() time.Time {
+	if epoch, err := strconv.ParseInt(os.Getenv("SOURCE_DATE_EPOCH"), 10, 64); err == nil {
+		return time.Unix(epoch, 0).UTC()
+	}
+	return time.Now().UTC().Truncate(time.Second)
+}
+
+// Returns an identifier made of the letters, digits, dots and dashes in s.
+func sbomID(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '.' || r == '-' {
+			return r
+		}
+		return '-'
+	}, s)
+}
+
+func toolName() string {
+	if Version == "" {
+		return "licentia"
+	}
+	return "licentia-" + Version
+}
@@ -0,0 +1,217 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const cycloneDXVersion = "1.5"
+
+// CycloneDX bill of materials. The same structure is written as JSON or XML.
+// See https://cyclonedx.org/docs/1.5/json/
+type cdxBOM struct {
+	XMLName      xml.Name        `json:"-" xml:"bom"`
+	XMLNS        string          `json:"-" xml:"xmlns,attr"`
+	BOMFormat    string          `json:"bomFormat" xml:"-"`
+	SpecVersion  string          `json:"specVersion" xml:"-"`
+	SerialNumber string          `json:"serialNumber" xml:"serialNumber,attr"`
+	Version      int             `json:"version" xml:"version,attr"`
+	Metadata     cdxMetadata     `json:"metadata" xml:"metadata"`
+	Components   []cdxComponent  `json:"components" xml:"components>component"`
+	Dependencies []cdxDependency `json:"dependencies" xml:"dependencies>dependency"`
+}
+
+type cdxMetadata struct {
+	Timestamp string       `json:"timestamp" xml:"timestamp"`
+	Tools     cdxTools     `json:"tools" xml:"tools"`
+	Component cdxComponent `json:"component" xml:"component"`
+}
+
+type cdxTools struct {
+	Components []cdxComponent `json:"components" xml:"components>component"`
+}
+
+type cdxComponent struct {
+	Type      string             `json:"type" xml:"type,attr"`
+	BOMRef    string             `json:"bom-ref,omitempty" xml:"bom-ref,attr,omitempty"`
+	Name      string             `json:"name" xml:"name"`
+	Version   string             `json:"version,omitempty" xml:"version,omitempty"`
+	Hashes    cdxHashes          `json:"hashes,omitempty" xml:"hashes"`
+	Licenses  []cdxLicenseChoice `json:"licenses,omitempty" xml:"licenses,omitempty"`
+	Copyright string             `json:"copyright,omitempty" xml:"copyright,omitempty"`
+	Purl      string             `json:"purl,omitempty" xml:"purl,omitempty"`
+}
+
+type cdxHashes []cdxHash
+
+// Omits the hashes element altogether when there are no hashes, which the
+// "a>b" tag syntax does not.
+func (h cdxHashes) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if len(h) == 0 {
+		return nil
+	}
+
+	hashes := struct {
+		Hashes []cdxHash `xml:"hash"`
+	}{h}
+	return e.EncodeElement(hashes, start)
+}
+
+type cdxHash struct {
+	Alg     string `json:"alg" xml:"alg,attr"`
+	Content string `json:"content" xml:",chardata"`
+}
+
+// Either a single license or a license expression.
+type cdxLicenseChoice struct {
+	License    *cdxLicense `json:"license,omitempty" xml:"license,omitempty"`
+	Expression string      `json:"expression,omitempty" xml:"expression,omitempty"`
+}
+
+type cdxLicense struct {
+	ID   string `json:"id,omitempty" xml:"id,omitempty"`
+	Name string `json:"name,omitempty" xml:"name,omitempty"`
+}
+
+type cdxDependency struct {
+	Ref       string          `json:"ref" xml:"ref,attr"`
+	DependsOn []string        `json:"dependsOn,omitempty" xml:"-"`
+	XMLDeps   []cdxDependency `json:"-" xml:"dependency,omitempty"`
+}
+
+// Builds a CycloneDX bill of materials with the scanned files as components
+// of the project, and its dependencies as libraries. The serial number is
+// derived from file contents and dependency versions, so scanning the same
+// tree twice yields the same document but for its timestamp.
+func BuildCycloneDX(scan *projectScan) *cdxBOM {
+	root := cdxComponent{
+		Type:     "application",
+		BOMRef:   "pkg:" + sbomID(scan.name),
+		Name:     scan.name,
+		Licenses: cdxLicenses(spdxID(scan.license), ""),
+	}
+
+	bom := &cdxBOM{
+		XMLNS:       "http://cyclonedx.org/schema/bom/" + cycloneDXVersion,
+		BOMFormat:   "CycloneDX",
+		SpecVersion: cycloneDXVersion,
+		Version:     1,
+		Metadata: cdxMetadata{
+			Timestamp: scan.created.Format(time.RFC3339),
+			Tools: cdxTools{Components: []cdxComponent{
+				{Type: "application", Name: "licentia", Version: Version},
+			}},
+			Component: root,
+		},
+	}
+
+	serial := sha1.New()
+	for _, f := range scan.files {
+		bom.Components = append(bom.Components, cdxComponent{
+			Type:   "file",
+			BOMRef: "file:" + f.path,
+			Name:   f.path,
+			Hashes: cdxHashes{
+				{"SHA-1", f.sha1},
+				{"SHA-256", f.sha256},
+			},
+			Licenses:  cdxLicenses(f.license.SPDX(), ""),
+			Copyright: strings.Join(f.copyrights, "\n"),
+		})
+		fmt.Fprintf(serial, "%s %s\n", f.path, f.sha1)
+	}
+
+	rootDeps := cdxDependency{Ref: root.BOMRef}
+	for _, dep := range scan.deps {
+		purl := packageURL(dep)
+		bom.Components = append(bom.Components, cdxComponent{
+			Type:     "library",
+			BOMRef:   purl,
+			Name:     dep.name,
+			Version:  dep.version,
+			Licenses: cdxLicenses(dep.spdx(), dep.declared),
+			Purl:     purl,
+		})
+		rootDeps.DependsOn = append(rootDeps.DependsOn, purl)
+		rootDeps.XMLDeps = append(rootDeps.XMLDeps, cdxDependency{Ref: purl})
+		fmt.Fprintf(serial, "%s\n", purl)
+	}
+	bom.Dependencies = []cdxDependency{rootDeps}
+
+	sum := serial.Sum(nil)
+	// Name based UUID, version 5.
+	sum[6] = sum[6]&0x0f | 0x50
+	sum[8] = sum[8]&0x3f | 0x80
+	bom.SerialNumber = fmt.Sprintf("urn:uuid:%x-%x-%x-%x-%x", sum[0:4], sum[4:6], sum[6:8], sum[8:10], sum[10:16])
+	return bom
+}
+
+// Returns the licenses of a component given its SPDX expression, or its
+// declared license when it is not an SPDX one.
+func cdxLicenses(expr, declared string) []cdxLicenseChoice {
+	parsed, err := parseSPDXExpression(expr)
+	switch {
+	case expr == "" || expr == noAssertion:
+		if declared == "" {
+			return nil
+		}
+		return []cdxLicenseChoice{{License: &cdxLicense{Name: declared}}}
+	case err == nil && !parsed.isLicense():
+		return []cdxLicenseChoice{{Expression: expr}}
+	case spdxLicenseType(expr) == UNKNOWN:
+		return []cdxLicenseChoice{{License: &cdxLicense{Name: expr}}}
+	}
+	return []cdxLicenseChoice{{License: &cdxLicense{ID: expr}}}
+}
+
+// Returns the package URL of a dependency. See
+// https://github.com/package-url/purl-spec
+func packageURL(dep dependency) string {
+	types := map[string]string{
+		"go":     "golang",
+		"npm":    "npm",
+		"python": "pypi",
+		"cargo":  "cargo",
+	}
+
+	name := dep.name
+	switch dep.ecosystem {
+	case "npm":
+		name = strings.Replace(name, "@", "%40", 1)
+	case "python":
+		name = strings.ToLower(strings.Replace(name, "_", "-", -1))
+	}
+	return fmt.Sprintf("pkg:%s/%s@%s", types[dep.ecosystem], name, url.PathEscape(dep.version))
+}
+
+// Writes the bill of materials in CycloneDX JSON format.
+func (bom *cdxBOM) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(bom)
+}
+
+// Writes the bill of materials in CycloneDX XML format.
+func (bom *cdxBOM) WriteXML(w io.Writer) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(bom); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
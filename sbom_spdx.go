@@ -0,0 +1,298 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+const noAssertion = "NOASSERTION"
+
+// SPDX 2.3 document. See https://spdx.github.io/spdx-spec/v2.3/
+type spdxDocument struct {
+	SPDXVersion       string             `json:"spdxVersion"`
+	DataLicense       string             `json:"dataLicense"`
+	SPDXID            string             `json:"SPDXID"`
+	Name              string             `json:"name"`
+	DocumentNamespace string             `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo   `json:"creationInfo"`
+	Packages          []spdxPackage      `json:"packages"`
+	Files             []spdxFile         `json:"files"`
+	Relationships     []spdxRelationship `json:"relationships"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxPackage struct {
+	Name             string `json:"name"`
+	SPDXID           string `json:"SPDXID"`
+	VersionInfo      string `json:"versionInfo,omitempty"`
+	DownloadLocation string `json:"downloadLocation"`
+	FilesAnalyzed    bool   `json:"filesAnalyzed"`
+	// Only set when files were analyzed.
+	PackageVerificationCode *spdxVerificationCode `json:"packageVerificationCode,omitempty"`
+	LicenseConcluded        string                `json:"licenseConcluded"`
+	LicenseDeclared         string                `json:"licenseDeclared"`
+	LicenseInfoFromFiles    []string              `json:"licenseInfoFromFiles,omitempty"`
+	CopyrightText           string                `json:"copyrightText"`
+	ExternalRefs            []spdxExternalRef     `json:"externalRefs,omitempty"`
+}
+
+type spdxVerificationCode struct {
+	Value string `json:"packageVerificationCodeValue"`
+}
+
+type spdxExternalRef struct {
+	Category string `json:"referenceCategory"`
+	Type     string `json:"referenceType"`
+	Locator  string `json:"referenceLocator"`
+}
+
+type spdxFile struct {
+	FileName           string         `json:"fileName"`
+	SPDXID             string         `json:"SPDXID"`
+	Checksums          []spdxChecksum `json:"checksums"`
+	LicenseConcluded   string         `json:"licenseConcluded"`
+	LicenseInfoInFiles []string       `json:"licenseInfoInFiles"`
+	CopyrightText      string         `json:"copyrightText"`
+}
+
+type spdxChecksum struct {
+	Algorithm string `json:"algorithm"`
+	Value     string `json:"checksumValue"`
+}
+
+type spdxRelationship struct {
+	Element        string `json:"spdxElementId"`
+	Type           string `json:"relationshipType"`
+	RelatedElement string `json:"relatedSpdxElement"`
+}
+
+// Builds an SPDX document describing the project as a package made of the
+// scanned files, which depends on a package per scanned dependency.
+// Identifiers only depend on file paths and package names, and the document
+// namespace on file contents and dependencies, so scanning the same tree
+// twice yields the same document but for its creation time.
+func BuildSPDX(scan *projectScan) *spdxDocument {
+	pkg := spdxPackage{
+		Name:             scan.name,
+		SPDXID:           "SPDXRef-Package-" + sbomID(scan.name),
+		DownloadLocation: noAssertion,
+		FilesAnalyzed:    true,
+		LicenseConcluded: spdxOrNoAssertion(scan.license),
+		LicenseDeclared:  spdxOrNoAssertion(scan.license),
+		CopyrightText:    noAssertion,
+	}
+
+	doc := &spdxDocument{
+		SPDXVersion: "SPDX-2.3",
+		DataLicense: "CC0-1.0",
+		SPDXID:      "SPDXRef-DOCUMENT",
+		Name:        scan.name,
+		CreationInfo: spdxCreationInfo{
+			Created:  scan.created.Format(time.RFC3339),
+			Creators: []string{"Tool: " + toolName()},
+		},
+		Relationships: []spdxRelationship{{"SPDXRef-DOCUMENT", "DESCRIBES", pkg.SPDXID}},
+	}
+
+	ids := make(map[string]bool, len(scan.files))
+	licenses := make(map[string]bool)
+	sha1s := make([]string, 0, len(scan.files))
+	for _, f := range scan.files {
+		id := "SPDXRef-File-" + sbomID(f.path)
+		for n := 2; ids[id]; n++ {
+			id = fmt.Sprintf("SPDXRef-File-%s-%d", sbomID(f.path), n)
+		}
+		ids[id] = true
+
+		name := f.path
+		if !strings.HasPrefix(name, "/") && !strings.HasPrefix(name, "../") {
+			name = "./" + name
+		}
+
+		license := f.license.SPDX()
+		infos := spdxExpressionIDs(license)
+		if license == "" {
+			license, infos = noAssertion, []string{noAssertion}
+		}
+		for _, l := range infos {
+			licenses[l] = true
+		}
+
+		copyright := noAssertion
+		if len(f.copyrights) > 0 {
+			copyright = strings.Join(f.copyrights, "\n")
+		}
+
+		doc.Files = append(doc.Files, spdxFile{
+			FileName: name,
+			SPDXID:   id,
+			Checksums: []spdxChecksum{
+				{"SHA1", f.sha1},
+				{"SHA256", f.sha256},
+			},
+			LicenseConcluded:   license,
+			LicenseInfoInFiles: infos,
+			CopyrightText:      copyright,
+		})
+		doc.Relationships = append(doc.Relationships, spdxRelationship{pkg.SPDXID, "CONTAINS", id})
+		sha1s = append(sha1s, f.sha1)
+	}
+
+	// https://spdx.github.io/spdx-spec/v2.3/package-information/#79-package-verification-code-field
+	sort.Strings(sha1s)
+	code := sha1.Sum([]byte(strings.Join(sha1s, "")))
+	pkg.PackageVerificationCode = &spdxVerificationCode{hex.EncodeToString(code[:])}
+
+	for l := range licenses {
+		pkg.LicenseInfoFromFiles = append(pkg.LicenseInfoFromFiles, l)
+	}
+	sort.Strings(pkg.LicenseInfoFromFiles)
+	doc.Packages = []spdxPackage{pkg}
+
+	namespace := pkg.PackageVerificationCode.Value
+	if len(scan.deps) > 0 {
+		sum := sha1.New()
+		fmt.Fprintf(sum, "%s\n", namespace)
+		for _, dep := range scan.deps {
+			fmt.Fprintf(sum, "%s\n", packageURL(dep))
+		}
+		namespace = hex.EncodeToString(sum.Sum(nil))
+	}
+	doc.DocumentNamespace = fmt.Sprintf("https://spdx.org/spdxdocs/%s-%s", sbomID(scan.name), namespace)
+
+	pkgIDs := map[string]bool{pkg.SPDXID: true}
+	for _, dep := range scan.deps {
+		id := "SPDXRef-Package-" + sbomID(dep.ecosystem+"-"+dep.name+"-"+dep.version)
+		for n := 2; pkgIDs[id]; n++ {
+			id = fmt.Sprintf("SPDXRef-Package-%s-%d", sbomID(dep.ecosystem+"-"+dep.name+"-"+dep.version), n)
+		}
+		pkgIDs[id] = true
+
+		doc.Packages = append(doc.Packages, spdxPackage{
+			Name:             dep.name,
+			SPDXID:           id,
+			VersionInfo:      dep.version,
+			DownloadLocation: noAssertion,
+			LicenseConcluded: spdxExpressionOrNoAssertion(dep.spdx()),
+			LicenseDeclared:  spdxExpressionOrNoAssertion(dep.declared),
+			CopyrightText:    noAssertion,
+			ExternalRefs:     []spdxExternalRef{{"PACKAGE-MANAGER", "purl", packageURL(dep)}},
+		})
+		doc.Relationships = append(doc.Relationships, spdxRelationship{pkg.SPDXID, "DEPENDS_ON", id})
+	}
+	return doc
+}
+
+// Writes the document in SPDX JSON format.
+func (doc *spdxDocument) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(doc)
+}
+
+// Writes the document in SPDX tag-value format.
+func (doc *spdxDocument) WriteTagValue(w io.Writer) error {
+	ew := &errWriter{w: w}
+	ew.printf("SPDXVersion: %s\n", doc.SPDXVersion)
+	ew.printf("DataLicense: %s\n", doc.DataLicense)
+	ew.printf("SPDXID: %s\n", doc.SPDXID)
+	ew.printf("DocumentName: %s\n", doc.Name)
+	ew.printf("DocumentNamespace: %s\n", doc.DocumentNamespace)
+	for _, creator := range doc.CreationInfo.Creators {
+		ew.printf("Creator: %s\n", creator)
+	}
+	ew.printf("Created: %s\n", doc.CreationInfo.Created)
+
+	for _, pkg := range doc.Packages {
+		ew.printf("\nPackageName: %s\n", pkg.Name)
+		ew.printf("SPDXID: %s\n", pkg.SPDXID)
+		if pkg.VersionInfo != "" {
+			ew.printf("PackageVersion: %s\n", pkg.VersionInfo)
+		}
+		ew.printf("PackageDownloadLocation: %s\n", pkg.DownloadLocation)
+		ew.printf("FilesAnalyzed: %t\n", pkg.FilesAnalyzed)
+		if pkg.PackageVerificationCode != nil {
+			ew.printf("PackageVerificationCode: %s\n", pkg.PackageVerificationCode.Value)
+		}
+		ew.printf("PackageLicenseConcluded: %s\n", pkg.LicenseConcluded)
+		ew.printf("PackageLicenseDeclared: %s\n", pkg.LicenseDeclared)
+		for _, l := range pkg.LicenseInfoFromFiles {
+			ew.printf("PackageLicenseInfoFromFiles: %s\n", l)
+		}
+		ew.printf("PackageCopyrightText: %s\n", tagValueText(pkg.CopyrightText))
+		for _, ref := range pkg.ExternalRefs {
+			ew.printf("ExternalRef: %s %s %s\n", ref.Category, ref.Type, ref.Locator)
+		}
+	}
+
+	for _, f := range doc.Files {
+		ew.printf("\nFileName: %s\n", f.FileName)
+		ew.printf("SPDXID: %s\n", f.SPDXID)
+		for _, c := range f.Checksums {
+			ew.printf("FileChecksum: %s: %s\n", c.Algorithm, c.Value)
+		}
+		ew.printf("LicenseConcluded: %s\n", f.LicenseConcluded)
+		for _, l := range f.LicenseInfoInFiles {
+			ew.printf("LicenseInfoInFile: %s\n", l)
+		}
+		ew.printf("FileCopyrightText: %s\n", tagValueText(f.CopyrightText))
+	}
+
+	ew.printf("\n")
+	for _, r := range doc.Relationships {
+		ew.printf("Relationship: %s %s %s\n", r.Element, r.Type, r.RelatedElement)
+	}
+	return ew.err
+}
+
+// Wraps multi-line values in <text> tags, as tag-value requires.
+func tagValueText(s string) string {
+	if s == noAssertion || s == "NONE" {
+		return s
+	}
+	return "<text>" + s + "</text>"
+}
+
+func spdxOrNoAssertion(ltype LicenseType) string {
+	if id := spdxID(ltype); id != "" {
+		return id
+	}
+	return noAssertion
+}
+
+// Returns expr if it is an SPDX license expression, as the licenses
+// declared by dependencies may not be.
+func spdxExpressionOrNoAssertion(expr string) string {
+	if _, err := parseSPDXExpression(expr); err != nil {
+		return noAssertion
+	}
+	return expr
+}
+
+// Keeps the first error of a sequence of writes, so that it only has to be
+// checked once.
+type errWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (ew *errWriter) printf(format string, args ...interface{}) {
+	if ew.err != nil {
+		return
+	}
+	_, ew.err = fmt.Fprintf(ew.w, format, args...)
+}
@@ -0,0 +1,166 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestExtractCopyrights(t *testing.T) {
+	data := []byte(`#!/bin/sh
+# Copyright (c) 2015 Test
+/* Copyright 2016 Other Inc. */
+echo "hello"
+`)
+	equals(t, []string{"Copyright (c) 2015 Test", "Copyright 2016 Other Inc."}, extractCopyrights(data))
+}
+
+func TestBuildSPDX(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "licentia-tests-")
+	ok(t, err)
+	defer os.RemoveAll(dir)
+
+	defer os.Setenv("SOURCE_DATE_EPOCH", os.Getenv("SOURCE_DATE_EPOCH"))
+	os.Setenv("SOURCE_DATE_EPOCH", "1500000000")
+
+	licensed := filepath.Join(dir, "licensed.go")
+	unlicensed := filepath.Join(dir, "unlicensed_file.go")
+	ok(t, ioutil.WriteFile(licensed, []byte("package main\n"), 0640))
+	ok(t, ioutil.WriteFile(unlicensed, []byte("package main\n"), 0640))
+
+	_, err = Set(&Config{
+		CopyrightOwner:  "Test",
+		LicenseType:     MIT,
+		Files:           []string{licensed},
+		EOLCommentStyle: "//",
+	})
+	ok(t, err)
+
+	scan, err := ScanProject("test", Apache2, []string{unlicensed, licensed}, "")
+	ok(t, err)
+
+	doc := BuildSPDX(scan)
+	equals(t, "2017-07-14T02:40:00Z", doc.CreationInfo.Created)
+	equals(t, 2, len(doc.Files))
+	equals(t, "SPDXRef-File-"+sbomID(filepath.ToSlash(licensed)), doc.Files[0].SPDXID)
+	equals(t, "MIT", doc.Files[0].LicenseConcluded)
+	assert(t, strings.HasPrefix(doc.Files[0].CopyrightText, "Copyright (c) "), "Unexpected copyright text %q", doc.Files[0].CopyrightText)
+	equals(t, noAssertion, doc.Files[1].LicenseConcluded)
+	equals(t, noAssertion, doc.Files[1].CopyrightText)
+	equals(t, "Apache-2.0", doc.Packages[0].LicenseDeclared)
+	equals(t, []string{"MIT", noAssertion}, doc.Packages[0].LicenseInfoFromFiles)
+	equals(t, 3, len(doc.Relationships))
+
+	// Same tree, same document.
+	again, err := ScanProject("test", Apache2, []string{licensed, unlicensed}, "")
+	ok(t, err)
+	equals(t, doc, BuildSPDX(again))
+
+	buf := bytes.NewBuffer(nil)
+	ok(t, doc.WriteJSON(buf))
+	var decoded map[string]interface{}
+	ok(t, json.Unmarshal(buf.Bytes(), &decoded))
+	equals(t, "SPDX-2.3", decoded["spdxVersion"])
+
+	buf.Reset()
+	ok(t, doc.WriteTagValue(buf))
+	assert(t, strings.Contains(buf.String(), "\nLicenseConcluded: MIT\n"), "Missing file license:\n%s", buf)
+	assert(t, strings.Contains(buf.String(), "\nFileCopyrightText: <text>Copyright (c) "), "Missing file copyright:\n%s", buf)
+}
+
+func TestBuildSPDXDependencies(t *testing.T) {
+	scan := &projectScan{
+		name:    "test",
+		license: Apache2,
+		files: []scannedFile{
+			{path: "main.go", license: singleLicense(MIT), sha1: "da39a3ee", sha256: "e3b0c442"},
+		},
+		deps: []dependency{
+			{ecosystem: "npm", name: "@scope/dual", version: "2.0.0", declared: "MIT OR Apache-2.0", license: UNKNOWN},
+			{ecosystem: "python", name: "requests", version: "2.31.0", declared: "Apache 2.0", license: Apache2},
+		},
+	}
+
+	doc := BuildSPDX(scan)
+	equals(t, 3, len(doc.Packages))
+	equals(t, spdxPackage{
+		Name:             "@scope/dual",
+		SPDXID:           "SPDXRef-Package-npm--scope-dual-2.0.0",
+		VersionInfo:      "2.0.0",
+		DownloadLocation: noAssertion,
+		LicenseConcluded: "MIT OR Apache-2.0",
+		LicenseDeclared:  "MIT OR Apache-2.0",
+		CopyrightText:    noAssertion,
+		ExternalRefs:     []spdxExternalRef{{"PACKAGE-MANAGER", "purl", "pkg:npm/%40scope/dual@2.0.0"}},
+	}, doc.Packages[1])
+	equals(t, "Apache-2.0", doc.Packages[2].LicenseConcluded)
+	equals(t, noAssertion, doc.Packages[2].LicenseDeclared)
+	equals(t, []spdxRelationship{
+		{"SPDXRef-DOCUMENT", "DESCRIBES", "SPDXRef-Package-test"},
+		{"SPDXRef-Package-test", "CONTAINS", "SPDXRef-File-main.go"},
+		{"SPDXRef-Package-test", "DEPENDS_ON", "SPDXRef-Package-npm--scope-dual-2.0.0"},
+		{"SPDXRef-Package-test", "DEPENDS_ON", "SPDXRef-Package-python-requests-2.31.0"},
+	}, doc.Relationships)
+
+	// Dependencies are part of the document identity.
+	files := *scan
+	files.deps = nil
+	assert(t, doc.DocumentNamespace != BuildSPDX(&files).DocumentNamespace, "Documents with other dependencies should have other namespaces")
+
+	buf := bytes.NewBuffer(nil)
+	ok(t, doc.WriteTagValue(buf))
+	assert(t, strings.Contains(buf.String(), "\nPackageName: @scope/dual\nSPDXID: SPDXRef-Package-npm--scope-dual-2.0.0\nPackageVersion: 2.0.0\nPackageDownloadLocation: NOASSERTION\nFilesAnalyzed: false\nPackageLicenseConcluded: MIT OR Apache-2.0\n"), "Missing dependency:\n%s", buf)
+	assert(t, strings.Contains(buf.String(), "\nExternalRef: PACKAGE-MANAGER purl pkg:npm/%40scope/dual@2.0.0\n"), "Missing package URL:\n%s", buf)
+	assert(t, strings.Contains(buf.String(), "\nRelationship: SPDXRef-Package-test DEPENDS_ON SPDXRef-Package-python-requests-2.31.0\n"), "Missing dependency relationship:\n%s", buf)
+}
+
+func TestBuildCycloneDX(t *testing.T) {
+	scan := &projectScan{
+		name:    "test",
+		license: Apache2,
+		files: []scannedFile{
+			{path: "main.go", license: singleLicense(MIT), copyrights: []string{"Copyright (c) 2015 Test"}, sha1: "da39a3ee", sha256: "e3b0c442"},
+		},
+		deps: []dependency{
+			{ecosystem: "npm", name: "@scope/dual", version: "2.0.0", declared: "MIT OR Apache-2.0", license: UNKNOWN},
+			{ecosystem: "go", name: "example.com/wtfpl", version: "v1.0.0", declared: "WTFPL", license: UNKNOWN},
+		},
+	}
+
+	bom := BuildCycloneDX(scan)
+	equals(t, 3, len(bom.Components))
+	equals(t, []cdxLicenseChoice{{License: &cdxLicense{ID: "MIT"}}}, bom.Components[0].Licenses)
+	equals(t, "Copyright (c) 2015 Test", bom.Components[0].Copyright)
+	equals(t, "pkg:npm/%40scope/dual@2.0.0", bom.Components[1].Purl)
+	equals(t, []cdxLicenseChoice{{Expression: "MIT OR Apache-2.0"}}, bom.Components[1].Licenses)
+	equals(t, []cdxLicenseChoice{{License: &cdxLicense{Name: "WTFPL"}}}, bom.Components[2].Licenses)
+	equals(t, []string{"pkg:npm/%40scope/dual@2.0.0", "pkg:golang/example.com/wtfpl@v1.0.0"}, bom.Dependencies[0].DependsOn)
+	equals(t, bom.SerialNumber, BuildCycloneDX(scan).SerialNumber)
+
+	buf := bytes.NewBuffer(nil)
+	ok(t, bom.WriteJSON(buf))
+	var decoded map[string]interface{}
+	ok(t, json.Unmarshal(buf.Bytes(), &decoded))
+	equals(t, "CycloneDX", decoded["bomFormat"])
+
+	buf.Reset()
+	ok(t, bom.WriteXML(buf))
+	var decodedXML struct {
+		Components []struct {
+			Name       string `xml:"name"`
+			Expression string `xml:"licenses>expression"`
+		} `xml:"components>component"`
+	}
+	ok(t, xml.Unmarshal(buf.Bytes(), &decodedXML))
+	equals(t, "MIT OR Apache-2.0", decodedXML.Components[1].Expression)
+	assert(t, !strings.Contains(buf.String(), "<hashes></hashes>"), "Empty hashes should be omitted:\n%s", buf)
+}
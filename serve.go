@@ -0,0 +1,235 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Default address "serve" listens on.
+const DefaultServeAddr = "localhost:8080"
+
+// Time allowed to read a request and to write its response.
+const (
+	serveReadTimeout  = 10 * time.Second
+	serveWriteTimeout = 30 * time.Second
+)
+
+// Years of a copyright notice, as accepted in header requests.
+var serveYearRe = regexp.MustCompile(`^` + yearPattern + `$`)
+
+// Error returned by the API, with the HTTP status of the response.
+type apiError struct {
+	Status  int    `json:"status"`
+	Message string `json:"message"`
+}
+
+func (e *apiError) Error() string {
+	return e.Message
+}
+
+type headerRequest struct {
+	// License type or expression, ex: mpl2 or "apache2 OR mit".
+	Type  string `json:"type"`
+	Owner string `json:"owner"`
+	// End-of-line comment style, ex: //.
+	Style string `json:"style"`
+	// Years of the copyright notice. Defaults to the current year.
+	Year string `json:"year"`
+}
+
+type checkRequest struct {
+	Content string `json:"content"`
+	// License type or expression the content should be under, if any.
+	Type string `json:"type"`
+}
+
+type detectResponse struct {
+	License string `json:"license"`
+	SPDX    string `json:"spdx"`
+}
+
+type checkResponse struct {
+	detectResponse
+	Copyrights []string `json:"copyrights"`
+	// Empty when the content has the expected license header.
+	Problems []string `json:"problems"`
+}
+
+// Returns the handler of the HTTP API:
+//
+//	GET  /licenses  supported licenses, as "list --format json"
+//	POST /detect    license of the file contents in the request body
+//	POST /header    header "set" inserts, from a headerRequest
+//	POST /check     license and copyright notices of a checkRequest content
+//
+// Responses are JSON. Errors are returned as {"error": apiError}. Request
+// bodies are limited to DefaultMaxFileSize bytes.
+func NewServer() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("/licenses", apiHandler(http.MethodGet, serveLicenses))
+	mux.Handle("/detect", apiHandler(http.MethodPost, serveDetect))
+	mux.Handle("/header", apiHandler(http.MethodPost, serveHeader))
+	mux.Handle("/check", apiHandler(http.MethodPost, serveCheck))
+	mux.Handle("/", apiHandler("", func(r *http.Request) (interface{}, error) {
+		return nil, &apiError{http.StatusNotFound, fmt.Sprintf("no endpoint %s", r.URL.Path)}
+	}))
+	return mux
+}
+
+// Serves fn as a JSON endpoint accepting method, or any method if empty.
+func apiHandler(method string, fn func(r *http.Request) (interface{}, error)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var v interface{}
+		var err error
+		if method != "" && r.Method != method {
+			w.Header().Set("Allow", method)
+			err = &apiError{http.StatusMethodNotAllowed, fmt.Sprintf("%s %s is not supported, use %s", r.Method, r.URL.Path, method)}
+		} else {
+			v, err = fn(r)
+		}
+
+		status := http.StatusOK
+		if err != nil {
+			apiErr, ok := err.(*apiError)
+			if !ok {
+				apiErr = &apiError{http.StatusInternalServerError, err.Error()}
+			}
+			status = apiErr.Status
+			v = map[string]*apiError{"error": apiErr}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(v)
+	})
+}
+
+func serveLicenses(r *http.Request) (interface{}, error) {
+	return catalog, nil
+}
+
+func serveDetect(r *http.Request) (interface{}, error) {
+	data, err := readBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	expr, err := detectLicenseIn(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	return detectResponse{License: expr.String(), SPDX: expr.SPDX()}, nil
+}
+
+func serveHeader(r *http.Request) (interface{}, error) {
+	var req headerRequest
+	if err := decodeBody(r, &req); err != nil {
+		return nil, err
+	}
+
+	config := &Config{EOLCommentStyle: req.Style}
+	if req.Owner != "" {
+		config.CopyrightHolders = []CopyrightHolder{{Name: req.Owner, Years: req.Year}}
+	}
+	if err := config.setLicense(req.Type); err != nil {
+		return nil, &apiError{http.StatusBadRequest, err.Error()}
+	}
+
+	switch {
+	case strings.TrimSpace(req.Style) == "":
+		return nil, &apiError{http.StatusBadRequest, "missing comment style"}
+	case strings.ContainsAny(req.Style, "\r\n"):
+		return nil, &apiError{http.StatusBadRequest, "comment style must be a single line"}
+	case strings.ContainsAny(req.Owner, "\r\n"):
+		return nil, &apiError{http.StatusBadRequest, "owner must be a single line"}
+	case req.Year != "" && !serveYearRe.MatchString(req.Year):
+		return nil, &apiError{http.StatusBadRequest, fmt.Sprintf("invalid year %q, ex: 2015, 2015-2019 or 2015, 2017", req.Year)}
+	}
+
+	header := bytes.NewBuffer(nil)
+	if err := renderInsertedHeader(header, config); err != nil {
+		return nil, err
+	}
+	return map[string]string{"header": header.String()}, nil
+}
+
+func serveCheck(r *http.Request) (interface{}, error) {
+	var req checkRequest
+	if err := decodeBody(r, &req); err != nil {
+		return nil, err
+	}
+
+	var expected LicenseExpression
+	if req.Type != "" {
+		var err error
+		if expected, err = ParseLicenseExpression(req.Type); err != nil {
+			return nil, &apiError{http.StatusBadRequest, err.Error()}
+		}
+	}
+
+	data := []byte(req.Content)
+	expr, err := detectLicenseIn(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := checkResponse{
+		detectResponse: detectResponse{License: expr.String(), SPDX: expr.SPDX()},
+		Copyrights:     extractCopyrights(data),
+		Problems:       []string{},
+	}
+	if resp.Copyrights == nil {
+		resp.Copyrights = []string{}
+	}
+
+	switch {
+	case expr.IsUnknown():
+		resp.Problems = append(resp.Problems, "missing license header")
+	case req.Type != "" && expr.String() != expected.String():
+		resp.Problems = append(resp.Problems, fmt.Sprintf("licensed under %s instead of %s", expr, expected))
+	}
+
+	if len(resp.Copyrights) == 0 {
+		license := expected
+		if req.Type == "" {
+			license = expr
+		}
+		if len(license.Licenses) > 1 || (!license.IsUnknown() && lookupLicense(license.Licenses[0]).Copyright) {
+			resp.Problems = append(resp.Problems, "missing copyright notice")
+		}
+	}
+	return resp, nil
+}
+
+func readBody(r *http.Request) ([]byte, error) {
+	data, err := ioutil.ReadAll(io.LimitReader(r.Body, DefaultMaxFileSize+1))
+	if err != nil {
+		return nil, &apiError{http.StatusBadRequest, err.Error()}
+	}
+	if int64(len(data)) > DefaultMaxFileSize {
+		return nil, &apiError{http.StatusRequestEntityTooLarge, fmt.Sprintf("request body exceeds %d bytes", DefaultMaxFileSize)}
+	}
+	return data, nil
+}
+
+func decodeBody(r *http.Request, v interface{}) error {
+	data, err := readBody(r)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return &apiError{http.StatusBadRequest, fmt.Sprintf("invalid request: %v", err)}
+	}
+	return nil
+}
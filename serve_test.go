@@ -0,0 +1,71 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestServer(t *testing.T) {
+	server := httptest.NewServer(NewServer())
+	defer server.Close()
+
+	call := func(method, path, body string, status int, v interface{}) {
+		req, err := http.NewRequest(method, server.URL+path, strings.NewReader(body))
+		ok(t, err)
+		resp, err := http.DefaultClient.Do(req)
+		ok(t, err)
+		defer resp.Body.Close()
+		equals(t, status, resp.StatusCode)
+		equals(t, "application/json", resp.Header.Get("Content-Type"))
+		ok(t, json.NewDecoder(resp.Body).Decode(v))
+	}
+
+	var licenses []map[string]interface{}
+	call("GET", "/licenses", "", http.StatusOK, &licenses)
+	equals(t, len(catalog), len(licenses))
+
+	var detected detectResponse
+	call("POST", "/detect", mpl2+"package main\n", http.StatusOK, &detected)
+	equals(t, detectResponse{License: "mpl2", SPDX: "MPL-2.0"}, detected)
+
+	var header map[string]string
+	call("POST", "/header", `{"type": "mit", "owner": "Test", "style": "//", "year": "2015"}`, http.StatusOK, &header)
+	assert(t, strings.HasPrefix(header["header"], "// Copyright (c) 2015 Test\n//\n// The MIT License (MIT)\n"), "Unexpected header: %q", header["header"])
+
+	var checked checkResponse
+	call("POST", "/check", `{"content": "package main\n", "type": "mpl2"}`, http.StatusOK, &checked)
+	equals(t, []string{"missing license header"}, checked.Problems)
+
+	body, err := json.Marshal(checkRequest{Content: header["header"] + "package main\n", Type: "apache2"})
+	ok(t, err)
+	call("POST", "/check", string(body), http.StatusOK, &checked)
+	equals(t, "mit", checked.License)
+	equals(t, []string{"Copyright (c) 2015 Test"}, checked.Copyrights)
+	equals(t, []string{"licensed under mit instead of apache2"}, checked.Problems)
+
+	var failed struct{ Error apiError }
+	call("POST", "/header", `{"type": "foo"}`, http.StatusBadRequest, &failed)
+	equals(t, http.StatusBadRequest, failed.Error.Status)
+	assert(t, strings.Contains(failed.Error.Message, `"foo"`), "Unexpected error: %q", failed.Error.Message)
+
+	for _, body := range []string{
+		`{"type": "mit", "owner": "Test"}`,
+		`{"type": "mit", "owner": "Test\nInjected", "style": "//"}`,
+		`{"type": "mit", "owner": "Test", "style": "//", "year": "last year"}`,
+		`{"type": "mit", "owner": "Test", "style": "//", "year": "2015\n// Injected"}`,
+	} {
+		call("POST", "/header", body, http.StatusBadRequest, &failed)
+	}
+
+	call("POST", "/check", `{`, http.StatusBadRequest, &failed)
+	call("GET", "/detect", "", http.StatusMethodNotAllowed, &failed)
+	call("GET", "/missing", "", http.StatusNotFound, &failed)
+	call("POST", "/detect", strings.Repeat("a", int(DefaultMaxFileSize)+1), http.StatusRequestEntityTooLarge, &failed)
+}
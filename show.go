@@ -0,0 +1,117 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// Owner shown in headers when none is given to Show.
+const placeholderOwner = "<owner>"
+
+// Writes the metadata of ltype followed by the header Set would insert for
+// owner using the eol comment style.
+func Show(w io.Writer, ltype LicenseType, owner, eol string) error {
+	info := lookupLicense(ltype)
+	if info == nil {
+		return fmt.Errorf("unsupported license type %q", ltype)
+	}
+	header, err := showHeader(ltype, owner, eol)
+	if err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "Type:\t%s\n", info.Type)
+	fmt.Fprintf(tw, "Name:\t%s\n", info.Name)
+	fmt.Fprintf(tw, "SPDX:\t%s\n", info.SPDX)
+	fmt.Fprintf(tw, "URL:\t%s\n", info.URL)
+	fmt.Fprintf(tw, "OSI approved:\t%s\n", yesNo(info.OSI))
+	fmt.Fprintf(tw, "FSF free:\t%s\n", yesNo(info.FSF))
+	fmt.Fprintf(tw, "Copyleft:\t%s\n", info.Copyleft)
+	fmt.Fprintf(tw, "Copyright notice:\t%s\n", yesNo(info.Copyright))
+	fmt.Fprintf(tw, "Full text:\t%s\n", yesNo(info.Text))
+	if len(info.Aliases) > 0 {
+		fmt.Fprintf(tw, "Aliases:\t%s\n", strings.Join(info.Aliases, ", "))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "\nHeader:\n\n")
+	_, err = w.Write(header)
+	return err
+}
+
+// Writes the header Set would insert for owner using the eol comment style,
+// and nothing else, ex: for the -header flag of the license header analyzer.
+func ShowHeader(w io.Writer, ltype LicenseType, owner, eol string) error {
+	if lookupLicense(ltype) == nil {
+		return fmt.Errorf("unsupported license type %q", ltype)
+	}
+	header, err := showHeader(ltype, owner, eol)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(header)
+	return err
+}
+
+func showHeader(ltype LicenseType, owner, eol string) ([]byte, error) {
+	if owner == "" {
+		owner = placeholderOwner
+	}
+
+	header := bytes.NewBuffer(nil)
+	config := &Config{LicenseType: ltype, CopyrightOwner: owner, EOLCommentStyle: eol}
+	if err := renderInsertedHeader(header, config); err != nil {
+		return nil, err
+	}
+	return header.Bytes(), nil
+}
+
+// Writes the supported licenses in format, text or json. The text format
+// lists license types, along with their SPDX identifier and name when
+// verbose.
+func WriteList(w io.Writer, format string, verbose bool) error {
+	switch format {
+	case "", "text":
+	case "json":
+		data, err := json.MarshalIndent(catalog, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(append(data, '\n'))
+		return err
+	default:
+		return fmt.Errorf("unsupported list format %q", format)
+	}
+
+	if !verbose {
+		fmt.Fprintln(w, "Supported licenses: ")
+		for _, info := range catalog {
+			fmt.Fprintln(w, "* "+string(info.Type))
+		}
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	for _, info := range catalog {
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", info.Type, info.SPDX, info.Name)
+	}
+	return tw.Flush()
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
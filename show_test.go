@@ -0,0 +1,66 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestShow(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	ok(t, Show(buf, MPL2, "", "//"))
+	assert(t, strings.Contains(buf.String(), "SPDX:              MPL-2.0\n"), "SPDX identifier missing from:\n%s", buf)
+	assert(t, strings.Contains(buf.String(), "Copyright notice:  no\n"), "Copyright notice use missing from:\n%s", buf)
+	assert(t, strings.HasSuffix(buf.String(), "\nHeader:\n\n"+mpl2), "Unexpected header in:\n%s", buf)
+
+	// The header shown is the one Set inserts.
+	file, err := ioutil.TempFile(os.TempDir(), "licentia-tests-")
+	ok(t, err)
+	defer os.Remove(file.Name())
+	_, err = Set(&Config{CopyrightOwner: "Test", LicenseType: MIT, Files: []string{file.Name()}, EOLCommentStyle: "#"})
+	ok(t, err)
+	data, err := ioutil.ReadFile(file.Name())
+	ok(t, err)
+
+	buf.Reset()
+	ok(t, Show(buf, MIT, "Test", "#"))
+	assert(t, strings.HasSuffix(buf.String(), "\nHeader:\n\n"+string(data)), "Unexpected header in:\n%s", buf)
+
+	assert(t, Show(buf, "foo", "", "") != nil, "Unsupported licenses should not be shown")
+
+	buf.Reset()
+	ok(t, ShowHeader(buf, MIT, "Test", "#"))
+	equals(t, string(data), buf.String())
+	assert(t, ShowHeader(buf, "foo", "", "") != nil, "Unsupported licenses should not be shown")
+}
+
+func TestWriteList(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	ok(t, WriteList(buf, "json", false))
+
+	var licenses []struct {
+		Type     LicenseType
+		SPDX     string
+		Copyleft string
+		Text     bool
+	}
+	ok(t, json.Unmarshal(buf.Bytes(), &licenses))
+	equals(t, len(catalog), len(licenses))
+	equals(t, Apache2, licenses[0].Type)
+	equals(t, "Apache-2.0", licenses[0].SPDX)
+	equals(t, "permissive", licenses[0].Copyleft)
+	equals(t, true, licenses[0].Text)
+
+	buf.Reset()
+	ok(t, WriteList(buf, "", true))
+	assert(t, strings.HasPrefix(buf.String(), "apache2     Apache-2.0         Apache License 2.0\n"), "Unexpected verbose list:\n%s", buf)
+
+	assert(t, WriteList(buf, "xml", false) != nil, "Unsupported formats should be rejected")
+}
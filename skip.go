@@ -0,0 +1,170 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Files bigger than this are skipped unless Config.MaxFileSize says otherwise.
+const DefaultMaxFileSize int64 = 1 << 20
+
+// Number of bytes sniffed from the beginning of a file to tell whether it is
+// binary or generated.
+const sniffLen = 8000
+
+// Minified assets have most of their content on a few very long lines.
+// Lines above this length are considered minified, and so is the content
+// when they hold most of it.
+const minifiedLineLen = 1000
+
+var (
+	// https://golang.org/s/generatedcode
+	goGeneratedRe = regexp.MustCompile(`^// Code generated .* DO NOT EDIT\.$`)
+
+	// Banners commonly left by other code generators in the leading
+	// comments of files.
+	generatedBanners = [][]byte{
+		[]byte("@generated"),
+		[]byte("DO NOT EDIT"),
+		[]byte("Autogenerated by"),
+		[]byte("Automatically generated by"),
+		[]byte("This file was automatically generated"),
+		[]byte("This file is generated"),
+	}
+
+	// Lines starting or continuing comments, in the usual comment styles.
+	commentLineRe = regexp.MustCompile(`^\s*(?://|#|/\*|\*|--|;|%|'|<!--|REM\b)`)
+
+	minifiedSuffixes = []string{".min.js", ".min.css", ".min.map", ".bundle.js"}
+)
+
+type skippedFile struct {
+	file   string
+	reason string
+}
+
+// Returns the reason why filename should not be touched or an empty string
+// if it is safe to process it.
+func skipReason(filename string, config *Config) (string, error) {
+	fi, err := os.Stat(filename)
+	if err != nil {
+		return "", err
+	}
+
+	if fi.IsDir() {
+		return "is a directory", nil
+	}
+
+	if max := maxFileSize(config); fi.Size() > max {
+		return fmt.Sprintf("file size %d exceeds limit of %d bytes", fi.Size(), max), nil
+	}
+
+	for _, suffix := range minifiedSuffixes {
+		if strings.HasSuffix(filepath.Base(filename), suffix) {
+			return "minified file", nil
+		}
+	}
+
+	fh, err := os.Open(filename)
+	if err != nil {
+		return "", err
+	}
+	defer fh.Close()
+
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(fh, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", err
+	}
+
+	return sniff(head[:n], n < sniffLen), nil
+}
+
+// Inspects the first bytes of a file and tells whether they belong to a
+// binary, generated or minified file. complete is true when data holds the
+// whole file.
+func sniff(data []byte, complete bool) string {
+	if bytes.IndexByte(data, 0) != -1 {
+		return "binary file"
+	}
+
+	text := data
+	if !complete {
+		// Do not let a multi-byte character cut in half by the sniffing
+		// buffer to be mistaken for invalid UTF-8.
+		for i := 0; i < utf8.UTFMax && len(text) > 0 && !utf8.Valid(text); i++ {
+			text = text[:len(text)-1]
+		}
+	}
+	if !utf8.Valid(text) {
+		return "binary file"
+	}
+
+	for _, line := range leadingComments(data) {
+		if goGeneratedRe.Match(line) {
+			return "generated file"
+		}
+		for _, banner := range generatedBanners {
+			if bytes.Contains(line, banner) {
+				return "generated file"
+			}
+		}
+	}
+
+	long := 0
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		if len(line) > minifiedLineLen {
+			long += len(line)
+		}
+	}
+	if long > len(data)/2 {
+		return "minified file"
+	}
+	return ""
+}
+
+// Returns the lines of the comments at the beginning of data, up to the
+// first line of code. Blank lines and lines within block comments are
+// included.
+func leadingComments(data []byte) [][]byte {
+	var lines [][]byte
+	var blockEnd []byte
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		line = bytes.TrimRight(line, "\r")
+		trimmed := bytes.TrimSpace(line)
+		switch {
+		case blockEnd != nil:
+			if bytes.Contains(trimmed, blockEnd) {
+				blockEnd = nil
+			}
+		case len(trimmed) == 0, bytes.HasPrefix(trimmed, []byte("#!")):
+		case commentLineRe.Match(line):
+			for _, block := range [][2]string{{"/*", "*/"}, {"<!--", "-->"}} {
+				if i := bytes.Index(trimmed, []byte(block[0])); i != -1 && !bytes.Contains(trimmed[i+len(block[0]):], []byte(block[1])) {
+					blockEnd = []byte(block[1])
+				}
+			}
+		default:
+			return lines
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func maxFileSize(config *Config) int64 {
+	if config.MaxFileSize > 0 {
+		return config.MaxFileSize
+	}
+	return DefaultMaxFileSize
+}
@@ -0,0 +1,109 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSniff(t *testing.T) {
+	tests := []struct {
+		data   string
+		reason string
+	}{
+		{"package main\n", ""},
+		{"\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR", "binary file"},
+		{"\xff\xfe\xfd", "binary file"},
+		{"// Code generated by protoc-gen-go. DO NOT EDIT.\n\npackage pb\n", "generated file"},
+		{"# @generated by some-tool\n", "generated file"},
+		{"#!/bin/sh\n\n# @generated by some-tool\n", "generated file"},
+		{"/*\n * This file is generated by some-tool.\n */\n", "generated file"},
+		{"<!--\n  Autogenerated by some-tool\n-->\n<html></html>\n", "generated file"},
+		// Banners only count in the leading comments.
+		{"package main\n\nconst banner = \"DO NOT EDIT\"\n", ""},
+		{"package main\n\n// Code generated by hand. DO NOT EDIT.\n", ""},
+		{"var a=1;" + strings.Repeat("b=2;", minifiedLineLen), "minified file"},
+		{strings.Repeat("var a=1;", minifiedLineLen/4) + "\n" + strings.Repeat("b();\n", 10), "minified file"},
+		// A long line among many others is not enough.
+		{"package main\n\nvar s = \"" + strings.Repeat("a", 2*minifiedLineLen) + "\"\n" + strings.Repeat("func f() {}\n", 500), ""},
+	}
+
+	for _, tt := range tests {
+		equals(t, tt.reason, sniff([]byte(tt.data), true))
+	}
+
+	// Files mentioning the banners in their code are not generated.
+	for _, name := range []string{"skip.go", "skip_test.go"} {
+		data, err := ioutil.ReadFile(name)
+		ok(t, err)
+		if len(data) > sniffLen {
+			data = data[:sniffLen]
+		}
+		equals(t, "", sniff(data, false))
+	}
+}
+
+func TestSetSkips(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "licentia-tests-")
+	ok(t, err)
+	defer os.RemoveAll(dir)
+
+	binary := filepath.Join(dir, "image.png")
+	ok(t, ioutil.WriteFile(binary, []byte("\x89PNG\r\n\x1a\n\x00\x00"), 0640))
+
+	generated := filepath.Join(dir, "api.pb.go")
+	ok(t, ioutil.WriteFile(generated, []byte("// Code generated by protoc-gen-go. DO NOT EDIT.\n\npackage pb\n"), 0640))
+
+	big := filepath.Join(dir, "big.go")
+	ok(t, ioutil.WriteFile(big, []byte("package big\n"+strings.Repeat("//\n", 100)), 0640))
+
+	config := &Config{
+		CopyrightOwner:  "Test",
+		LicenseType:     MPL2,
+		Files:           []string{binary, generated, big},
+		EOLCommentStyle: "//",
+		MaxFileSize:     100,
+	}
+
+	skipped, err := Set(config)
+	ok(t, err)
+	equals(t, 3, len(skipped))
+
+	data, err := ioutil.ReadFile(generated)
+	ok(t, err)
+	equals(t, "// Code generated by protoc-gen-go. DO NOT EDIT.\n\npackage pb\n", string(data))
+}
+
+func TestUnsetLongLines(t *testing.T) {
+	file, err := ioutil.TempFile(os.TempDir(), "licentia-tests-")
+	ok(t, err)
+	defer os.Remove(file.Name())
+
+	// A line longer than bufio.MaxScanTokenSize placed past the sniffed
+	// bytes so the file is not taken as minified.
+	content := "package main\n\n" + strings.Repeat("// comment\n", sniffLen/10)
+	content += "var s = `" + strings.Repeat("a", 70*1024) + "`\n"
+	ok(t, ioutil.WriteFile(file.Name(), []byte(content), 0640))
+
+	config := &Config{
+		CopyrightOwner:  "Test",
+		LicenseType:     MPL2,
+		Files:           []string{file.Name()},
+		EOLCommentStyle: "//",
+	}
+
+	_, err = Set(config)
+	ok(t, err)
+	_, err = Unset(config)
+	ok(t, err)
+
+	data, err := ioutil.ReadFile(file.Name())
+	ok(t, err)
+	equals(t, content, string(data))
+}
@@ -0,0 +1,205 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, version 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Returns the SPDX identifier of ltype, or an empty string if there is none.
+func spdxID(ltype LicenseType) string {
+	if info := lookupLicense(ltype); info != nil {
+		return info.SPDX
+	}
+	return ""
+}
+
+// Returns the license identified by an SPDX identifier or one of its common
+// aliases. UNKNOWN is returned for unsupported licenses.
+func spdxLicenseType(id string) LicenseType {
+	id = strings.TrimSpace(id)
+	for _, info := range catalog {
+		if strings.EqualFold(info.SPDX, id) {
+			return info.Type
+		}
+	}
+
+	id = strings.ToLower(id)
+	for _, info := range catalog {
+		for _, alias := range info.Aliases {
+			if alias == id {
+				return info.Type
+			}
+		}
+	}
+	return UNKNOWN
+}
+
+// License names commonly declared in package metadata that do not identify
+// a single license, in lower case.
+var ambiguousLicenses = map[string]bool{
+	"bsd":         true,
+	"bsd license": true,
+}
+
+// Tells whether a declared license name may stand for several licenses.
+func isAmbiguousLicense(name string) bool {
+	return ambiguousLicenses[strings.ToLower(strings.TrimSpace(name))]
+}
+
+// Parsed SPDX license expression, ex: "Apache-2.0 OR (MIT AND BSD-3-Clause)".
+// It is either a license, with an optional exception, or expressions
+// combined with an operator.
+type spdxExpression struct {
+	License   string
+	Exception string
+	// AND or OR when the expression combines operands, empty otherwise.
+	Operator string
+	Operands []spdxExpression
+}
+
+var (
+	spdxTokenRe = regexp.MustCompile(`[()]|[^\s()]+`)
+	// License identifier or reference, see
+	// https://spdx.github.io/spdx-spec/v2.3/SPDX-license-expressions/
+	spdxIDRe = regexp.MustCompile(`^(?:LicenseRef-|DocumentRef-[A-Za-z0-9.-]+:LicenseRef-)?[A-Za-z0-9.-]+\+?$`)
+)
+
+// Parses an SPDX license expression. AND takes precedence over OR, and
+// parentheses group expressions as usual. Operators are matched regardless
+// of case, as licentia always accepted them in lower case.
+func parseSPDXExpression(s string) (spdxExpression, error) {
+	p := &spdxParser{tokens: spdxTokenRe.FindAllString(s, -1)}
+	expr, err := p.or()
+	if err == nil && p.pos < len(p.tokens) {
+		err = fmt.Errorf("unexpected %q", p.tokens[p.pos])
+	}
+	if err != nil {
+		return expr, fmt.Errorf("invalid license expression %q: %v", s, err)
+	}
+	return expr, nil
+}
+
+// Returns the identifiers of the licenses and exceptions referenced by the
+// expression, in order.
+func (e spdxExpression) IDs() []string {
+	if e.Operator == "" {
+		if e.Exception != "" {
+			return []string{e.License, e.Exception}
+		}
+		return []string{e.License}
+	}
+
+	var ids []string
+	for _, operand := range e.Operands {
+		ids = append(ids, operand.IDs()...)
+	}
+	return ids
+}
+
+// Tells whether the expression is a license without an exception.
+func (e spdxExpression) isLicense() bool {
+	return e.Operator == "" && e.Exception == ""
+}
+
+// Returns the identifiers referenced by an SPDX license expression, or nil
+// if it cannot be parsed.
+func spdxExpressionIDs(expr string) []string {
+	e, err := parseSPDXExpression(expr)
+	if err != nil {
+		return nil
+	}
+	return e.IDs()
+}
+
+// Recursive descent parser of SPDX license expressions.
+type spdxParser struct {
+	tokens []string
+	pos    int
+}
+
+// Parses expressions combined with OR.
+func (p *spdxParser) or() (spdxExpression, error) {
+	return p.combine("OR", p.and)
+}
+
+// Parses expressions combined with AND.
+func (p *spdxParser) and() (spdxExpression, error) {
+	return p.combine("AND", p.operand)
+}
+
+// Parses the operands of operator, a single operand being returned as is.
+func (p *spdxParser) combine(operator string, operand func() (spdxExpression, error)) (spdxExpression, error) {
+	expr, err := operand()
+	if err != nil || !p.next(operator) {
+		return expr, err
+	}
+
+	expr = spdxExpression{Operator: operator, Operands: []spdxExpression{expr}}
+	for {
+		e, err := operand()
+		if err != nil {
+			return expr, err
+		}
+		expr.Operands = append(expr.Operands, e)
+
+		if !p.next(operator) {
+			return expr, nil
+		}
+	}
+}
+
+// Parses a license, with an optional exception, or a parenthesized
+// expression.
+func (p *spdxParser) operand() (spdxExpression, error) {
+	if p.next("(") {
+		expr, err := p.or()
+		if err == nil && !p.next(")") {
+			err = fmt.Errorf("missing closing parenthesis")
+		}
+		return expr, err
+	}
+
+	license, err := p.id("license")
+	if err != nil {
+		return spdxExpression{}, err
+	}
+	expr := spdxExpression{License: license}
+	if p.next("WITH") {
+		if expr.Exception, err = p.id("exception of " + license); err != nil {
+			return expr, err
+		}
+	}
+	return expr, nil
+}
+
+// Parses a license or exception identifier, what being the one expected.
+func (p *spdxParser) id(what string) (string, error) {
+	if p.pos >= len(p.tokens) {
+		return "", fmt.Errorf("missing %s", what)
+	}
+
+	token := p.tokens[p.pos]
+	switch strings.ToUpper(token) {
+	case "(", ")", "AND", "OR", "WITH":
+		return "", fmt.Errorf("unexpected %q", token)
+	}
+	if !spdxIDRe.MatchString(token) {
+		return "", fmt.Errorf("invalid identifier %q", token)
+	}
+	p.pos++
+	return token, nil
+}
+
+// Skips the next token if it is the operator or parenthesis token.
+func (p *spdxParser) next(token string) bool {
+	if p.pos < len(p.tokens) && strings.EqualFold(p.tokens[p.pos], token) {
+		p.pos++
+		return true
+	}
+	return false
+}
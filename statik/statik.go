@@ -1,13 +0,0 @@
-// Code generated by statik. DO NOT EDIT.
-
-// Package statik contains static assets.
-package statik
-
-import (
-	"github.com/rakyll/statik/fs"
-)
-
-func init() {
-	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\xce9sO\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x07\x00	\x00apache2UT\x05\x00\x01U\x96\xd3]\xdcZ\xdds\xdc8r\x7f\xf7_\xd1\x99\xaaT\xa4*zvos\x97\xe4v_\xac\xb5\xb4\xb7\x93xG.I\x8e\xb3\x95\xca\x03H6\x87\x88A\x80\x07\x80\x1a1\x7f}\xaa\x1b\x1f\x04g\xc6\x1f\xa9\xbc\x9d\x1f\\\xd2\x88\xecn\xf4\xc7\xaf\x7f\xdd\x98W\xf0\xb5\x7f7\xa3hz\x84w\xb2A\xed\xf0K\xcf\xff;Z'\x8d\x86\x1f\xb6\xdfW\xf0\xafBO\xc2\xce\xf0\xc3\xf7\xdf\xff\xf1\xb3/\xf5\xde\x8f?~\xf7\xdd\xf1x\xdc\nV\xb35\xf6\xf0\x9d\n\xaa\xdcw\xaf\xe8\xc5\xa7\xbb\x87\xdf\x1e\xe1f\x7f\x0bo\xef\xf7\xb7\xbb\xa7\xdd\xfd\xfe\x11~\xb9\x7f\x80\x0f\x8fw\x15<\xdc\xbd\x7f\xb8\xbf\xfd\xf0\x96>\xae\xf8\xa9\xdb\xdd\xe3\xd3\xc3\xee\xe7\x0f\xf4	\x0b\xf8\xc3\x16n\xb1\x93Zzi\xb4\xdb\xbe\x8a\xd6l\xe2\x896\xe0z\xa1\x14\x0c(4\xf8\x1e\xc1\xa3\x1d\x1c\x08\xddBct\x1b\xde\x82\xceX\x98\x1cV`q\xb4\xa6\x9d\x1a\xfa\xb8\x8a\xa2\xe8\xd9V:oe=\xd1\xe7 \x1c\xb4\xa4\x12[\xa8gx\xc4&\x08\xf9\x03\xf8\xde\x9a\xe9\xd0\xc3\x9f\xc1t\xe0{\xe9\xa05\xcd4\xa0\xf6\xa7v\x19{fXc\xc6\xd9\xcaC\xef\xc1\x1c5Z0\x16P{\xe9g\x10\x93\xef\x8d\x95\xff\xc3\xfa\xa2\x9cKo\xf8^x\x90\x0e\x0eVh/\xf5\x81\x1f\x8a~(\x0c\xc0\x83Pp\xc7\xa2\xcf\x8c\x984\x1d\x90\xadG\x10\x0dKIV\xe8\x16\x84RQ\x8c\xf1=F\x03%\xba\xa0\xba1\xda[\xa3*\x10\x16\xd3/\x8a\x8d\xae\xe84\xf4\xe9\xa4[\xb4\xd0\x98a0:J\x8a\x0f\xc2Q\xfa>\xc8	\n\xb7\xf0\x8b\xb1l\xc78\xd9\xd18t\x8bWs\xc0S\x8c6Q\xca\x86\x8f\xe2\xe0J^\x87W\xcd\x11m\x05\xad\xb4\xd8x2B\xea\xf0s\x05\xde@#&\x87\xf4\\\x94\x12\xfe\xc4\x1e\xb00\x08-\x0eH\xc1#\xbdnj\xfahX\x05\xc7\x1e\xf9\xf8\xf5\x1c\xac\x17,\xbb\xf4\xccQR6\x19\x0bWR^\x87\xf0\xb8^\x8e$\xa9\x93\x9d\x9faD\xdb\x90\xe8\xab?}\xff\xf7\xd7\xac\xceX\x8c\x8eO\x82&\xef\xbc\xd0-\xc5\xc0\xf5\xc2\xa2K\x12\xe55\xd4\xa8\xb1\x93\x8d\x14j-\xbd\xb0s	\xf9\xeff\xda\xc0\x95\xb1\xfc\x93\xdd\\\x97Q\x17\x9a}\xf2,\xdb\x89dY(\xf3#\n\xc0\x17\xb4\x8dtd\xc8\x88v\x90\xceq\xc2s\x9e\x85\"\xe0\xb0\x9c\xa5\xda\xa3\x99l\x83\x1b*\xaf\xe14\xd3F\x8b\x1dZ\x8bm\xf8k\xc7\x1e\xffD*\x06\xd3\xcaN6\x82\xab*\x05X\xeaFM\xec\x8az\xf2\xa0\x8d\x07%\x07I\xda\xbd\x01g:\x7f\xa4\xf4r\xac\x10\x1a\xd3b\x95k\x8f\x05E1\xe1\x81*\xd5\x7f'\x0f\x93\xe5\xbfC'\x15\x16\xf0q_\xff76\xfe\xdct\xa1\xe7\xf0\x99E7)\xae\x8f\xce\x9a\x01\x06lz\xa1e#R\x81x+\xb4\xa3'EJ(\xfeD\xc5_;\x10\x10\xdc\xc3\xe2\xaa\xf5\x01\xa3\x8c\x93c6f\x18%\x15\x94a\xe3\xe21\x0f\xa8\xd1\nzdu\xe0\x12\xbd\x1a\xa3\x9f\x03z;\x92\x13jw\xc0V\n\xf0\xf3X\x1e\xfb\xa3\xb1\x9f\xce@\xe1h\xec'\xb6\x98q\x882m)\x01\xa9\xd31r\x01\x04\xd7\xc5c\x0d\xa2E\x10\xcfB*Q\xabT\xff\x05.U\x84\xa6\x94\x80\x8d\x88\xa9$2.$t\xd3\xc6\xcb\x063\xbc\x05OaK\xba	V\xbc\xa7\xde\xc2\x1eJ\xd6F\x11WB\x03\xbe\x88aTH/\x8e\xd6<\xcb\xf8\"=y3\x8e\xa8[\xf9\x025*s\xbc^\xbcp\x8bV>\x0b/\x9f\x11\xc8!ns\x9a\x01\xa4\xe3\xb2\x0f\xe2\xe9\xa3\xa4\xe0\x83dx-\x1c\x05Os)\xb6\xa4\x83\xb2\xdf\x9a!`\x15\xa9\xe2pQ-\x1c{\xd9\xf4\x05\x18`+\xbd\xb1T\xee\x16\x9f%\x87\x92\xb2X\x1b\x1f\xeb\x04P\x89\xda\xd8\xf4\x9b\xb1)\xcce5Ea\xd4\xe5\xd0\xa1\xf6\xec}\x01\xc7\xde(.\n0V\x1e\xa4\x16\xeaB\xcc\xcf\xf18\xe1T\xb7*\xff\nN\xdd\x17\xbdG\xd9\x1cc\xc7\xe2c\xd7\xb08\x08\x99\xeb\x13Ga9S\xc8/|\x8c\x01-\xaa\x19\x94\xd4\x9f\xd8q\xb5\xd4\x9c'Z\x0cx\x9d\x82.\xb5G\xdb\x89\x86\x9bDU\xf4\xc8\xec\xd43\xa3\xc8;h\xba%\xeao	\xcac\x8f\xbf\x18\xf1\xd3\x1a\xc8%[\xe8\xcb\x0e\x8c\x05\x97zi\xb6\x83\x84\xadb\xc29\xdcF&\x92$\x99\xe0\x1b~\xcb\xd8\xcf\x1a_\x15E\xe1	\xf5\x8d\x16J%\xd8vS=H\x1f\xc1#\xf1\x0e\xce.\xb6\x9c\xcd\x8b\xa5\xc0\x8a\x18\xc7\xcfhE\x8a2\xb7\xbb/v\x8b\x92\xa8\x10*\xb3z\xca\xf7\x1a{\xa1:0]\xe1\xa8\x13-\xdf\xd6\xeda\x93\xcf\xb4\x89\xb2B\xbf\xcf\xb0l:@\x85\x8d\xb7F\xcb\xa6\xa2(\xd4Bq\x1e\x1d-\xbd\xa7\x99|L:z\x1f\xa8\nJ\xa7\xe3\xe2(\xf2\x93wK\xb1\xb0\xff]\xf5\xc5V\x94\xb1\xab\xd4ata\x13\x0cB*zYI\xe7]U\xb6\xacL\x85\xdc\xec<\x0e\xae\x84p\xe9\xdc\x84\xd4B\x1a\xee\x91\xf1\x89\x10~\xea|\x81\xadd\xaeU:\xbd*`d\x95\x05\x85\xb7\xc9o\xadt\xcd\xe4\xb8\xcb\xb3\xc6\x81\xf12\xd2\xc8\x8f\x8cxKk\xc2\x97\xe4\x84\xf5YS>6F\xbbQ6\x93\x99\x9c\x9aa\x10\xf6\x13A\x9f]\xd8Q\x94\xd3\xa2\x93\x07\xcd\xd8/5\xc7\x88\x1d{1\x13	\xac6{\xe3A@Y\xab\xdb\xcdy	\x9f\xf0\xeb|\xecT\x81_\xa5<\xa5\x03	\x1f\x87\x13\xa5\xd0\x0b\x075\xa2\x06\x8b\x0d2\x92\xd7\xf3J\xcfR\x84\x0e\xff:\xa1\xf6\x8a\xd46\xc6\x8e&\xb4k\"\xbcE\xf9\x05 \xfaa\x0b\x7f!ZEj\xdf\xe6\xe3'f\x05\x8fSh\xae1W/\x0e3E\x99\x95\xa8\x8c\xa2\xe9\xa1p\x10\x10\x84\xd4s`q\xcc\x0b~7\x13\x08bx#\xfaI\xa8\x94~GcU{\x94\xc45\xb4\xd1\xaf9\xf2N>\xf3\xaf\xaf\x9b^\xd8\x03\x0dNf\x16\xca\xcf\xaf;\x8bX\x81\xb4\x16\x9fMC@~\xd6\xcd\xe3\xfcG\n\xd3\xb4\x85\x15\xd1\xc1\x91\xf2\xf8\x0c\xe9\x168\x1f\xa7Z\xc9F\xcd\x94\xa8\xa3\x12s\xb5|2\xa2\x0d\xad\xd6\xf1'\x91X\x94s[I\xf33\x163Y>\xd3x\xa1\x9d3\xb6\x84\x00\xfdc\x11\xa0\xf7\x82@\xf7o :W\xf8\xd2\xe0\xe8\xa9\xc0\x9cO\xc5\xc8\x06\xba0\x10]\xc3\x18\xceZDo\x10\x9f\xb0\x82^<#\xb3\xbcd\x10\xcf\xd1\xa6\xeb\x88\xe7\x19p\xa8T\x15\xff\x97\xc3h\xac\x0f\x81\xc98\x10\x89rd\x85\x0c3\xe9d\xe4\x82\x10\xa3\xa4U\x8c\xa3\xa2q\xd3h5\x07/\x13vE\xd3\x1a%\xe4\xe0\xe2\xb3\xc5\xe1\xea9\x08)\xbd\x9bqSc\x83\xce	+\xb9:;+\xf5!M4(S\xef+\x0b\xff\xca]\x83PFc\xec\x88\x8d\x19j\xa93\xab\xe7\xd7N_H\x07\n\x13n\xec\xb6\xdeD\x92\xb76.\xaa8R(R\xaf\xdb\xc2\xae\xa3\xf8G9R;/=\xe5t\x0e\x8a\x97\x87`\x828\x08\xfa3\x83\\\x1c\xdc\xaf\x96\x86\x95\xb9\xb55\xce\xbdf\x87\xd11\x1a3\x11\x7f\n\xbfK\x0d\x02\x948\xbaIz:\xaa\xc2Ch\x02\xc2g\xe3\x17Np\x82\x8a_\x028\xee	\xc1p\x17G\xedEN\xb3\x04gN\xc7J\xf1\x18\x98\xa9\xfa\x1e\x03\x15[gb\xa2Li\x18\x8d\x95\x92\x06\x8d\xa5\xc6b\xcbK\xac*t\x07*Q\x8a^\xca\x15\xe1\x12ak\x85\xcf\xc9\x97\xbd+\x1d\xcf\x89m\x80\x82?n\xe1\x01\xcb\xcd\xd0\x96U\x0fb^\x90\xed\x14\x85\x1a3\xca\xc4mVx\xf4\x05\x96\xc7!!\xda\x88\xad\x9c\x86*\xe4\x111\x1a\xe9{\x93;\xf2zl\x0e-\xfc3HV-\xa3\x10;dI\xad\x011D\xb93J\x99c\xe8\xef	\xbb~L}\xf6J\\\x87\x93N\xce\xc3\x81\xec%\xf3\xc2\xbca\xb1\x91\xa3D\x02\xad\x92\xfa\xe6\xe9\x90\xfe\x9d\x1dTp\x7f8\x9d$~\xe26\x9at\xd6\x85\xce\xb0\xb8Y\xa84\xcdQ4\xbf\x87\xa5\x8e\xa5\x14\xb2f\x90\x9a\xf2$L\x8f\xaePO\x10\x97S\x9ad\xd2\xe8~`g`\x90\xb3\xd6\xdc\x14\x9a-z!u\x95xs1\xc2\xf3t\xa0\xe7\xb3\xc3\x15\x8a\xb3\xc2%!*\xaa\xb0\xa5;V1\xbb+\x82\xc5\x16\x897U\x05\x99\xa0\x7f\xc2/\xe5\x16\xcf\x16V\x10\x17\xec9\x85TX1\xb7\x80\x9eI\x06\x1b\xd7\x1a&\xb4#Z:&\xb93T\x9c\xf5K\xe3\x82\xc8\xe0O\x0f\xbavZ{M\xa0\x95\xe3\x1f\x07?\n\xf5f\x7f\xff\xb4{{\xb7\x01\x8f/\x9e\xfdMe\x17u\x10\xe5.\xf4\x94\xd5U@\xc0\x85J9\xf3,\xc7\xab\x10\x95FO\x01\x16E\xcb3\xe6\x92tx\xd1\xad\x04JBj,\xdd\x1fA\x8d\x91!\x1c\x84\x8fP}\x8b_\x0b1\x97=|\xd1\xaf\x9cl\xc2\x83B\xe1h\x9c*\xb7\xf4\xf1\x95\xa5ZGEC\xf0\x8f\xc9L\x91l\\|\xbdxh\x95U\xee\x8b6\xfcT\x82\xf9*\xc9\xca\xba^/\xa0@v\x0b\xceP\xcb<,\x1d\xf0\\\xbe\xb1\xd5\xb9\x97E\xe2z\xc5\x96+\xce\x06\x17\xbc\xd4\x9dT\n\x13\x88g\xb4!X\xbe\x97\xb6}M\x87\x9csl\xb4\xb1\x03\x0d\xccD,P\xd8-<\xf5a\n#\xfc:ws\x11o&\x0fa\x94\xceK>\xa1\x8a\xe1\x95\x18\xca\xda\x9cX[\x8cX\xe1\x0c\x998\xa6\xb6!\xda\x96~\xb64\xef\x94\x19YHI\xa6G\x0f}K%T\xc1\xfbN\xb6\xab\xd4\xe1yJhR\x8a\xba\x9d\x86D[W\x19\x93\x80%\xcc\x7f)\x9c\xa7\x98\xc6\x0eNK\x0c\xa1.\x17\x13o\xab\xa0\xc6\xc0\x03\xect\x9a\x7f\xc11\x9f\xbb\xb7\xb8\xe8\xa2e\xaa`\xda\xca\xcb\xfa@\x00N\x16_E(HH<Gi\xb2\xb1\xd0Jb\xad+\x96{\x81\xc1G1\x97\xaf\x8c\x82\x98\xe2\xae\xc8t\x17\xac\xa9\x96\xb2\xe9xX\x9c?3\x8a\x94\xdb\xb9\\J,\x8fTG\x11k\x03\xcen\xabV]8\xb3\xee\xc6\x0c\x81JS\x1e\xbdJ\xa1\xc4rR9\x99\x04V\x01\xf9\x13\x0f;\xf1& \xcc\xaa\x0b\x0bt[\xf8\xa0\x15:\xc7A\xc3\x97Q\xc9F\xd2\xf8\xcb\x12\x8b\x0b\x92\xa8\x97\xce\x7f\xc2\"\x8beV\xb1\xc6\xfa\xec\xea*\n\xaag\xd6x\xba\xc8	T\xaf.\xb7\xcf\xff\x97\xd1,\xd2,6\xb3H\x98 \"P\xd76\xdd>\x86\xf7\xf7\xc6\xd3K\xf9\xf6\x86\xfbKm\xc2PFe{\xe0\xf1\x8e\xda\x08\x9b\xe6\xa6\x11\xad\xc3\x16\xc3E\x10\x95A\x11\x92\xa8(\xb0\x8b\xb0 \xf5\xb8\x8cD\x07\x8b!\xf1\xe7X!<\x91\xe1\x0b6\x05\xc43\xf0f\x87X<\x08\x1b\xee\x95Ng\x8fx\x17\xf0O[xJ\x04\xc4\x11,\x16<\xba5\x8c\x9c>P\xee\xe2F\x88\x1c\x1f/\xd4\x02}\x89\xca\xb5\x18\xd0\x15\x8c\xc6\xd1@h\x9fe\x83\x10\x7f5\x16b\x0e\x87\x87S\xd2&\x8bS\xa6,c\xaa\xc5\xbfN2\xde\x1eQCwFsK\xe7\x90N\xce\x9bA\xd8\x99\xad\x91\x1aZt\x8d\x95u\x0cE\x94\x156\xb5g\xfb\xd9TM)n\xb1\x1b\\h\x01\xc1S\xff\xbc\x85[\xe9xtBKO}\x14\x96\xfc2\xe7\"\xc8\xa6\xd6s\x18`y\xf2\xa6\x11k\x81\x01\x8e\"\x0f/\xcb\x16\xacZ\x02\x16k\xdf-\xa6^\x91\xad(\x9aT\xbb\xe5p\x9b\x9f\x96\xde\xad\x83{\x0d\x86o\xfc67\x8f\xb0{\xdc\xc0\xcf7\x8f\xbb\xc7\xe4\xdc\x8f\xbb\xa7_\xef?<\xc1\xc7\x9b\x87\x87\x9b\xfd\xd3\xee\xee\x11\xee\x1f\xcak\xf9\xfb_\xe0f\xff;\xfc\xdbn\x7f[\x01\xcap\x03\xfc2Z:d>\x89d\\i\x8b5\xe9RA\xbc'\x15	\xa7f8\x06W\xf1@d\xcf!\xd6t\xf0\xb4{zwW\xc1\xfe~\xffz\xb7\xff\xe5a\xb7\xff\xcb\xddow\xfb\xa7\n~\xbb{x\xfb\xeb\xcd\xfe\xe9\xe6\xe7\xdd\xbb\xdd\xd3\xef\x9cB\xbf\xec\x9e\xf6w\x8f\xe1\xeb\x037Q\xc6\xfb\x9b\x87\xa7\xdd\xdb\x0f\xefn\x1e\xe0\xfd\x87\x87\xf7\xf7\x8fw\xa1\xdb\x86\xdbB\x85\x8af57\x1a\xed$\xdf:\xf0\xcdL\x98\n\xd7\xe9\"\xc6\xd1\x9a\xd1J\xa2\xe7|\xe0\x0e&\xde\x95r\xfe-\x88[\xecK\xc3\xb6\xd1\xb9i\xe0Y%\xc1\xb5t\x8c\xec\xce42\x8f\xc9\x01\xd4\xe3=+oc\xcb\x8b\xd6\xf3a6\xe4\xde\xbfl\xe1]v)\xbd\xf4N\x8aZ*\xbe<\xdfQ\xe7\x05|\xa6\xdc%;\x82\x0cm@\xf1\xb2\xd3\xf7h\xec\\\xacZ\xd2M\x967\xd6\x97+\x03\x8d\x07%\x0f\xa8\x1b\xbc\xae\xf2mw\xb5Z\xe5\xe6\xcd\xcfW\xf3\xfd*\x10\x05\x07-*Y3\xa1c\xe3\x0e\xd68\x97\xef-\x92J\x0f\xa2\xf1\x8eo\xc7/\xd7G@\xcfU\xfb0\x16\xea\x142%Yq\xdc\x08ph\xc5 \x0e\xeb\x1d>\xbd\x9d\xbe\x12\xb0|9\xc0\x8d\xd8\xc8e\xc9&u#[\"\xb6\xe1*\x81\x08L\xd8\xe9J\xa1\x92\xd0\x84\xd0M/\xc8EhA\xd8pgN]<\xf7j7)\x7f:\xe8\xb27\xa7\x8c1S\xf8D\xea\x18\xcc\x02WS\xa92\x04|\xf1N<YE\xc7V&$\xec\xc1\x98\xf6(U\xb9;\xfc\x04\xce\x9bq\x14\x07\xac\x98\x13Ldx'\xa4\x9al\xe8FBu\x93^\xc8\x0d7\xc1\x0b\xdf\x04i\xcc0P\xf2\x96\xfe\x08\x8a\xd1]W\x9c\x87D\xd0O\x17qQF^\xa6\x8b\xf6Y\xf2%i\xf0\xc4h\x9c\x93\xd1	\xe9\xcb\x0dQ|\xa8\x80?o\xe1\xa6\xa1\x9e@^H\xc8K\x9ao\x96F]\x14\xc5\xc7\x9e\xa8\xfb\xba\\\x8bN\xfb\xb5EL\x95Yh\xd3\x1b\x13\xb6\xa0\xbc\xe9\\]\xb6\xf3\xce\x15\x04t\xc8xR\x81`\x0b\x85n0\x1cb\x0ck\xd0\x88~3\xe7\x1d\x0eZ\xfa\\\x8f\xf9\xf6V%\xdb\xc1\xd4*n\xa1\x98\xb7|G\xb0C\xcc7\\\xb5H\xc7M*\xceW2!h\x1e0~5G\x9a\x84\xc2(\x99\x1d\xc6\xfe,\x04/\xe7\xe3o\xb4hU\xdc\x86d\xce\x1d\xafEx\x89\x1b?& ]`\x94\xede\xa6\xb3\xdc\xa2,\x88\xbel\x8a\x8a4\x88;a\x9a\x99d\x17\xf0\x99\n>\xd4;\xfb\xa6\xcb\xbei\xb1C\xdd\x867z\xa3\xda\x0b\xabsa\x07F\xa2D\xae\xb3\x17\x97r\x9e\xac]n\xcb\xe2\xe6X8\x87\x96\xca'.Q\xab\xf3\xbdq=G\xb2\xb1\x1ch&\x0f,>\xcdd\xfeXdcA\x1b\xb3-!\x81\xef\xf6\xb7\xd4W/}\x0d\x8e\xff~\xf3\xfe\xfd\xdd\xfev\xf7\x1f?R\x08y[0\x8e*L\x8f\xeb\xaf\xee\xd1\xdf\xd8\x94c\xbeK\x02\x80\xa7o|\xa1\x8a_\xa3\x80\xd56!\n\xa9\x8dThGEh\x1d\xa6\xb9j\x99\xe4;\x89\xaau\x80\xbaQ\xc6\x05\xd0\xaf\xadh>\xa1w\xb0\xf9\xcf\xff\xdad\xe0\xe3\xcdD\xecvsJ&F\xd58\xf5\x15\x93\xf4\x16\xaen\x8d\xfe\x87\xfc}\x81\xa2F\x93\xf0\xbf\xbb\x06\x9e\xd6yLu\xbd\x99TK\x14?\xdb\x11\xa7\x83\xa2mG!\x8cT\xda\x83\x9b\xb5\x17/\xf9\"\x94\x87\xfa`\xc0\x16>\"\x08\xe5\x0cX\x0cO\xc7=iBq~6\xe4\x8ds\xccX\xc3\xd8\xc54sL\xcd8]\xad\xd6\xb8|e\x85oH\x93%\x8e^\xdc\x8cV\xf2\xe2\x9a0xC\xbd\xc2\xafn>\xe3\x97_\xc8L\x14N\xe6\xfb\xf8\xe8\xb9t\xef\x9a\xd73\xcb\x92C\xd8\xa6\x97\xcf	)\x97\xcb\xc47of\x14\xf6\xcd\x1bx\xf3\x86\xafU\xdf\xbc\xe1\x07bZ\xb4\xc5\x94\xb4N\x98\xaa\xfc\n(\\\xd1\x03\xf9[\x96\xd7?\x91\x884\x81P\xe9\x87\x86\x15\x17\xe6\x89\xb8K\x1d\x07O\x06\xc3\x9cC\x99\xd4\x14s\xbe\xa9y?&VK\xba\x94\xba\xc2\xa7\x04\xff\xda\x97L\xdf\xed\xde\xde\xed\x1f\xef^\xff\xb0\xfd\x9e_\xf9\x16N\xfe9\xb6\x11\xbfe\xf6\xaa\xdcK\xae\xfc\x95\xcc\x93n\xf5\xc0\xe78\xf7\xff\x93p'\xaa\xcdn{D\\\x99\x90\xd2\x9a\x89L'\x1bPB\x1f&q@8\x98g\xb4LnK\x8a\x19\xf7#\x0bCw\xe7\xe7\xda\xbe\xfa\xdf\x00\x00\x00\xff\xffPK\x07\x08\x86\x1fy*M\x0f\x00\x00P,\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce9sO\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x11\x00	\x00apache2.copyrightUT\x05\x00\x01U\x96\xd3]r\xce/\xa8,\xcaL\xcf(Qpp\xa8LM,rpPpp\xc8/\xcfK-rp\xe0\x02\x04\x00\x00\xff\xffPK\x07\x08\x0c\xc8i\x93#\x00\x00\x00\x1d\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce9sO\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0e\x00	\x00apache2.headerUT\x05\x00\x01U\x96\xd3]d\x91\xc1n\xdb0\x10D\xef\xfe\x8a\x81O-\xe0\xcaA\x8e\xedIM\\Th \x01\x96\xd3 \xc75\xb5\x92\x16\xa0I\x96\\U\xf1\xdf\x17Tl\xa0F\xae\xdc\xe1\xe3\xdb\xe1\n\xc0\x93\x18v\x89;L\xae\xe3\x08\x1d\x19e 3\xf2u\xb2\xc1o\x8eI\xbc\xc3}q\x87O9\xb0\xbe\x8c\xd6\x9f\xbfe\xc4\xd9O8\xd1\x19\xce+\xa6\xc4\xd0Q\x12z\xb1\x0c~3\x1c\x14\xe2`\xfc)X!g\x18\xb3\xe8\xb8\xbcs\xa1\x14\x99\xf1za\xf8\xa3\x928\x10\x8c\x0fg\xf8\xfe\xff HW9\x0b\x8c\xaa\xe1\xebv;\xcfsA\x8bl\xe1\xe3\xb0\xb5\xef\xb1\xb4}\xaa\x1evu\xbb\xfbr_\xdc-\x17\x9e\x9d\xe5\x94\x10\xf9\xcf$\x91;\x1c\xcf\xa0\x10\xac\x18:Z\x86\xa5\x19>\x82\x86\xc8\xdcA}\xd6\x9d\xa3\xa8\xb8a\x83\xe4{\x9d)r\xc6t\x924\xcaq\xd2\x9b\xb6\xaer\x92n\x02\xde\x81\x1c\xd6e\x8b\xaa]\xe3{\xd9V\xed&C^\xaa\xc3\xcf\xe6\xf9\x80\x97r\xbf/\xebC\xb5k\xd1\xec\xf1\xd0\xd4\x8f\xd5\xa1j\xea\x16\xcd\x0f\x94\xf5+~U\xf5\xe3\x06,:r\x04\xbf\x85\x987\xf0\x11\x92{\xe4n)\xade\xbeQ\xe8\xfd\xbbR\nl\xa4\x17\x03Kn\x98h`\x0c\xfe/G'n@\xe0x\x92\x94\xff3\x81\\\x971VN\xa2\xa4\xcb\xd1\x87\xbd\x8a\xd5\xbf\x00\x00\x00\xff\xffPK\x07\x08\xf6a\xa3WY\x01\x00\x00&\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce9sO\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x04\x00	\x00cddlUT\x05\x00\x01U\x96\xd3]\xcc[_s\xdb8\x92\x7f\xd7\xa7\xe8\x97\xab\xb2\xaa\x18^\xecdfv&O\x8aD\xc7\xdc\x95E/)%\x9b{\x83H\xc8\xc2\x0e\x05h\x01\xd0\x8a\xee\xd3_u\x03 )\x89J<\xb7\xb7u\xfb2\x13S \xd0\xe8?\xbf\xfe\xa1\xd1\x9cf\x8f\x8f\xd9\x02f\xc9\xe7d\x9e==&\x8b%L\x163\x98\xa5\xc52O?\xae\x96i\xb6\x80y:M\x16E\x02\x9f\xb96BI\xb8\x8d\xdf\xc2\xcdt6\x9b\xbf\xb9\x8d\xdf\x8eG\xa3\xdb\x18f|#\xa4\xb0BI\x13\xe3\x83\xdb\x18\xa6JZ-\xd6\x8dU\x1av\x9cI\x03\x9c\x95[\x10\xb2\x12/\xa2jX\x0dJ\x03\x97V\xd8#\xd8-\xb3Pj\xce,7\xf8\xb8\x0c\xefr\x03V\x81\xddr\xf7+\xae\xae6\xf0\xa8*\xb1\x11%\xeb\xd6\xbb;]/H\xea\xd6\xa5\xd7\xd5n-d;\x03>\xca\xb4x\x16\x92\xd5P\xa8\x8d=0\xcd#\xd8k\xa1\xf4\xe9\xf4\xd0\x18^\xc1\xfa\x08\xecd\x85\x1b\xb1\x01&\x8f\xe3\x08\x98\xach\xba\xd3\xb7v\xac\xe2\xf8\x16\xedl\xcf\xb4\x15eS3\xdd\x9f\x83\x04\x7f\x87\x82\xbfp\xcd\xabV\x0c/\xf5\x0d\x1b_\x13\x13\xd7_\x8fOWtO\xcb\xf1\xd0n7\xa2\xe6\x86\x94\xca\x84\x14\xf2\xf9rN8\x08\xbb\xbd\x1cw\xb6\x82\x90\xce\x86%3\x1c\x84,\xeb\xa6\xc2Q{\xa5\xdd\xa6\xed\x96k\xae6\xb4\xb1\xf71$\xdfx\xd9X\xb6\xaey\xcf\x10\x17\xbb\x15\x12\x15	\x1b\xa5w\xa0p\x06\xd4\x99\x84B5\xba\xc4\xe1\x15\xa7\xf9~\x8a!E\x0fc5\xcc\xf8\x0b\xaf\xd5\x9e\xeb\xde\xb4\xd7\xddj#\xb4\xb1\xb0c\xbfs3\xb0o\xf6\xc2DM\"6\xb2\xa2\xb5\x85\x81\xb9(\xb94n\xdd\x9fc\x983\xfd\xcc5|Q\xfaw\xbf\"\x83\x03\xfeq\xd8\n\xd4\x06)\x9b\x9b\xcb\xad)}\xa1\x1b\xa7\xe8RU\x1c\xa4\xb2\xf0\x8c\xafH\xe7`\xb8\x0d\xcb\xf5\xce8\x07=\x93\xe3\x978\xfc\xd9\xeeZ\x18\xa8T\xd9\xec\xb8\xb44\xe4OaHO\xe5[\xf6\x82&\xc2\xb9\xb5x\xdeZ\x0c\xa7g\xcd\xa4\x8dB`\xed\xd87\xb1kv\xc0\xbfY.-\xec\x951b]\xf3\x08\x0e[N\xe6`\xd6\x89&v<\x84\x8e\xf0\x96\xa0\x99p\x9b\xa6Y\x1b\xfe\x8f\x86K[\x1f\x81\x95\xffh\x84\xe6UD\x86\xc5\xf8`u\x1d^%)\xc8\xc9^\xf8\x91W\x80j\x11\x92\xe4\xff5>\x0f\xa2\xd6\xbc=o\xa0	{\xae\xe5\x1c\x87\xa21\xac\xb1Qu\xad\x0eB>\xff6\x1aMb\x98\xa0{\x89\x9a;\x7f\xd0\xdc4\xb55\xb0\xd1j\x07L\x02\xab*\x02.\xb0*\x82\x8a\xd7\x9c\xfe\xa0_\x11\xb9z\x02\x85\xe91@\xb8\xb4d'\xe6f\xfenl\xa1\x1bh\xfe\"TcN7\xf8a4\xfa\xe8\xc4\x93\xfc\xd0\x13\xd1\xcffhO\x08\x1eW!\xeb\xea\xdc\x1f@\xe9\xd1h:4\xbb0=|\xadp\x06\n\xbb\x83@\xd7B\xd8\xba\x8c\x89\xef9\xe6\xed\xdbx@\xae?d\xbaR\xed\xf6\x8d\xe5\x1aLx\x9d\x02$H\xab\xfc\xec\xf5\x114\xaf9C@\xbe\x12\xad\xb7\xb71<1r\xe4i\xcd\xc4.\xf8\x90S$=/\xf1\xf9\x8d\x19G \xd5\x01\xd4A:\x1d\xa0\x1f\xb2\x0d\n\xd1\xb9o\x07q\x18\xb5\xaa\xb1P\x8b\x9d\xb0\xa4\xe0\x08v\xdcnU\x85IC\x95\xdc\x18\x97	\xd8~\xcf4\xb3\x8dq\xeb8\xd0\xec\xad\xde\x0b\xd0\xf5\xd1\xc5\x8f\xcf\x04\xb7w\xf1\x89\xaeN\xb3@\xa9v;t\xcb\xefkLH\x8fI\xbb\x938\"\x830\xaf\x7f\xcc\x1c\xcc\x18U\n\x86\xe6\x0f\x08\xe2<\xdc\xed\x98W8\x93\xd2\x0e\xacLS:\xc4rb\xbe\x8b\xe1\xabj\xe0Fi\xfc\xbf\x1e\xb7\x1a>\xc3`\x065\x7ffu\xc0b\xfe\x8d\xebR\x18T\xa6\x87\x002\xa1\xd3\x1an\xa7>\x06E\xf7\xe1\"\xf8]tjk\xb8W\xba?\xbf\xe0&\"\xb1\xfc\x06\x9c\xc5\xfd\xd2\x01\xa6\xa5\xd5\xaaF\x8b\x98\xf0GM\xc8K\xa9Sx\x81\x82\xa6\xfd\x08'\xd0W\xd5\xb8%\xf7\x8d\xde+\xc3\xbbH\xa8Z\xe2\x13\xb5\xaf\x9cZn\xaf\x0e\xb8\xcdJh^\x12V\xa2\x9a\xf0\xdf\x84\xc0%k\x0c\xa7q\xee!\xc1\x8c\x86\x1d\x93\xec\x99\xa3]p%\xb2\x80\xdbL\x07\xcb\xeb\xa3[\x90\xb9Y\xdb\x18n\xe9\x01\xba\xb66[\xb1\xc7)vJs\x97X7bc\x8f\xb0\xe7\xba\xc4\xd9o~z\xfb\x1f\xe3\xa0l\xd5Xc\x99$\x8f7[\xa6\x1d\x19[s\xc97\xa2D\xbc?\x99\xb2'U<\x1a\xddu\xf9\xe9\x13z\xb5\xa1g\xb71,\xb7| s\xd3\x98x4\x9a*\xe9\xe0\x17cz\xaf$\xf9\x94\xf3\x07\xc1d\xe9\x89I\xe15\xf3.\xbe\x855\xaf\xd5\x81\xbc\xc64\xeb\xbf\xa3N)\x91	]\x11T\x1eAH\xcb\xeb\x9a\x97\x16\x1dq\xafq9{l\xc3\xd1\x0e\x8a\x83\xd1\x1f\xe2\xd1\x90#Q\x8a\xaf\xab7\x07Q\xf1\x08\xb4:\xb2\xda\x1e\xdfl4\xe7\x08\x1c\xf2\x0d\xffV\xd6\x8d\x11/\x1cj\xb7\xed\xdfF#4\xb9s\xa2a\x19\xbc\xe3\xdf\xf4h\x8e\x87\x05\xa5\xc1jV\xf1\x1d\xd3\xbf\x8f\xcf@\xe2BZr\x9c\x06-\xad\xf9^\xab\xaa)y\xe4\"\xfe\x88~f\xf65;FhaD\x8b\x08\xd5\xe4E$\xadU\xc2x\xec\xbf\x92Rn\x06h\xcb8r\x86\xf0\x98\x80Px\xc6\x0d\x99\xac\xfe\x13\xa3\xde\xb4	\x8b\xf5\x89\xd3\x07\x1c0\x1a\xa1_:\x0d\x9d\xa2\xb4\x90\x1b-\xe4sG\x84v\xecw!\x9f#h\x081\x90a\xf0\xba\xa6\x7fn\x86\x18\xb1U\xc4\xf0\"\xe4;\x0e\xeb\"\xa7\xa1=\xc6\x87@\x05\xe1\x04\x0em\xd4f\xc35B)\x18\x86D\xc7\x8b\xdeeA\xd4\xa12\xfcz\xd6\x1dTQ<\x1a!\xffF\x7f\xf7\xfa6\xce\xa1\x1c\x9cz\x1f6p\x17\xdf\xa2\xa3\xb4X\xac9\xf0\xcd\x06\x7f}\xe1\x80<\x04\xc1\x80\xd9!7u\\\xb6\xb3\xa09\xcf\xdeHr\x87E\xee\x92\xbaU\xc0N\x02\xe6\xc7Y\xfe\xa6\x1a\xc3BY4}\x0b\x10!&q;\xb8\x8d\xb5z\xa1\xd0\x08.\x1d|N\xb4Z\xf8\x0dnn\xc7\xa4\xf7.\xbdc\xa8\x11\xe9\xe2\x8er}\xe7\xc4s\xe7\xde\x0d\xae\xb2#\nF\xf8\x89^\xf3\x1b\xdc\x08\x07\xb8C\x94\xed\xca\x94B\x0c\x9e\x97\x865\xe8\x02\x80B\xd7\xf4\xe8W\xc5_D\xc9\x1d\xdc\x9d\x1dC\xff\xdf@\x8eNi}I\xfe\x9d\xf1\xad/\xe7\xff!\xb0\x9d\x1e%\\q\x81\xe0\x85\xf2\xd6\xc9Y~\x18\xf0\xb8\xa0m(\xe4o\xd0H\xe7V8\x053\xc2D}w8\x87\xc2\x81\xa3\xe0\xbf\x12\x1e\xa33|\xfcN%\xa2\xbfm\xbf?V+\xd9\xca'\xe4I,\xd0\x1e\x855\x83\xc5\x95\x13\xb5\x05>\xd0{{\xdc\x83e2\xaaC\xe0s\xf4\xed!\xf6w\x80\xd8a\xc7+w6h\xd0\x0f\x0eq\xef\x06#\xfe\x95\x13\xffs\xfax}\x82\xb8\x0b	\x02\xff\xf9\xdd\x1c\xd1\x17\xe4\xd5\xd9\xe1t\xb7\xd73\xc3\x0f\x91\xff\xee\x0f#?\xd2\xf2\x0e\xfdO \x8a\x19\x9f	\xaa.\x15\x0c\xe8\xf9\xc3k3A\x07\x96\xa7\xa7!\xb5\x19\x9a\xf6\xbb	\xe1\x8f\xb8\xc7Y\x86\xb8\xe1\xdfJ\xbe\xb7\xfd\xc8\xbf\xb2\xb1qk\xae\x90S>\x90L\xef^\x81	CU5\\\x86\xad\x0d\xc7\x04\xf3\xea=\xc4\xa3\xd1\xbb\x18f\xc1\x87p\xff\xd9\xba\x16\xcfm\xad\xf5\x1dr\xfa\x89s\x19Q\xe3\xf1Jm\xce\x8au\x139 P\x97\xed;\x98\xbep\xcf\x9e/\nyQ)\xd85\xc6\x02\xab\x8d\x82\xf5E\xa5B\x9cT\x0c\xddxW\x99e\xf6\xf2\x17\x9ai\xcd{\xb2T\xa0d\xfdc*D;\xa0\xb7\xfdA\x13\x18\x94j\x7f<\x1f\xe8\\\x81\xbfp}\xec\xfd\xce/%i\xdd\xe1L]\xaf\xd7\xd4\x89L4\xa7\xe6\xa5\xd8\x8b\xb6B%\xdb\x94w\xe9#\xe7*fTo\xdf\xaa\x03Ju\x84\x92IPk\xcb\x84\xbc>\xc5\xc5\x96\x84\x04\x06\x9a3\xa3\xa4+D2)]\x1e\xc5\x04\xbf\xd5\xaay\xde\x02\x83\x1d\xafD\xb3\x83\xb21V\xed\x98\x16\xa8}\x0c`\xca\x0ca~\xfe\xad\xdc2\xf9\xcc\xc9\xf1\xee\xe2\x8b\xca\xff\xf2\x02\xcdZ?s\x19\x1f\x1c\xa9p%\x00z\xdc\x96\xbe\x08U_W\x80\xa57\x91\x93p\x83\x11\xd8\xae\xb1\xe6\xb5\xe0/\xdc\xf1\xb9\xc7\x8b\x9a\x0b=\x0e\xd5\xab\xf6\x06\xe3\xc6\x8cC\xa6\xc39(\xff\x99f\x83Gl\x9c\xdc\x93\xa8P\xa7%\xa9\xce\x8b\xa6$\xec	C\x7f\x17\xbf\x8b!\xe7\xaer\x85\x88\xed9\xe9\x80\xbfJ\xfa\xb1-\xe6\xab\xcd\x90\xf8\xae\xf6V\xe1\xf1~#\xb8\xa7\x8c\xe6\x02\xba\xbc\xfb\xf6\xdf\xf5\xfe\xc8\x8eT\xe0\xd6|\xa7^\xc8\n\xac\xa6\xe2\x9at\x01A;\x8a\x86\xe8\xa1\x97\xaf\xbd\x87\xe0\x15\x05\x93\x07\xb4s\x0f$\xd8\xc6I\xc3[j\xe3\xf3\x8f?7\xe2o\x157\xa5\x16{J\x9e\x96\x7f\xb3\xf0,\xa8*\xcel\x87r\x98\xfb\xe4)\x19U\x0e	.\x8ea\xa4\xee\xf71L\xf6\xfb\xbaw\xce\x98\xf8B2\xaba\x89^\x14\xb4\xef5\xe1x\x0f\xa6\xad\x1dq\x1a\\\xcd{\x9b\xf4K\xbf\"\xb8\xc8.\xa4J\xca\xee\x9a#D\x94\xd6Y\x869\x810\xe8^<)9\xc7%\xbf\xa7\x1eDx\xdfB\x96D\xe8\xd7\xd9\xaf\xdc*\x14\xd4*'\xbb\xbf\xeaRPn\x91\xb8\x02\x83\x0d'\xa9\"80\x8d\xbezDN\xbeG\x0e\x14\x81\x90\x15\xdfI\xca\x0f\x1aj\xd1&\x8b.\x99\xd0\xc4\x92D\xa2\xea\xd4)l\x9dk#\x86\x07u@@\x8d\xe0\xe8\xe5\xab\x14\x18\xe5p;\x9c\xa9\xd4A\xc2\x9aoY\xbdq\xd2\x92\xe2\xc3\xa3\xe0\xad\x97\xe7j\xef'\xfd<\xd8\xa1*\xc1\xad\xb0\x98KU\xddX^\xe39\x8b3\xedm\x11\xe0\xf5\x7f\xa3\x03\xaan\xa3n]LS\x90!\x1dw\xc2\x13:\xb8\x83\x1b{\xd6\x9c,\xe1\xa7\xdc\x1c\xaf\xec\x04\xdfsi\xe7\x84\x19\xfa\x0dv2\x08Y6Zw\xb87\xa8\x93\x8b\x93\x123\x04\xed\xa6\xa9\x89\xc8\xbc~\xcb\xce\xd1qC\xb4]\x8a\xa0\x9f\xcex\x86\xda\xf4\xd3\x91'E\xfd(:;\xe0\x0d\\$\x0c\xa6\xd2\x1f\xe4t\x14t`\x08kil\x00\xc8r\xab\xa8\x90\xe4+\xec\xec\x18\x10\xca\xbfT	\xb2\xa4\xb4\x81\xbevk\xd0%\xc1\x8b\xa8x\xd5\xe5\x0e\x1f\xe1\xe7U\x80\xebr\xb6t\xc6v\x07\x08\xb2\xec\x902*\xc5\x0dy?\xb3\x96\xef\xf6T?\xa0\xcb\x8b\x0e\x8a\x87q\xc0\xe3\xec\x05\xf0\xb4\x94\xdc\x8f3\xdc\xe2\x0fx\x1e\x92g\xc92\xdd\x9c\xd3\x97A\xbb\x0c\xd0\x0fg\x07\xd6Se\x1d\x14\xf8\x07\xc2\xd1\xa9\xcf\x99\xc9\xcdti\x11R\xffp\xe8\xa1\xd6\xbe\x17\x15\x17(\xf1o\x1d\xa3->\x0d\x85\xe0\xe9\xe5v?\xd6<{:)R\xb8+\x07<\x18a\xf2\xbc\xb0g\xef\xf4\xf3\xc7\xee\xb7\x87\xaa7\xfdeiG\x98\xcfk\x0e\xae,dcH=%e\xd4\x8dp\xee\x1f\xa6\xd1\xdc;8Q\xa2]H+\x17>\xb0i\xea\x8d\xa0\x8b\xa0\x10J\x17\x99g4z\x1f\xb7x\x14`\xa6\xa3^\xef\xf1D\xb4\xe0\x87>d\x15\x8d\x84GQje\x8e\xc6\xf2\x9d\x89 \x95e\x8cpo{\xd7\xe7!\x88\x8d\xe5\x07\xa6+\xd2\x03*\x7f\xdf\xacka\xb6\xa0\xf9\x8b@J\xec\xd9\xa2\xe4\x87\x90\xd7/\xf7\xe2<\\\xec\xc8\x03\xf1\xff1$H\xef\x02\x118\x88\xba\xc6#\xcf\xb3x\xe1\x92B\xccX!\x9f\x1ba\xb6h\xcd0L6\xbb5r\x80\xa4;\xb5\x06\xe4\xeaJ\x14\xf0>~G\x07~J\xdf]\xb1\xaf\x0fLaO[\xcf\x1a\xdb\xee\x03W\xcf;\xe7\xaf\xef\x91\xdd'T\xe2\xc0\xad\x9d\xa93x%\xab\x0f\xec\xe8\x98\xa1\x90\x0do\xcb\x85\xdf=)\x0d\x82\xcf\x0f\xee\xb8\xcf\x08Tkn?\xba;N\x9c\xdcM\x97\\\xbc\xf0j\xd8\x89\x10\x15\x87\x83\xb8\xbb\xb2\xecQs;X\x82\xdek\xb5\x15k\x81\x86C\x08$\x9b\xaf9\xfeur\x92\xfd\xe1\x8d\xbe\x03\x85\xd0\xb2qe\xaf\xbd\x98\xea\xe9\xd7\xf9\xe8\xbf\x8fZ\xb3\xee\xde\xb3\xf3\x12\xa3z\xe4\xf5_\xe1!\xafR`\x88\xe3\x0e\xfe\xce\x82\x83\xfc\xfe]8\xd5\xf2\xaa\xef\xf2_\xb6\\\xb6<\x81\xc9+i\x04\x07\x1c\xe8\x94\xa8:\xc8F\x9c\xe8\xf3\x03b/\x037 \xe7X/+\xd4\x15\x1e\xcd\x83<\xd7N\x11\x822\xfcot\xc7\xad\xb9d;~\xb2=\x9c\xc9\x1f\xfcPQ\x9aS2/\xbbf>z\xc3+\xeb\x1c0B\xc1\xcc*\x0c\x07~Iy\\J7\x979=\x94x\xd7\xe3s\x03\x0b\xdb\xe7\x08\xfd\xc9\xdaV\x9b\xd7\x90\x86x4B\xd2\x9a\x16\xd3\xf9$}Lr\xc8\xee\xe1\xcb$\xcf'\x8b\xe5\xd7x4\x9af\x9f\x93<\x99A\x91\xdd/\xbfL\xf2\x04\xd2\x02\x9e\xf2\xecs:Kf\xb0Z\xcc\x92\x1c\x96\x0fi\xd1\xf6Sf\x0b\x98,`R\xe0\xb8\x8f\x93\"-\"\xf8\x92.\x1f\xb2\xd5\xb2\x9d\x15W\x98,\xbe\xc2_\xd2\xc5,\x82$]>$9$\x7f{\xca\x93\xa2Hf\x90\xe5\x90>>\xcd\xd3d\x16A\xba\x98\xceW\xb3t\xf1\xa9\x9be\x9e>\xa6\xcb\xc92\xcd\x16Q\x981M\nX>L\x96\xb0|H`H\xe0\xfb<Ip\xd5Yr\x9fL\x97E\x04\x8fI>}\x98,\x96\x93\x8f\xf3$\x82\xfbt	\xf7Y\x0e\x13x\x9a\xe4\xcbt\xba\x9aOrxZ\xe5O\x19n(\x87E\xb6x\x93.\xee\xf3t\xf1)]|\x8ai\x99d\xb1L\xf3\x04\xf2\xb4\xf8\x0b\xeev\x99\xd1\xd3\xbf\xae&\xf3t\xf9\x95\xdaM\x9f\x92\xfc>\xcb\x1f'\x8b)\xad}M6\xdc\x17|\xcdV1\x14\x0f\xd9j>#\xd5\\\x0cD\x95'^\xfe\xf4s\x02\xe9\x82\xc6\xe5I\xf1\x94L\x97\x11N\x007\x8b\xcc\xa9 ]\xa4\xcbt2\x0f\x0d\xb0h\xd3\x9c\x86g\xa4\xeai\xb6p}\xb0Y>\x86IQ\xac\x1e\x13/]\xb1\x0c\xb6Y$\xd3\xa4(&\xf9W(\x92\xfcs:%\x13\xe4\xc9\xd3$\xa5\xc9\xa6Y\x9e\xa3$\xd9\"v\xe6\x1f\xf6\x1f\\\xaaX\xa6\xcb\xd52)\xd0-\xd0\xc0\x0b\x12\x0d\x15\xed\xb4\xd2\xf9N\x0c\x8b\x0cVE\x12d\x18\xd2\xd6d\xb5|\xc8\xf2\xf4\xbf\x92\x19<$y\xe2\x1c0\xf9\xdb4yZ\xf6\xbd\xb1\x13'\x1e\x8d~\x8ea\x99\xe4\x8f\xe9\x82\xbc\x86\x1eP\x0b\xc7\xc51\xa4=\x07\x84\x0b\x8c\xb6~\xe0\x98\x06\x86\x93\x90\x04+\x8dU;fEIh\xee\x90\x036L\xd4\x84Y\xd4\xf8\xe3O>\x14\x81\xae\x1f\x90Vi\x07!\x99#\xb2\xb7\xd6T\xb2\xf2\xf5\xa0wo\xa1B:\xa06\xb0\xe6\xa5\xdaQI\xc7]\xcb:hq\xc3cxB\n\xe3h\x13\x05x\xe4\xf1Xh\x90\xcc6\x88\x85\x94\xe64\xdf\xe1qNH\x7f\xd9\x02k~T~\xbbaCCph\xb6\xac\xae\x91s\xbe\x88\x17NJ\xbbkOA\xcc\x18\xae-\xb0P\xea\xea_Z\xb8\xfbZB<\xdfgV\xf1\xb2f\x9aY\xa5\x8f\xf0\xf7\xa6z\xa6Q\xcc\xdd\x0b\x8d\x81=#T\xd9+\x05\x8c\xd3+\xb0a\xaeqz|i'<l\xd5\xae/\xad\xbb\xba\"\xe1\x84q\xf8\x8d\xb9\xd1*$\x84O\xd4\xcd,\xf6L\xda1\xb0\xba\xe6\xcf\xae\xc3\xd4#k\xef\xe7^\xc3\xc4\x8e3\x19\x1aQ\x87.\xcf\x0eh\xf7\x8b\xf7\x859\xdb\x96\xa7\xe8\x03\x97\xf3\xd7\xde\x1f\xd4\xc3\xd8w[\xd5T\xa6\x08\x0dY\xe8\x9e\xde:\xfd\x8eA\xea\x19\x92'\xbd\xacg\xbe\x7fe2\xabH\xa7\xe1\xca\xb9'\xd8\xb56\xa4\x1bq\x95$\xba\xd3\xfc\xd9\x06\xc7\xadD=%\x85F\xb6~\xc3I8B\xdc\xc5w\xc3\xde\x1b\xb9\xc6\x84\x9f}Hy\"J\x19\xb0\xaf\xd0.\xac\xf7Z\x99\xbd\xbb\x90\xac\xbdbN\x02\xdd{\x03\xff\xb6\x17\xba\x8d\x1a\xd2\x83[#,\xb1\xe7Z\xa8*\x82F\xd6\xdc\x18\xc4\x07\x1f\xdd\xfd\xb1n\x90c;\xc2n+\xcd\x0e\xbe&C>J\xf0\xa19\xc9\x13(\xc6\xa0\x1b\x06\x7f?\xb7G\xb8\x0bo\xa4\xa8\x99\xe5\x9av\xe0\xda\xfdL\xe3\xe9\x15\x83\x83\x16\xd6\xa2'\xe0A\x9f\"\x93\x16\xee\xcdC\xb1\xff\x8e\x0e\xa7\xb4\xf9\x17\xdf\xc0\xd7\x07\x8f3\xe3\xfc\x1c\xdf\xe2J?\xc7w\xe1\"\x15\xcd\xc9\x1d\x17\xd3\xddE1\xc5\x17\xdd\x15\xac9\x97\xf0\xc2jQ\xd5\xc7\xd6\x05}	#\x14\xbc\x03\xdb\xf5\xad\x1fnM\xf7u\x03\xea\xa7'N\x0f|..\xa5;\x07>\x9bt|\ny\xfd	\xe3\xd1\xe8\x97\xb8\xc7<0C\xcd\xd3\xc9\xc7\x14\x93}<\x1a\xb9\xcc\xb3\xc8`\x9a\xe6\xd3\xd5c\xb1\xc4\xa4_\x10\x0bh\x7f\x9a'\x9f&s\xcc\xb3Y\xfe5\x82/\x0f	\xe5\xe2e\x96/\xe1\xa6\xa59\xb0H>\xcd\xd3O\xc9b\x9a\x8c#\x97\xa8'\x98\xde\xb3\xdc\xe5\xee/i\x91DP<L\xe6sL\xf9\xd1p\xba\x8f\x86\x93}\x14h@\xfb!LF\xd9\xfa<\xcf\xb6\xe3\x8a\xd5\x13\xf2\xb0<$\xe4\xec\x1e\x8a\xd5\xf4\xc1\xb1\xa4\xa4\x88\xe0cBj\x98'\xc8\x7fp\xc4S\x92\x17\xd9\xc2\x91\xa9\xc5WH\x17\xb34'~\x824%\x9d\xcc\x89\xd1\xa5\xb3d\xb1\xc4\x7f\x13\x91X\x14\xc9_W\x9e\x15\xcc&\x8f\x93OI\xd1\x12\x80\x87	n?\xc9\x7f\xc4\x03\xc3{\xb8\xee\x1cY\xccS\x9e\xdd\xa7H\xf4\xe6YA\xd3}\xca\xb2\xd9\x97t>\x8f\xe0K\x96\xff\x05\x8ae\xf6\xf44\xf9\x94\xa0\x8e\x1f\x9fV\xb8\xc4\xfd$\x9d\xafr\xe2{\x8f\x93\xf9\xfdj1us\xfb\xad\xa0-Q\xebA\xab\x8fH!Od\xa6\xa5\x0bTK\xf29Y@\xdaS\xd6Wo\xb2\x87\xc9\xe7\x04>&\xf8\xeb\x02\xb9!\xb2]\xc7\x0c\x9f\xb2\xa2H\x9d?\xb5j\xf63\xc7\x81$\x0dz\x9f\x9f\x19\xa9\xdf\xe4\xe9i\xfe\x15-\xd1\xfd\x88\n\x99%\x93\xe5\x03\x8a\xe7\x8c3\x99C\xba\xf8\xf3*'\xf2\xb8\x9a/\xd1\xeb\xee\xf3\xec\xb1'm\xd1s\xc3\xc0l\x93\xbf-\xe9;*\xf4\x88)\x99|>\xf9\x82z~H?\xa6\xcb\xc2\xbd\xdd\xc9\x18C\x91=&\xf0\xe7U\x9e\x16\xb3\x94TY\xc0,sr\xce\xe7\xd9\x17?\xe9t\xbe*hK\xf9\xd9\x06;?\xb9\xea&\x11\x14\x99\xd3M7\x0f\x9a\xa97\xd1\xe3\xe4\xeb\xa9j\x90h\x8fF\x7f\x8aa\x15\x171|B\xcf_\xd0\x07b	\xc6j\x91\xe4\x85\xbf\x80\xbd\xac\xec\x1a\xba\x16\xdf\xed\xb8\xa6\xb6aa\xf9.r\xd7\x87\xcc\xe5\x10\x08\xad\xd3\xae\xae\xf4\xfeO0\x8d\xef\xe3<\xc6\\\xf5\xf6\x16n\xb2\xd2\xc6p\xfb\xeb\xaf?\x8d\xa9\xad\xda\xb8b\x15\xb8\xce\xf70\xede\x13\xfc\xcd\xb55\x98\xed\xad\x01w?\xdd\xc5ww\xbf\xbc\xf9\xe5\xed\xed\xfb\x1b6\xbe\xb9\x1d\xbbD\xfa\xdd\xb9O\x1b\xe5\x99\xe9WU\xe9\x9a\xc1\x9c\xef\xe5\xf6.\xbe\xbb\xbd\x83\x9b\x82\xef\xc3n\xa8?\x10w\xd3f\x8f\x8b\xe1(HO\x1fw\xbf\xc4\xbf\xdc\xbd\xbd{s\xdb^\x9d\xb7\x8f\xde\xc3\xcd\x9f\x1b\xc9\x83\x9e\x10\x90\x9d\xa9\xa8\xe8J	*\x91\x15\xac\x0c\x9e\x96\xfdW\x0d\xd7\xca\xb6\x12\xf9\xcaV\x99\x81\xfa\xbe\xffB\xc7\x9d\x02\xce\x17\xc8\xfd\xcdtM}\xec\x82\xee\x0fj\xc1\x1bj\xd9w\xfd\x8b{\xae\x0d\xaf\xb8q\x9f\x03\xb9r\xe1\xfd$\x8f`F\xffm\x9b^\xfc\x1c\xf4E\x8b\xe7\xeb\xbe\xa6_U\x9a\x1bLL\xbd\x85\xbb\xeb\x8aKS\x0d~\x1c\xf2k\x0c\x8fi1M\xe6\xf3\xc9\"\xc9V\xcey{4\xa8\xbd\xdeo?\x18\xdcS[j\x97\xedK%K\xae\x89\xc6\x86\xae\xcc\x1d\xb3\xd6\xb7\x8f\xab\x0dq~\xe2\x8d\xad\xfc\x17\x85\x13<\xe0\xd4\x94Y\xd7('\x97\x1b\xa5K\xce\xe8\x83+r\xa8\xee]\x97b\xd7(\xd9F\xe9]hQ\xf1\xfc\xc6\x7f\xae%y\xc9\x8da\xfa\x18z\xed@X\xe8\xcdzvxk\xe7</\xcc\xd7\xec\x10NM\x7fo\xb40\x95p\x95^\xe4T\xae\x16Dm\x1d\x9e\xb4\x0d^\xcd\x0f\xb4Iw\xa5\x9c\x9e\xc8\xbd[\xea\x9a\x1d\"p_R\xb6Wd\xa6+\xde\x8c#\xe8\xd8	i\xa7/\x1b\xd5\x817\xb5(\xed\x1b\xb5y\x83\x1bhug\xdcwO\xb5\xb0\xe1\xa2U\xf3\x9a\x11\x84\xb8>\xda\x01\x8d\x9c4\xda\x9ei\xc1k\xa6T\x8d\xb6\x06jU2\xdf\xb3w1\x12]\xfe\x85\xcb\x86\xffS\x9a\x8b\xba\xbb\xc0ZQ'\x83kdC\x8e\x8b\xf8\xe1/\xcc\xa0T\xc6\x9a\xdewJ\xd1\xe0\x87J$\xb6\x1b\xebKsmo\x0e\xb3Vi\xc9\x8f\x066\x9c\xbb_\xf9\xb7=\xb1@\xf7\xe5\x06;\xedq@\x89VR\xe0\xee\x17\xfe\x16\x7f\xaa$\xd2\\\x1a \xdd9\x84\x95\xd6\xb4\xd7)\xa9\xb4\\;n\x88;d5\x1d\xcf?)U\x19\x0c\x07\xfe\x0d\xc3\xce\xd4Goj^y\xdb\xa1Cj\xd0\xfc\xb9\xa9}3*\xd5\xe6Z/\xe9JyL>7\xec\x99\xbb[\xdb\xf6\x9b\x98\xd6\xb0\x98?\xacn0\x0bx\xfa\x8foU\xda}\xed\xe5\x86\xd1M\xe9~\x1f\xc2\xeb\xbc\xf5\xc7_\xed\xb5W\xb7\xaee\x96z\x16\xce\x0dry\x9b\xeb\xf5UX\xfa\xba\x99\x7f\xdb+\x8d\x98\xb6\x13\x12	up\xcf\xb0M\x037\xa1\xc2\xe2\x87\x86\xcf\x8ajv\x08\xf6ku\x12\xbe{\xf4\xb7n\x0d\x1e\x02\xb9\x19\xe3q\xd8U\x8f\x7f\\\xfc\xee\xea\xdcCM(\xf1ht\xfb6\xa6\xe2Y\xb6hi\x17r%*\x1a!\x8aN\x0c\xac\xb9=\xe0\xa9d\xb8H}v\xe6\x0f\xfd\xe9\xbe\x91\xdd\\*\xd158\xd2\xad \xdb1:\x8dk\xe1:\x9d\x87\x8f\xda\x11\xa0\xcf\xab\x0d\xf5\xe66V\xd4\xe2\xbf[\xfd\xf4\xbf6\xbb\xbcv\xecYW\xf9\x8fz\xd1n\xc3\x1b99d[\xd5\xd7+\xa1S\xbb\x11\xdf\xe7A\x9d\xe3\xfc\x1f\x8dpW\xdc\xd48\x1e\xc3BY\xbar\xf3\x95.\xca\x99\x96\xcb\xca\xdd\xdc\xb4n[a\xd6q\xfd6\xe8\xc0\xc2\xba\xdb\x97#\xb9\x8e	\xd9\xa5\xbd-\x8e\xff'\x00\x00\xff\xffPK\x07\x08\xfc\x1b\xa6x\xdb\x14\x00\x00\xca?\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce9sO\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0b\x00	\x00cddl.headerUT\x05\x00\x01U\x96\xd3]\x8cQ=o\xdb0\x10\xdd\xf5+\x0e\x9eZ\xa0\x95\xda5\xab5d0\xb2$@\x90\x91\xa6\x9e\xa4\x0b\xc8;\x82<\xda\xf1\xbf\x0f,\xcbq\xbce|\xe0\xbdO\xbe\xcc \xafb\x10+\xa4#\xd9\xcc\x85F\x0e \x97A\xa5\xee\xdf\xe1\x8dL\xc9f\x90!\xc7\xf5\n\xb4\xd5\x18U\x9a\x1e\x07\x04M\x11b\xe4d\xa0\x9e\x8be\xdeWc\x15\xda\xb1\x87\x14\xd0\xaf3a\xb3\xa2\xcd\xef\x96\xde\xb4Rt'\x125\xaa\x057\xdb\x06\x1f\x1e\xc9\x88\x85\xbc\xc6\x14\xd8\x89\x07\x1d\xd9\xe6\xc5t\x95\xb8\x08x'\xa4{s,\xe4\xc8k:]\xa3]m\x9d5\xb3Yz\xe8:M\x90\xa25{\xb4\x9a\xa7.\\\x0eJ\xb7\xed\xfb\xdd\xdf\xff\xed\xbf\x96\x9e\x81;\xee\xa8y\xc1%\xc1\xf3\xc8\xbe	N\xa6\xea&\xd0\xa4\x07da\x99(!G.\x85U\xca\xd2=pds\xb6\xe0*\x03\xf2}\xe6\xd7\x19\xd2\x0c_\xfb\xc8t1\xd0\xd1\x8e.\xe3\x0f\xb1\xf8P\x87u\x8ck\x8eG\xb8\xb3\xd0\x93\x1a{\x9cg\x81\xf3\xf3\xfaC247\xce\xb7\xe8\xcb\xa3\xd1O\xbb\x7f\x06\x00\x00\xff\xffPK\x07\x08\xc4[H\\\x18\x01\x00\x00\x04\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce9sO\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x03\x00	\x00eplUT\x05\x00\x01U\x96\xd3]\xc4Z\xcdr\xe38\x92\xbe\xeb)2|i+\x82\xa5\xdd\xe9\xfd\x8b\xe8>\xb1e\xdaf\xacLyH\xb9\xaa|\x84HPB\x17	\xa8\x01P*\xbd\xfdF&@\n\xa4\xe4\x9a\x9a\xb9\xec\xa9\xca6\x99H\xe4\xcf\x97_f2)\x1bq0\x1c^\xbbm#JX\x89\x92K\xc3\xe1\x13\x1c\xe1o\x8b\x7f\x9f\xcd6\xcf	\xc4\xcb\xe5\xfa\xe55\xce\xde\xd3\xec	^\xf3\xf5S\x1e\xbf@Z\xe0\x7f?\xa7\x0f\xc9\x03\xbce\x0fI\x0e\xf8\xe8&\xc9_\nX?\xc2\xe69- Y\xae\xd2\xd7\"\x81\xd7\xb7?V\xe9\x12V\xe92\xc9\x8a\x04\xee\xef\xe2\xa7<I^\x92ls7_@\x9c\xbd\xc3[\x91D\x90'\xaf\xf9\xfa\xe1m\xb9I\xd7\x19\xacsxH\x8bM\x9e\xfe\xf1\xe6~~\xa4\x03\xfa\xe3\x97\xeb\xac\xd8\xa4\x9b\xb7MR@\x9e,\xd3\xd74\xc96\xbf\x14\xa8k\xf2\xba\x89\xb3e2h1\x1c\xb6\x98\xcd\xfe\xb6\x80\x87\xe41\xcdR\x94Y\xccfwK%\xad\x16\xdb\xce\n%\xef\xa0\xe5L\x9a\xdff36\x07!\xc1\xee9\x94\xccpP5\xfd_Ha\x05k`xG\xe9h\xf4\x87RU\x1c\x98\xac\xa0Re\xd7ri\x19\x8a\x85J\x18\xf7<\xaf\xa0\x93\x15\xd7`\xf7\xc2@\xbc\xd3\x9c\xe3S\x11\xbe3\xdb^\x9d\xc9Y\xb9\x07\xd3m\x0d\xff\xab\xe3\xd2\x86\xe7\xfe6\x13s(\xf7L\xee\xb8\x01\xab\xe8\xb5W\xadv\x9a\xb5N\x9a\x10s`U%P\x81\xe9\x13\xbf\xcfN{\xae9\x98\xae\xdc\x0fB\x98\xac\xfeM\xe9\x0f\xdf\x01\xa5\xc5NHf9\xd4Z\xb5tK\xa6\xf9\xe8n\xdb3\xd8=\xb3p`\xda\x8a\xb2k\x98\x0eU^@\x0c\xa1\xb5\xe1\x97A\xa4\xf9\xc5\x0b\x0d\x9f\x07Q\x83\xb0pb\x06\x95\xe2\xd5T\xa1\xed\xd9]`\xf4\x8a5\xbc\xa9\x01\xef!\xcfJr`\xa5\x15r\x07J^=\xfb\x8b\x81-\xdf\xb3\xa6^\x8c\xb42P)\x90\xca\x82\x90e\xd3\xa1;?2\xc8i/\xca\xfdop\x8f\x86Fc\xf2\x03\xd3h\x9eVU]\xc3\x0d:\xd0\xa8\xda\x9e\xa6V\x12\x12J%\xff\xecdIV8	\xbb\x1f\xc9\xed#\x84\x0b\x0d\xea$\xa1\xf1)\xc9F\xe1\x02\xf7\xc2\x1f\x8c\xcaV\\\x8b#\xb3\xe2\xc8\xe1\xa4\xf47\xd3G\xac\x97\xb9\x08\xe2\\i\x1f\xe6h\"8pm\x94D\x83qi\x85\xf5\x0e\xbc\xa8k\xc6bfw\x1e *xe\x96Kk\x9c08\xd0OP6L\xb4\xc6\xab\xcc\xb6\x0dG/\x8d\xbdJfs\x8a\xf3\x92\x1b\xc3\xb4h\xce d\xad\x85\xdc\xf5A\xc4\xa1\xc3\x14\xd0`XC\xa9 \xac\x19\x07\x0fk\xd0\xbd$\x8f\xa3A\xdb\xad\x90\xbc\xba\xb2&\xaa\xec\xff\xdb_\x1b\xff:\xf1\xf8\xd8;\xac,\x95\xae\x98,y/.LV\x94\x98\xf3R\x1c\x04\x9760%\xaas\xda+\xd0\xbc\xe4\xe286\xdc\x07Y\xefB\x0c\xe3\x935#T1\x8b\xd9\xec\xd7\x05<\xe5q\xb6A\x18\xcb\xd3\xa7\xe7MA\xb0Tt\xdb?yi\xfb`\xb4\\\xb7\xde\xdbc\xd9\x84\x1d\xa1\xdd1\xe3\xb7g\xd8i&\xad\x81\xe1\x06\xc0@*\xf9\x89\x7f/\x9b\xce\x88#\x8f0\x80\x9a\xea$*\x1e\x81Vg\xd6\xd8\xf3\xa7Zs\x0e\xa5:\x9c\xb5\xd8\xed\xed\x10\x91\x16o{\xd0\xaa\xeaJ\x1e\xc1Ac\x06\xf0[\xb1\x18\xc1\x81\xcaKsF[\x1f\x1av\x0e~s\xe0\xbaV\xba\x8d\x027P\x80\x1bz\xc0\x1d4q\x19\xe5\xd6$\x9f#\xc4\x0b&\xcf\x91\x7f\xb9\xdc_i\x82\x16\x07\xa3:]\xf2\x0bT+gO\xfa\x19\xf5X \x10\xff\xffY\xd9'R\x7fs\x178\xd3\xa4C\xbdZ\xf6\x8dG\x98&\x11\x18\xde4\x11\xa8\xba\xc6\x18S\xfeG\xd1\x1e\x94\xb6\xee\x8av\xcf\xf5I\xa0!5\x93\xa6v\xf0\xf2OX\xf4g\xcc\x06\x1b\xb4\xcdD}\xb3\xc7\xc8f\x87Cs\xeem\xe9R\x95\xf5\x87^)\x82\xc2\xc3\xd4\x11u\x04\xcc:7\x88\xf6F,\x88\xbe>x\xe4\x18\xdd\x80\xee\xd4C\xf8\xcd\xf3J\xd6\x19n|-\x0ct\xb3\n\xb6\xa8\xed\x91\xeb\x8b\xec\xa9#\xf0\xd6W>s\x97FX\x1e.\x8ePK^\x08\x8f0\x1e\x0b\xfbJ3Um\x01\x99\x82=\xd3\x15\x95\x90\x03\xd7`8\xde\xb6\xe9\x95\xc0`\xa3\x00Y\xcc\xcay\x10l\xf4;c\x99\xac\x8c\xc3s\xd6\xd8\xbd\xeav\xfb\xebx\xf5\x81\x8aG{\xb1\x14\\S\xb85`\xb8E?\xdb=\x9d*d\x04R\x013\xa6\xd3\x08\x94\x86\x00\xfd\xa0\xd5QxO\xe0\x8d\xc3\x83H\x8f\xd0\xaf\x95\xe2\xc6\x17Z\x07\xfd\xf4WoJ\xa5\xbd\xb9\x84\xb4\xbcixi;\xd6\xa0\xfc\x03\xd7\xf6\x0c\x04C\x94\x90\x17\xc3\xba\xfa\xb5\x80dz\xc5J\x18_\x96\xf0\xe1F\xb0\xadh\xa8\xd2\xa9\xc0d\xb5\xd2}\xf1\xdaj4\x95\xed/\x11J\x87-C\xbbc\xd0y\xa5[\xd2\xb6\xfe\x07z\xeaK\n. 6\xc0\x90\x01\xf8\x98\xb4\n\xf8w\xaeKa\xb0\x10\xa0\x0d\xfc[\x98\x08\x83O\xc8O\xa1\xcb=\xf6\\n\xe0\x91\x07]\xd2b<\xab\x86\x83\xe6\xe6\xa0\xa4\x11\x97\x1b\x1b^v\x9a\x07\x17\xfb\xa1\xe2\x92\xf3\x8aW=\x0e,\xe0\x11y\xc2w\xd6\x1e\x1a\xee~\x89\x80\xa8+\"|\xe7i\x1a\x08\x03\x9a\xff\xd5	\xed\xa8\x1bk\x1au\n\xf4\xb5*\x04\xfc\x11\x7f\x15\x16_\x1e\x1e\xfd\xc5\xdc\xb8\x08+I\xb4\x8b\xab\xfe\xc8-\xafU\xc8\xb6z\x8b\x0ed\xa0\x9a_\xc7\x07\x960n\x1c\xacR\x90\xba\xf0\xff&\xd5\xa9\xe1\xd5\x8e\xa3:{\x86\x08Q\xd7\xa2$\xdd/\xa5\xd0\x1bJ\xc8\xab\x94\xb9\xa0\xa7U\xce}\x1e\xfe\xa6U\xf4\x92YD\xff\xc7\\\xe3?\x16\x90'\x7f\x7fKsj`\x8a\xd9,\x1e\xe9\xde\xb23\x94{\xa5\\1\xbemO\x14;Ek_WP\xe7\x0f(\xe6\x90\xcbh\x13\xdf\x11\xa1\x80\xf6\xd0\x08n.<\xcb\x95F\x8c\xd5!\xa4oT\xca\xdf\x87\x0e\xc7\x9a\xeb\xd3\xa8\x99\xe1u\xcdK\xac\xd5\x8e$\xf8dT\xd2\xb3tJ\xf6	K\xa2_\x9c\x98F\xe3\n>\xd5\"\x02\xfe\x1d=\xeb~/H\xf1*$]\xc1\x9b\x98\xfdc\xf5\x85m\\\xa1\xc3\xba\x1df{\x14\x8a\xfb\xa1\x8c\x96kl\xael\x0f8\xf8^-\xacD\x95\x10oX\xd8(\x1d:}P\x86\xffN\x8d[h\x0b\xe2\x0c\x15\xff\x19S\\\xb0\x0d\xc5W\xace;n\xc2\x1bWB\xf3\x92\x88g\xff?s\xe0\xa5`\x0d=$*\xecX\x9b\xde\x8c\xbe\xeb\xc4\xbev\x10\xe5\xca\xa9\x81F\x19\x8b!R\x0bkPe1\x07c\x99k\x17\xb0\xde`g\x81\x01d\x822W	\"(\xd4\xe8\x8d\xa3\x83\x8a\x07\xd1\x97\xa0\x89\x0c\xc3\xdc\x91}\xe7\x8d	0\x13\xf4\xb8\xf0\x12\xc7\xb1\x16!e\xa9\x95\x1e\xe7\x84\x01vd\xa2\xa1\x1e\x85T\xbaf?\xe4g\x89\xe92\xc4,7\xb0W'\xcc5\xb5\xb5\x8c\xb2\x9e:\x06\xd0\x9c\x19%IZ\xcb\xa4\xe4\x1a\\We\xf7TM\x80A\xcb+\xd1\xb5Pv\xc6\xaa\xd65=\x1d\x16\x13\xd4l\xe8\x13\xf9w\xd7\x8e/f_\xb0\xad\x99h\xdc2$_\x83\xda\x13V\x86\x8a\x0e\x89\xdav\xc6\"\x7f\x99\xbcr\xab\x0f\x19r\x93\x118]'\xef \xcc\x13\x15\xdfeQ\xfd\xb9\xbc\x11\xa0\xec(0\x11\xa2\xd0k\x9a\xb7\xeaH\x1d\x1bk,\xa7\x06=\x00C\xa9\xac@\x1aQ*\x89v\xf5g\x089\xe9\xe5\xae\xc0\x9bT\xa3\xc0\x15\xf5\xb9o\xff\x99#4\xfdx\x01\xcb\xefu\x079b\xb7\xac\xf7\x1a\x05\xce\xe0\xcc\xb3\xabZ&\x1c\xc2\x0ce\xc9\x11\xa5\xfe\xe8\xeb\x03\xaf\xe8\xdcl\xf6\x9f\x0bX\xae_^\x92|\x99\xc6\xab\xd1xk6[\xaa\x16\x01\x83\xd2\xad\xc7q\xb4_8F@[\xb2\xb2\xe4\x07\x0b%\xd7\x14\x80\xa3\xca8 3\xfe\xd6\xf7/\\V\x18h\xdaD\xb0\xed\x8c \xf4\xc1\xa4\x91\\\x9b\x81l7\xe2\x1b_\xc0\x97\xbdh\xb8s~P\xc4\x91!H?\x7f\xa9Y\x89\xe70_a\xca\x8b\xce\xddeL6\xd4\xf1	\x1b\xa7\xf6\xd8\xc7\x90\x99V(\x16\ns=\xa5u\x98\x80\xc0e\xf6\xaak*\xa8\x14\x185\xf2\x97\x87\x96\x9eK\x96\x9a\xa3n\x07e=r\x8d!\xd1A\xc6\xa8\xd1F\x02\xaf\x896xF\x13*\xfc/)\x1b]\x8f\xa5\xee\xef\x02\xef\x06\x7f\xb8\x9b\x0f\xb4\x0d\x93\x8d\"\xaa\xe25\xba\xcc\x81O\xc5[\x89\xd1\xc5\x8f\\\x9f\xaf\xd5\x87\xfb\xbb\xd4?\x83\xc5h,\x99\xed\x98\x90\xc6\x81q\xa3\x8cA\xfc\xf6@\xee!\xdeX\x03\xf7\xa5\"\xfa\xe7\x8a\xcd\xdd\x8a\x1e\xc4\xb7\xb5#\xa4\x84\x8d\xae\x18G\xd0\xb0\x93\xe9\x84\xe7\xa6N\x9b\x86\xef\xb0d\x94\xae\xea\x85\xdcy\xc4\x0d{e\xd0\x90\x1f\xa8\xdc7\x88\xfc\xbb\x1b%1\x82G\xdfx\xb1\xd2\xf3\xe8V\x18\xd3\x17Xo\xe7[\x96\xf5S6\xc9\x83!\x1b*~\xa1\x86\x97v\xf0g\x1d\xeb\x9a=\xb5m\xc4\xce\xf7o=a3\xfe\x18?:\x1c\xb5}=\x91\xd1\xe0l\x0b\x9a7\xcc1S\xf7\x00s\xdc\x9b\x80\xb1\xe1;\x9aA\xdd\xe2\xe4!	Y@\x8a\xe5\xa5r\x0d\xff_\x1dkDM\x93\x8f\x0f\x8d\x8b@\xf9\x1b\xb09\xcak\x0f\xb6!P\xee\x81\xebc\x1b\x9e\xb4p\x03Tom\xba\x8d+\x8dX1\x88\xd3\xff@\x82U\x84\xe8Z5\x91\x8f9\xbc\x0c\xa6\xe8@ ?<\xdb\xe1\x07\xa5\x83q\xc5\x1f\xadE\xd6\xe3\x15\xb2f\xdb\xb8\xf2$\xf9NY\xe1\\\xe2|\xf4\xa1\x11\xd8\xd9s.\x81\xcd\ny\\\x9e\x83\x9b\x01\xb3\x03)\xe6\xdf\x0fx\xf2b6\x1b\xb5=c\x84h\xa9z\x85\x1d\xfc\xcfFS\x84O\xd2o\xbe\xa2\xd2\x13\xc6#\x08s$\x9dv\xcb>\x0bH\xeb\x9e'\xdd\xb6<\xbe\xdd\xb2o\xdc\xf4\x036\x1ah\xf6\x99L%\xaa\xc6\x12\x100\xd8\xde\xb6V]tC/`{q-\x84\\\x12\xb2\xef~\xa3p[\xa3\xeb.\x8eX\xdd\x02\xde.\x9c\xc4\xe7Q\xf4\xa3\xc08Q%\xd8\xb3#\x0f\xd0\xb2\xd7(@\x99k\xac\x0f\xef\xf7\x93\x97\xf2$\xb0&Wv\xda\xf6\x1d\xad	\x18\xe8$\xde\x0f\xcc\xd1\xd3\x01j\x0d\xd1C\xd35\xf6\x87\xf7\"&\x83/;\xd5\xfc\xeb\x8b\xd9\xec\xbf\x16\x90\xad\xe1K\x9c\xe7q\xb6y\x9f\xcd\x92\xaf\xcb\xe4u\x03q\x01\xc9\xd7\xd7<)\x8a\xd5;\x14\xc9\x06\x1e\xd7\xf9\xe6\x19\xd2l\xb2\xf4\x8aFk\xb3pk\xb7\xce \xce\xe0.. -\xee\xe0\x8f\xb8H\x8b\x08\xbe\xa4\x9b\xe7\xf5\xdb\xa6?/M\nX\xe7\xb0\\g\x0fne\x06\xebGZ\xd8\xfdo\x9a=D\x90\xa4\x9b\xe7$\xef\x15\xc1'\xd3\x97\xd7U\x9a<@\x9a-Wo\x0fi\xf6t\x11\xb9J_\xd2M\x8cR\"\x12\xf1\xa3#6\xe9f\x95D\x90\xad\xb3Oi\xf6\x98\xa7\xd9\x93\xbf\x0c\x92\xa7\xe78\xdb\xc4\x7f\xa4\xabt\xf3\x8eo>\xa6\x9b\x0cO\x7f\\\xe7\x10\xc3k\x9co\xd2\xe5\xdb*\xce\xe1\xf5-\x7f]\x17\x89\x9f	]F\x0f\xc2\x8dG\x9a\xf3%\"\x1b\xd7\"T\x1c\xfbY!\xfb\xc1\x01; \xfcj\xc1,'\xe2\xa4j\xe8\xa8,\xd2\x92\xef\x831\x83\xc3*?\x87\xc1\xbeL\x0b\xf3\x0d\x03\xc1\xa8RP\x00\x0e\xe5\xc8O~\x88:\xf9Q\xc2-\x8a\x0ea\x13\xb7\xed\x88.C#Za/k1\x7f\xc8P\xd8U\x8d\x90C\xfap\xad\x15\xf2?\xd7\xbd_\x16\x1bX\xa7DI\x8d\x01V\xf6\x9e\x1eP{\xa3\x893\xa0\x94\x8aY\x16\xf5\xb2\xa8\x90a\x0e\x1c.\xbdp'}\x83\xe1\xd2Z\xb9\xa1\x92\xd6\xdd\xa1\xaf\xb2\x0e\xf4	\x9fg\xb3\xff^ \xf3]\xae\xe2\xf4%\xc9\xd1\xd7\xab\xd4;\xf3_\x88\xed\xccG\xe0\xb0\x02\x86\x0c\xa3 {\xc7xr\xecz\x9d\x17P<\xc7\xab\x15<\xc7\x9f\x13\xfa\xdbp\xa2\x8b\x99\xec\x1d\x1e\xd2<Yn\"H\xb3\xcb\xff\x96\xe9C\x92m\xe2U\x04\xc5k\x82\x8c=\x82\xe4k\xf2\xf2\xba\x8a\xf3\xf7\xc8\x87l\x91\xfc\xfd-\xc96D\xe7\xe3\x97\xf8))\xe0~\x08\xfd\x1b\x91\x0f\xabu\xb1\xc1\x14|L7\xc5<\x82\xe7\xf5\x97\xe4s\x92\xc32~+\x92\x07\x883\x9f\x96\xef\x98\xb5\xeb\xfc}d\x9f\x08\xbe<'t\xdd4s\xf7\x8bQS\xec\"\x96\x9b\xf0\xb1u\x0e\x9bu\xbe	U\xc9\x92\xa7U\xfa\x94\xd0^<\x875J\xf9\x92\x16\xc9\x1c\xe2<-\xf0\x814\xf3I\xf9\x0e\xa8\xb2_\xb7\xbf\x15\xc9?\xda\xc4\xaf\xdd\xe6?\xf9\x8amM\x91\xf4\x00\xe1vVn\x87\x95<\xc0s\x92'\xf4\x99@\x04\xc9\xe7$\x83\xf4\x11\xe2\x87\xcf)^\xba\x97\xb7.\x8a\xb4\xcf\xeaG(\xde\x96\xcf\xbdM\x17\xb3\xd9\xff,\xe0)\xc9\x92<^\xcdfi=\x9e0\xdchY\xa9o9\xb2FT\x18\x8f\x9d\xe4\xd8\xc7\x97<h\x82\xc7\xc1OC\xc7`pO\x03\x18\xca+\x12\xe2\xe3z\x90\xe2#\xdd\xf1G\xcd[&H\xa6\xff\xc5\x87\xeb\"*.\xc2\xeeUg\xa1\xee4\x95\x0fG\x9d{\x9eK\xec\x84\x1bj\x0b\xac\xf2\xad\xc4\xe5\xa2N\xc3-\x9e\x89\x85\xeb\x92\xfd\x88Ym\xd7\xf6\xe4\xb9_\xad\x9e\xfbM\xd1T\x903\x0d\xea\x13XfA\xa6\x0dpR\x1a+,m\x81\x87\xd9\xae\xf5\xe4w\xd4^\xf8\xe9\xf8}\xb0\xd0\x84R+c>9VE\xa3\xb1\x0eA\xc1\xfdL\xdc\xc87\x13sG{\x1d\x86Nv\x04\xbe\x9f\xbfw30|d\xb4>\x99\xf0w\xc24W\x93\x87\x8eY\xe9a\x832\x1f\x18\xb4\xdf\xf6\x84\x03fw\xbf{3\x8f\x1cs\xbaz\xc0\xa3s?\x87w1Tx\xde\xff\xeb\xfdv\xee}\xe3\xea\x07\x92K6(X\xe1\x8f$1\xb0\x9f0P\x8b\x86W\x8b\xd9,n\x9a[g\xdd\xac\x04\xd3S\xdcG\x125\x13\x8dql\xbb\xc5\xde\xc3\xc1\xbb\x1cB\xb4e\x96kd\x1c>4\xaf\xc6\x9d\x93y\x1c}\xc2\xd2\xf7\xd4]O\xec\xf0\x14\xfca:\xf1:p-T\xe5\xc6\xa6-\x07V[\xaea\xcbK\xd5R(8G\xf8\x06B*y)C\xc4b\xd9?q\xfb\xe1\xdeQ\xb8[\x1dz\xe7\x923\xe3>\x1d\x18\xd7\xe7\xeb^\x8f!\x05\xc0(6\xe1\xb4\xe7\xa01\x1b	\x14\x16\xf0\xacN\xd8vG#\xe5\xc2\xee\xef\xa6\x86}\x9fr\xb5\xb9\xd9\x9e\x03\x95\xc3\x1e0T\xcby\x17[&!\xbb~\x0d\xae\x8f\xe2\x88\xb9\x99\x1c\xb9\xa6\xcf\x0c\x04q\xfaVX_\xfei\x087\xba1\xed\x19\x04\xbf	B\xc8\x1fD\xd0=\xb2\xa3\x12\xd8s\x96H\x84\x8c\xe5\xb2tP4\x02\xd3aR\xc7\x1dj`'\xa5ds\xa6\x19\xa3\xaa\\\xab\xe5\xc7u\xb5\xc2\xb6\x10o\xe7\x864\xae'\xbb\x88+,?1]!\xf9\xe2\xba\xffR\xc2\x8d\x01\x91?w\xdbF\x98=H~\x82#\xd7\xae\xd9\x0f\x90Es?V\x9e\xdf\x08\\7\\\xa65\xb1\xa2\x7fi\x87J_\x8a\x10.\xd8=\x93\x93\xcb\xf5\xda\xec\xd9D\x11\xba\xd6y\xba\x91\xa1\xbb\xf4\x1f\xcd=\xaaNVC:\xdb\xe0K\xb0+\xf9\x1f\xbeH3=c\xc4N\xfabr\xbd\xb2\xd3G\xde\xcf4\xaf\xf5F\x0f\x02\x02)\xa5\xe2\xf0\x15\xd2h\x1d\x1a\xd8\xb2O\x84\x8b\xa0\x93p%e'\x8e\xd4pb\x10	\xb9\xeb\x84\xd9\xa3\xbd\xfb\xf7d\xd7n{_\xf6\xe1\x1a\xb8%\x9ct\x9a\xb9\xbbUsbg\x83\xa2\xc3\x0fl\xcc\xf8\xd3\x8a\x0f\xd5r\xd9\xe57\xe4\xee30\xffiME\xd3\x8f~\xa9\x1fy\xbca?\xbe%\xe6\x8c\x8b,^EWC\x01\xdex\x95>X\xa1\x05\x17\xbdZ\x8d\xcf/_l\x85*, \xf9N\x93Zf\xfaETsv\xfb	\xca\x14_;\x0c\xfcz\xcf\xe6\x94STE\xd8V\x1dG\xe86|O$U\xb0O\x0e\x97\xf5.\xeen\x8d\x8c\xfc\x82<\xbc\xed\xed/\x91N{\xee\xd6\xdc\xbd\xa2\x11\xe2\x15-\xb9J\xe6\xac\xcc\x8dU\x87\x03o\xa6\xdbl\xeao\xfa%hh3\xac\x1d\x97\x8b\x8f\x0b\xe7\x8d\xfd\x8fG\x03\xac\x87\x9b+.\xb7SG\xae\xe5e\x1c\x88\xdc\xa1\xf7qA\xe3hUC\xc6O\xf0\xae\xf4\xb7a\xaa}\xdb(\xe1\xbbo\x92Z\xa8\xc2m\x8dT\x0dq\xcb\xb5(\x19\xc1\x86\x9b\\\x92\x81G\xfa\xb8\x84\xd1\x8e\xe6\x84\xf3\xcf\xdb\x97k\x95[SK\xc2\xa13g\xdaG\xac\xa5O=\xfd\xe0\xdcK`Z\xa1U)k\xdd\xf9'F\xde\xc7\xb0\xf3v\xa6\x8c\xff\xb3CrG5\xddO\xb1\xdc\x8c\x01\xb5\xba\xb0\x8c\xc5\xec\xff\x02\x00\x00\xff\xffPK\x07\x08\xee\xaby\xa8\xba\x0f\x00\x00\xe0+\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce9sO\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0d\x00	\x00epl.copyrightUT\x05\x00\x01U\x96\xd3]r\xce/\xa8,\xcaL\xcf(Q\xd0H\xd6Tpp\xa8LM,rpPpp\xc8/\xcfK-rp\xd0\xe3\x02\x04\x00\x00\xff\xffPK\x07\x08U\xe9\x92\xd4(\x00\x00\x00\"\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce9sO\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\n\x00	\x00epl.headerUT\x05\x00\x01U\x96\xd3]L\x8e=N\x041\x0cF\xfb9\x85\x0f\x00\x99\xdd\x96\x8e\x82\x8e\x82\x82\x0bx\x12\x93Xr~d{\x12q{\xc4 \xa1-\xbf\xe2{\xef\xbd\x8a\x80r.n\xa0d\xa4\x93R\x80\xcf\xc2\x06C{V\xac\x80-\x81\x17\x02\x8c\xb1\xd7\x81\xed\x9b[\x86\x8aN\xca(\xb6\xa1\x12TL\x048\x91\x05\x0f!8[\"\xbd>NZ\x0d\xfa\xd75\xde\xa2\xf00\x82\x8f\xf3\x10\x8e\xf0\xce\x91\x9a\x11\xcc{\xb8m\xabp,\xff\n&\x03\xffmHl\xae|\x9c\xce\xbd=]%l\x0f\"\xf4\xad\xb8\x8f\x97}_k\x05\xfa\xe3\x87\xaey\x17\xca(;\x0dy\x9e\xf7[(^e\xfb	\x00\x00\xff\xffPK\x07\x08\x12\xb1=\xf4\xab\x00\x00\x00\xea\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce9sO\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x07\x00	\x00freebsdUT\x05\x00\x01U\x96\xd3]\x94\x92A\x8f\xdb6\x10\x85\xef\xfa\x15\x0f95\x80\xe0\xa2=6'Z\x1a[\x03\xc8\xa4JRv|\xd4Z\xdc\x98\x80-.$z\x83\xfc\xfb\x82\x8c\x17\xeb\xa4@\x8b\x9c4\x109o\xde\xfb\x86\xe2r\xc1\xec\xbf\x9c\xe3\x82\xd9-n~u\xe3\xaa(\xb4\x1b\xfd\x12g\xfft\x8b>L\x18\xa6\x11\xb7\xc5\xc1OX\xc2m>\xb9\xfc\xe7\xc9O\xc3\xfc\x0d\xcfa\xbe.%\xbe\xfaxF\x98\xf37\xdcbq\x0d\xa3\x7f\xf6\xa7!	\x94\x18f\x87\x177_}\x8cn\xc4\xcb\x1c^\xfd\xe8F\xc4\xf3\x10\x11\xcf\x0e\xcf\xe1r	_\xfd\xf4\x05\xa70\x8d>5-\xb9\xe9\xea\xe2_E\xf1\xc7\n?ZZ\x10\x9e\xdf\xbc\x9c\xc2\xe8p\xbd-\x11\xb3\x8b\x83\x9f\xb2\xe0\xf0\x14^\xd3\xd1\xcb\xb7\x9c\x0eS\x88\xfe\xe4J\xc4\xb3_\n\x00\x17\xbf\xc4\xa4\xf18n\x1a\x7f\xf22\xfa\xe5t\x19\xfc\xd5\xcd\xab\xe2\xcf\x7f[\xf0\xd3#\x837\x0b/s\x18o'\xf7_.\x92\x81d\xe4W]\xe0\x1en\x0c\xa7\xdb\xd5M1\xc3Mb\xc34\xfe\x1ef\x84xv3\xaeCt\xb3\x1f.\xcb;\xe7\xbc\x9c\xdc\xf9\x10`U\x14\xb6a\x03\xa36\xf6 4\x81\x0d:\xad\xf6\\S\x8d\xf5\x11\xb6!T\xaa;j\xde6\x16\x8djk\xd2\x06B\xd6\xa8\x94\xb4\x9a\xd7\xbdU\xda\xe0\x830`\xf3!\x1d\x14B\x1eA\x9f;M\xc6@i\xf0\xaek\x99j\x1c\x84\xd6BZ&S\x82e\xd5\xf65\xcbm\x89uo!\x95E\xcb;\xb6T\xc3\xaa2\x0f\xbd\xb7\x15\xefmP\x1b\xecHW\x8d\x90V\xac\xb9e{\xccF6le\x9a\xb5Q\x1a\x02\x9d\xd0\x96\xab\xbe\x15\x1a]\xaf;e\x08BSQ\xb3\xa9Z\xc1;\xaaW`	\xa9@{\x92\x16\xa6\x11m\xfbSJu\x90\xa4\x93\xf5\x1f\"\xae	-\x8buKiP\x0eY\xb3\xa6\xca\xa64\xefU\xc55I+\xda\x12\xa6\xa3\x8aSA\x9fi\xd7\xb5B\x1f\xcb\xbb\xa6\xa1\xbf{\x92\x96E\x8bZ\xec\xc4\x96L\xf1\xdb\xff\x10\xe9\xb4\xaazM\xbbdYm`\xfa\xb5\xb1l{K\xd8*Ug\xce\x86\xf4\x9e+2\x9f\x8aV\x99\x0c\xab7T\xa2\x16V\xe4\xc1\x9dV\x1b\xb6\xe6S\xaa\xd7\xbd\xe1\xcc\x8c\xa5%\xad\xfb\xce\xb2\x92\x1f\xd1\xa8\x03\xedI\xa3\x12\xbd\xa1:/SI\xa4\xa8\xb6!\xa5\x8fI41\xc8\xecK\x1c\x1a\xb2\x0d\xe9\xc43\x93\x12	\x81\xb1\x9a+\xfbxMiX\xa5\xedCFH\xda\xb6\xbc%YQ:UI\xe5\xc0\x86>Bh6\xe9\x02\x7f\x1f{\x10G\xa8>GN+\xea\x0d}/\xd9\x14o\x0f\xb6\xcc\x8b\x04o \xea='\xdb\xf7\xcb\x9d2\x86\xef\xcf$#\xab\x9a;\xeeU\xf1O\x00\x00\x00\xff\xffPK\x07\x08\xc6`\xd3\xd7\xa7\x02\x00\x00\xf1\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce9sO\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x11\x00	\x00freebsd.copyrightUT\x05\x00\x01U\x96\xd3]r\xce/\xa8,\xcaL\xcf(Q\xd0H\xd6Tpp\xa8LM,rp\xd0Qpp\xc8/\xcfK-rp\xe0\x02\x04\x00\x00\xff\xffPK\x07\x08OAG\x98(\x00\x00\x00\"\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce9sO\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0e\x00	\x00freebsd.headerUT\x05\x00\x01U\x96\xd3]\x94\x92A\x8f\xdb6\x10\x85\xef\xfa\x15\x0f95\x80\xe0\xa2=6'Z\x1a[\x03\xc8\xa4JRv|\xd4Z\xdc\x98\x80-.$z\x83\xfc\xfb\x82\x8c\x17\xeb\xa4@\x8b\x9c4\x109o\xde\xfb\x86\xe2r\xc1\xec\xbf\x9c\xe3\x82\xd9-n~u\xe3\xaa(\xb4\x1b\xfd\x12g\xfft\x8b>L\x18\xa6\x11\xb7\xc5\xc1OX\xc2m>\xb9\xfc\xe7\xc9O\xc3\xfc\x0d\xcfa\xbe.%\xbe\xfaxF\x98\xf37\xdcbq\x0d\xa3\x7f\xf6\xa7!	\x94\x18f\x87\x177_}\x8cn\xc4\xcb\x1c^\xfd\xe8F\xc4\xf3\x10\x11\xcf\x0e\xcf\xe1r	_\xfd\xf4\x05\xa70\x8d>5-\xb9\xe9\xea\xe2_E\xf1\xc7\n?ZZ\x10\x9e\xdf\xbc\x9c\xc2\xe8p\xbd-\x11\xb3\x8b\x83\x9f\xb2\xe0\xf0\x14^\xd3\xd1\xcb\xb7\x9c\x0eS\x88\xfe\xe4J\xc4\xb3_\n\x00\x17\xbf\xc4\xa4\xf18n\x1a\x7f\xf22\xfa\xe5t\x19\xfc\xd5\xcd\xab\xe2\xcf\x7f[\xf0\xd3#\x837\x0b/s\x18o'\xf7_.\x92\x81d\xe4W]\xe0\x1en\x0c\xa7\xdb\xd5M1\xc3Mb\xc34\xfe\x1ef\x84xv3\xaeCt\xb3\x1f.\xcb;\xe7\xbc\x9c\xdc\xf9\x10`U\x14\xb6a\x03\xa36\xf6 4\x81\x0d:\xad\xf6\\S\x8d\xf5\x11\xb6!T\xaa;j\xde6\x16\x8djk\xd2\x06B\xd6\xa8\x94\xb4\x9a\xd7\xbdU\xda\xe0\x830`\xf3!\x1d\x14B\x1eA\x9f;M\xc6@i\xf0\xaek\x99j\x1c\x84\xd6BZ&S\x82e\xd5\xf65\xcbm\x89uo!\x95E\xcb;\xb6T\xc3\xaa2\x0f\xbd\xb7\x15\xefmP\x1b\xecHW\x8d\x90V\xac\xb9e{\xccF6le\x9a\xb5Q\x1a\x02\x9d\xd0\x96\xab\xbe\x15\x1a]\xaf;e\x08BSQ\xb3\xa9Z\xc1;\xaaW`	\xa9@{\x92\x16\xa6\x11m\xfbSJu\x90\xa4\x93\xf5\x1f\"\xae	-\x8buKiP\x0eY\xb3\xa6\xca\xa64\xefU\xc55I+\xda\x12\xa6\xa3\x8aSA\x9fi\xd7\xb5B\x1f\xcb\xbb\xa6\xa1\xbf{\x92\x96E\x8bZ\xec\xc4\x96L\xf1\xdb\xff\x10\xe9\xb4\xaazM\xbbdYm`\xfa\xb5\xb1l{K\xd8*Ug\xce\x86\xf4\x9e+2\x9f\x8aV\x99\x0c\xab7T\xa2\x16V\xe4\xc1\x9dV\x1b\xb6\xe6S\xaa\xd7\xbd\xe1\xcc\x8c\xa5%\xad\xfb\xce\xb2\x92\x1f\xd1\xa8\x03\xedI\xa3\x12\xbd\xa1:/SI\xa4\xa8\xb6!\xa5\x8fI41\xc8\xecK\x1c\x1a\xb2\x0d\xe9\xc43\x93\x12	\x81\xb1\x9a+\xfbxMiX\xa5\xedCFH\xda\xb6\xbc%YQ:UI\xe5\xc0\x86>Bh6\xe9\x02\x7f\x1f{\x10G\xa8>GN+\xea\x0d}/\xd9\x14o\x0f\xb6\xcc\x8b\x04o \xea='\xdb\xf7\xcb\x9d2\x86\xef\xcf$#\xab\x9a;\xeeUQ\xfc\x13\x00\x00\xff\xffPK\x07\x08v\xb2\x07@\xa7\x02\x00\x00\xf2\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce9sO\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x04\x00	\x00gpl2UT\x05\x00\x01U\x96\xd3]\xa4|]s\x1b\xb9\xd1\xee=~E\x97o,U\x8d\xb9\xeb\xcdINv\x9dJ\x99\x92F\x16\x13\x99TH\xca\x8e\xee\x02\xce\x80$\xe2\x19`\x02`D\xf3\xdf\x9f\xean`>(\xca\x9b\xf3\xbe[\x95\xaa\xd8\x1c4\x1a\xfd\xf9t\xa3a8\xf3\xdf\xa7\xf9#|\xca\xe7\xf9rz\x0f\x0f\x8fW\xf7\xb3k\xb8\x9f]\xe7\xf3U.\xce}\x0e\x00_\x94\xf3\xda\x1a\xf8%\x83\xbf\xb5F\xc1\xfb_\x7f}/\x04\\\xdb\xe6\xe8\xf4n\x1f\xe0\xe2\xfa\x12\xde\xff\xfa\xe7_3\xfa	n\x9dR\xb0\xb2\xdbp\x90N\xc1\xadmM)\x83\xb6&\x83\x99)&\x99\x80?\xe27\xd2|\xab\xb4\x81UpJ\x85\x0cn\xf56\xec\xe1\xb6\xb2\xd6epe}\xc0\xef?O\xe1\xe7_\xde\xbf\xff\xf9\xdd\xfb?\xfc\xfc\x1e\x1eWS\x01\xf9\xb3rGk\x14h\x0f\x8dr\xb5\x0eA\x95\x10,\x14\xb69\x824%\x94\xda\x07\xa77mP\xf0\xac\xdcF\x06]\xe3\x8fZy\x01v\x0ba\xaf=T\xbaP\xc6+(m\xd1\xd6\xca\x84\x0c6m\x80b/\xcdN\x9b\x1d\xe8\x80\xe4\x8d\x0d \xab\xca\x1eT9\x11\xaf	\x87\xfe{pJ\xd6\x9bJ\xe1W\xeb\xbdJ\xe4=l\xad\x83\xda\xfa\x00>\x89\x03\xffW*\xafw\x86\xd9\x0e\xf2\x9b\x02y\x90G8\xda\xd6\x89\xadS\xaa\xb45\xfe\xe2\xf7\xf4\xbd)\x99/\x05:L\x00\xae\x8ePX\x13\x9c\xf4!\x83\xb0W\xacOe\x94\x93\x15<\xb4\x9bJ\x17\xe2>\x9eN{\xd0&(S\xf2V\xbbV:i\x82R\xb4\x15\xfch+\xfcM$\x9e\xdf\xbd\x0b\x16j\xe4\xd3\xb7N\xd1\xa6\xddq\xb4\xa7o\xe9\xa0\xb2\xaa@\x07\x0f\xadW\xceOP\x12\xda\x8b1k\x90X\x93MSi\xe5qs\x92\x0f)F\x8dMG\xf4\xa6\xf3\xd6\x0f$h\xe84\xd2\x1c\xc1\x86\xbdr\xd08\xbbs\xb2\x86\xc3\xde\"\xe56\xec\xad\xf3P\xd8\xba\xd6\x01\x82\x15\xadg\x9dN\x00.V\xb6Vq\xd9kf::\\a\x9f\x95S%l\x8e\"	\xfb^y\xaf\x1c\xbcr0m|P\xb2\x9c\\\x02<\xd9\x16\ni\xe8\xacG`^H\xf2\x91a\x9fA\xb0\x96L\xeb\xeb^\x198(\xf0\x8d\x92\xdfP\x18$\xd4\xc4H\x86?!CNm\x95sx\x9a`\x93\xfe2\xb4S\xd18]\xa8	\xc0\xa2}\x8d3\xff\xc2\xf4\x86*\x95\x01\x8dB\xec\xe53+x`\x1c\x03\x7fb7z\xc1\x1f\\D\xd3q;\xb2\x04A>\xe6\x95{\xd6\x85\x02\xbdE\xd2p\xd0~\x7f\x99u[\x81S\x85\xd2\xcfH\xa4u\x05\x92.\x15XG\x02\xdb\xa9\x00:\x88\xb4P\x1a\xfc\xe3`)~\x13\x0dud\x8c\xd6\xa1\xedA\xa3U\xc1\\\"\x11\x03F\x1d\x98\xdf$\xf7\x0flC\x89\xdc7c\x0f\x1d\xdd\xd2\"M\x8f\x94\xb5\xd9y\xd2\xce\xda\xe2\xd2\xa0\x8a\xc0\x9eCQ\xcf\x93V\x8c\x1a\xc8\xd2)\x94T\x81F\xe4\x99\xfc\xd6\xba\x8d.\x854\x14\xb2P\x98\xca\x90\xa7\xc7M\x98\x122\x8e\x16\xed\xbf\xf1O\x16\xb5\xe2\xd0o\x1d\x1d\x90\xbf\x9a\x885\xaf\x19\xed\xe2\xa4\xf1\x95\x0cD\xbcP.Hm\xf0\x8b\xc6\x1a\xaf7\xba\xd2A\xc70\x84\x94Y\xa2\xe2\xacF\x87\x92\xcc\x90\xa3(\xfe\xda\x96z\x8b\xe6K\xa2\xb8\xb5\x0e\xd4wY7\x95\xca\xd2\x17g\xc9\xf9\xb6\xd8\x83L\"\xcf\xe0\xb0W\xe8ub\xe7d\xd0tb\n\x19\xb0U*\xe3}Z\x1f`\xa7\xa3\xfd9U\xe8F+\x13<\x85\x95^\n$Wt#@[\x9d\xb0\x97\xd1\xda\x13s\x0e{u$\x07\xcb:S\x1b\x98W\xd8c|\xeb,o\x0205e\xcf\x87\xdf\xdb\x03~S'cP\xae\xc6\x10DT\xd9`\xc2^i'\x92j\xd0\x87\xd59+\x81\x83\x0e{\x08\x07\x0b>\xa8\xc6\xff\x06\x17\xef/)Wq\xea\x1cK]\x9aR\\\xfcr	v\xbbU.\x9a\xc9 [\x1d\xf6\xba\xd8\x93\x8c<\xfdX\xa9\x9d\xac8\x0bz\xca\xd01\x0dfC\x0dKS\xfeDY\x88\xd48\xdc\x8f\xb8\x9eV\xdef\xa4\x0b%Qc\x14=\xdf\xfat\x14\xa4\x8a\xceb[\xc7\x06O\xde\x98\x0c>\x1a\x9c \x81\xab\x94\x99[4\\\x1f\xa4)}\xa7\n\x8e\xa6\xc6\xc2A:LBG\xda\x92N7\xca5\x13\x80\xd9\xf6E\x8a!\xe65\x85a\xf0\xb6V\xb8\x8b\xaa<\xe7\x82Fz\xafJ@\xbcpP\"F\x0b?\xb4\xa0`\x93\xcad\x80C2\x0e2\xa0\x94\xe7qG\xeb\xf4N\x1bYe\xe0-\x1f	sL\xe3\xec\xa6R5\xa5Rg\xcb\xb6`6(\x87\xa0v\xab\x8a\x088\xb5\xadP\xf5\xa8\x85\x01-\x11\xd3\xd1[p\xaai\x03%\x186\x97[\xfc\xb9:f\xb4\xc90<!Ka\xef\x94\x0c\n\x03ua\x0d\xca2TG>}\xcc\x8d\x0d\xfe\x1c0\xcd~U\x14[)\x82<[]\xd2\xfe%FG\xc7'v\xaa3\x07L\x8cv\x8bn\x87B\xef\x12'\x1eB\x9bR?\xeb\xb2E\xa6\xc0n(\x90\xf0&\x1d\x9c\xc9@\x1bP\xdb-\x9e\xb3\x96\xdf(\x0f\xed{2\x8d\xb3\x8d\xd3*Hw\x9c\xc4\xa0\xa9\x9eq=\xaa\x99\x8c\x87$^\xcb\x12\xb1\x0c\x14\x95\x92\x91Ci\x8e\xf1@\xec~\x9b\x0eB\x95l\x9a\xd1\xb4\xdeF\xb4\x81Q\xde:\x92{\xf7\x9d$\xb06I\x10\xacA\xfdw\x9eK\xf9\xc9\x9aRs\xd4D\x9a\xe8(\xda\xec\xb2>|E[\x17lm\x05\x83\x81\xadE\x04\xf8\n\xfe\xfb1\x96^\xe7\xcb\xcf+\x98\xceo\xe0z1\xbf\x99\xadg\x8b\xf9\nn\x17K\xb8^<<\xcd\xe6\x9f2\xb8\x99\xad\xd6\xcb\xd9\xd5#\xfeD\x1f~^\xdc\xccng\xd7S\xfc\x0b\xdc\xf2\xe7	\xa1\xa8s\xb0)\xda&I\xde\xba\x88i\x0e\xd6}\x8ba\x02Q\xa2\xd4\xc6\x0b\x89r\xc2D\xdcT2\x1a/ZH\x1f\x83\xf6\xb6\xc2L\xe3\xe51b\xdfZ\x1eQ\x05}\x10)E\xdb%#\x16h\x02\xd2\xe7\xb1\xc6\x84u\xf0\xe6\x81\xf9{\x93\xc1FU\xf6\x90	\x020\x1d\xfb\x94#\x06g@\xee)\x08\x82\x847t\x94\x8dd\xd7\xa6\x9d\x135Q+i<(MG\x1e\xfc\x824\x90n\xa9\x9c~\x96\x01C>Qa\xe6\xfb\x03W\xf2\xf0\x1b;\xb8&^\xbcD7\xe4o\xa3\xd8\xa2m\x8f(Cc\x1d\xd9\x04!\x8bLD\x06\xba\"\x03O\x80\xc1~h?>\xc5\xdf.Q\x97\x18H\xf0\xfc\xa41QI\xb3k\xe5\x0eEvq\xa7\x9c\xd2Fn\x83rY\xb7\x007$ _T-\x02y\xdc\xc2\xb6h\xf8\xb5\x0e\xf1g#\x92f\xe0\xcdp\xf77\x08Cs\x8c\xeb\xd1M(\xde\xc9\xb2t\x8ab\xa6\xf4\xf0\xe6h\xdb7\x13!\xa6E\xd0\xcf\x8c\x16l\x94+\xa2\xac\xd7\x9cdtHB\x96\x88B{\xb4\xcc\xd6\x11\xcd\xe1\x03\xc7[\x82hm\xf0\x9a\xfc\xdf\x83/l\x93LEb\xe8\xdc\n\xd7\x9a\x17\xa2\x8f\x11:\xc1\x1eUf\x11\xbe\x11\xb5\xa6\x0d\xb0u\xb6\x1e.\x11\x03\xe0n\x0db\xef-m\x88\xba\xa5\x84@1U\x07J\x8f\xf0\xc2\xd0D\xda\xf9B\x9bR5\x88\xc3\x0cU({\xf9\x8c\xccm\x942\x1c\xc56G8\xc3\xf1\xe5D|e\xb4\x03\x9d\x91\xb9\x16\xb17\xd2\xf2\xb8KJB\xdd!K\xab8-\xbc\x9f0\xa2\x91\xc7\xff\xa6\xa2M\xc0-\x92y\xeb\x87\xa0\x06\xd5;D\xda\x88\xa1\xb5!\x0f\xa9U\xa9\xdb:C\xe7{\xd6T\x1e\xa6\n\x00E\xd3\xe8\xa2\xb5\xad\xafxw\xd9p`\x97AUGh\xd0\xd1\xfd\x1e\x0fA\x80!29\xfcJ\xf4\x9e\x16#O<DQI]+\x87L'\x18\xf0\x01\xbe)\xd5\xa0K\xa0\x05D\xa8'x\x99O\xe9\x0b\xc1\x10\x96\xca\xa3H\xc8U \x1e^n\xbc2\x85\xa2\xc4f\x8e=i\x81\xdf\x10\xa2\xeck\xc5\x01*\x18\x8b\x0e$\x1f%\x05\xb6\xb8\x8f\x90\x955\xbb\x08\xe2\xfa\xaf'BtZ\xe2\xb2\x87\x90l\x045\n\x9a\xfd\xd1\xebBV\xd1\xae\xd9\x99S\xed\xc6;1\xda;F*2\x82F\xdb\xc4\x08\x83g\xee\xb0\xd2\x00\x8ca\x06\xfe\x9e\xaa\xf4\x84\xa0\xc9r~\xe9-'\x82=\xa2\xc8\xa7r\xe7\x0d&E\xcc\x18\xd9\x04G6\x08\xfb\x96\x92d\xcd\xec\xbe\x1a\x8a\xb3\x98X\xd9N\x87\xa8\x93B\xfb8\x10\xc6\x00\x0fgR\xc9*\x1e\xee\xbd\x90\x1b\xfb\xac\xce\xd8%\xc8\xca[\xa8\x95b#\xe1Sx5H\xea\xbfq\x8a\x96\x97}EP\xc8\xd6s9\xd1\x01\xc8\xad\xae8}\x16\xd29\x12l\xad\x0d\xbaw49\xa2\xe11\xae\x92O\xa7\x82\x93\xe4\xcd1\x87)\xa4\x08Tb\xe9\x15\x0d\x8f\xbf\x8aPa\xf3\x82\x0f\xb2M\x14@Gv /\xeb\x92g\xc5:W\x1b\"s\xd8\xdb\x8a~%4\xe6B\x97\xd6\xe9\xef<\xa7:<\xd7I\x08\x8c\x8a%\x1a\xb4\x8e0\xb8\xddbE4\x82W\xd2\xa3\x82i\x17\x89RH\xf6\x8c)\x8a\xbcQ\xbb\xb2\xa3\x82\x06\xf4\x1a\x12H\xa9\x9f\x8f_\\&\x1c\xdf\x89>%zc]M \xd3)Yr\x9f\x86J\x05m\x82r\x12\xd3\x90\xaa\x8e\xf1\xf0\xca`\x80\x1d\x14\x88,J\xb4Q\xfa\xd1\x07\xe90\xa5\xa6(\x8c\x1e\x81\xa6G\xcb\x07\x04	1j\x13\x19\xf2\x01\xac+\xb5\x91\x0e\xa3\x05U\x89\xd08\x8dA\xde\xa1R\x9a\n=\x92\x15 \x8d\xb1\xad)T\x8dF\xc2I\x98\x9cb\x14\xf1\xe0l\xc4\x93D \xfe\xc5\xeb\x85\xd0\x05\x02\xdc\xca\xab,!\xb0\xce>\xa2\x170\x1f\xdd\x82\xcb\xbe{A\x9d6\xf2\xf8\x01\xc6g\x8bO\xd2&u\x11\x85S\x87\x89iTUU\xca_H\x0e\xa8\xf2\xb5\xf0\xac\xd5\xe1$&\x12\x95\x1e\xe1]\xe4\xdf\x0bE\xe1\xea7L\xb0\xa3\x94\x1d\xbc\xaa\xb6\xa9\xff\x98t\xb0i\x03\x91\xc0\\G)\xbd\xb3\x04\x16>\xb7\x0c\xccH\xe4\x19\x07\xb1Q\x04J\xa7y\x89\x10\xfe\xd3j\xc7\xfd\x18\xa6xBlr)\xba\x1e\n}Zs\x83\x81\xfas1\x99t\xe6J[\xf6\xdeA\x85\xa9\xd0\x08\x05\xf4V\xcbM\xa5\xc0\xab\xd8\x84!\xf9`iIK\x18\x0b\xbd\xea\x99\x19\xa5\xa5B\x1atC\xa7\xa4\xb7Fn*\xea\xea\"2r\x04\x10{\xd8\x81\x1f{\xd5H\x87f\x86\x1b\xf8\x08\xf7j\xaf\xaag\xac\xc9\x02:\xc2\xd0\x05Y\xb1\x08x\xc8C3(-\xf7\xb2\xfbsZ\xafD\xc7>y\xd2I<\xa2\xde\x87\xf4'[O\x00\xae\xda\xd0-\x10'6\xe7e=\x90\x8a\xf4\x1cy\xa8\xde\xe4\x08\xc3\x95\x89\xf6\xa3\x9c\"Ns\n\xc5\xd5!\xde\x8c9\x8bi\xa4\x021\xaeJAH\x8c%\xc0\xbd\xe0\xbe5\xc25\x1fc\x80\x84\x85=\xa8\xefAu8B\xa0j]\xdc&a\xcc\x96\x92\x05\xb7FL\xc9\x85(\x1f\xcb\xa9\x9dte\xa5<\xe9\xff\xb0\xb7p\xc0,\xcd\x8d\xb2\xf5\xbe\xc5j9\x0c\xdb\x0b\xd4\x8a\x0f]\xbc\x8cr\xa2\\\x84\xb8h\xd0\x0b$\x9c\xea\x83\x18\xb6\x91\x82\x8d\xc5\x9d\xd3!(\x03\xcc,7\x05\x8e\xb6\xfd\x00N\xe2\xe1\xb2\xe1VT\xdd\x08\xf5]9.\x85S\x13\x8d\xfbD&8[\x9d\x15\xf6\xa0~\xb2N\x14\xb6\xaaT\xd1US\xfe,\x12\x98\x0813XYh\xbe\xe9\xa91\xd0\xc9\xdd\x0e\xa5\x94\xc8\xc6\x92\x87\xcf\x81R9GH\x9cB-\x8a\x8f\xf4\x97?\x00\"\x97\xf8g	\xcf\xb6jk\xcc\xc7B\x82\x0f\xd6\xc9\x9d\x8a!\xbd?\x1fC\xdf>\x08m\\\n\x7f\x03\xee8j\x92Mc\x91r6\xc9\xfd\xe1\xc7H\xfd\xf4\x08\xa7\xdcc\x05\xc9\xb94\xa1\x9f_.1E\xd9\xcd\xbfU\x11\xba~\xb8\xfa\xae\x8a6P\xbcA@v&\xfd\x8aU\xf2\xb8\xf7\xc4\xc3/@ \xea5\x0cUZ\xb0F%\x9f\xe2\xf6\x866\xbb\x1e>M\x8b\xc2\xd6\x0d\xa2\x15\x1dz\xe0\x8b\x7fW)Ju\x8e\xfb\xcb\x94\x07kY\xec\xb5Q\xef0\x97#\x93\x8c\x9f\xfa\x1a$\x8b>\x9f\xbcv\xd0S\xf8\x01\x10\xe4T3>\x0e)8*\xafh}\xb0\xb5t\xba:b\xd2\xe2&Q\xdf0\xc4\x9c\xc3`\xec\x03X\x97u\x80\xec\xe5\xc9d\xe7O\x84\xb83x\x96\x95fr2@\xa5\xa4\x0f\xd4\x8b\xe3s\x1d\x95tti\xd3W\x15\x84\x8f( \x1c\xb3\x88\xc7#\x802\x16j\xcb\xcdh\n\x96\x9c\x84\x8bx\xd9\x95\n\x04L~\xca%\xa8\x1d\x057\xb4\xd7\x8c\x920\xcb\x9e(\x9cJ|\x90\xa2O\x953\xd2\x03\xe1>\xce\xbf\xff\x9d\x0e^\x97?\x9f\xe4\x7f\xa0\x83\xe25\xeb\xd2\x06E\xc0\x91bP\xb2\x12<\x8d\x89\x99\x14\xc4\xa9\xff\xe4N\xea\x95##D\xa1\xe6\x99\xac\x82r\x86\xe3YD1\xf1Z\x97\xbb\x03[j%\x1a\x04\xa2\x18)e\xf5\xb2\xdb\x91\xba\x08\x98\xf4p}\xc7\xdf\x10j\xfd\xbe\xf3\xd2y;|*;\xab\xc3\xaa\xbc(\xac\xe3\xee\x0e\xac\xdaM\xca\x0e\x1b\x96~D.\xa3\xcb\xb2m\x1fT\xb8!\xc6\xbc\xd0\x15!\xab\xa3\xee2'~\xb4\xb5N\xc4\xae\xed\xb80\x0b\x96/Go\xa9f\x182\xcd\x0d\xb9\xce\xf5ywA\xbb\xf3\x96\xe9n\xe6\x05_U\x85\x9b\xb4X*\xe9\xbeh\xc9\xa0\xa9ZO\x85\x89\xf4\xde\x16:\xf5\xc3\x94\xdbJ4|\xb5\xd5\x862\x08\x97Y\xf1{\x8e\xc3N7|\xb9\x8c	[\xa4\xfc\x85\xcc\xe9\xd8&#\xd8c|\x90U%\x87\xc0\xa1?\xd1\x04\xe0\xce\x1e0\x8bg\x84\xed\x84o\x14i\\%,\x9b\xbd8\xcf\xd0]\xe8\xba\x0f\xb3Fl\xc7	i\x8etQ\xd8uz:L;\\v\x81U;w\x0b#e\xeb`C\x05\x88@=]\xf6\x9eP\xcb\x7f\x13\x02\xa8\x1bk\x08\x9d^\xf0	\x91\xe3o\xca\x19U14\xf1\x18\xc6/\xe3	\x85m\x94\xe3\x9a\xd5\x1f}P5\xf7\x980\xf0\x8e\xcf\x8f\x85\x92\xcf\xa05\x84[\x88\xe7n+\x11Q\xbb\x8c\x1eJ}\xe6\xb1\xf4\x84\x98m_\xa0\x85\x01u\x84X\x03\x0f\xd0\xbek\x93\x91\xa1k\xb3\x13\xb2(h\xeb8\xb0A\xe8X\xc6ki\xb2\x06\xeaRGT\x9bV\x01\xc2\xf5gY!\x97'\x04^X_\x82\xdb\x04F\x89\x18\x14\xb6%\x9c\xef\xc59X9\x8a\x92\xea\x99\xc0\xb4mw\xfbAl\xd7\xf1\xf6\x9c{\x9cu\xa3\xaaj0t2 r\xd2-\x1a\x08\x83 \xc3\xff\xe9!\x03\x1a\x11\xb7\x81\xb8Y\x93\x81\xa7\x16:\xc3\xd7!h\x19A	\xc1\x86\x8a\xc6\xab\xbe7NyO\xe5S\xcc\xf4)\x9a\x0f\x90\n\xc0\xd4\x1cA\x86\xa0\xea&\x08\x828\x07\x02\x83\xf6\xd5\xed_\xdf\x1d\xc3\xe7\xb3\xd5\xb1\x03K\xd7F\xb2\xc5,\x10b.\xc3$\xa2Q\x8f\xa3+\xd03l\x89\xce\x0d\x93|\x11A\xd3\xfdP\x17[\xb9cE\xc2H7\xee\xa4]L\x10	\xa0\x0d:\x82\xddU\\\x1ab\xd0\xae\x1f\xc4\xe9\x18#\xcf!-au\x83\xa181\xe0T-55\xd8\xb6m\xc5\x81\xa5\xd2\xd2\x14\xac\xba?\xb2\xeaRu7\xac5\xd1\"\x9bpR\x82ym\n\x92\x02\xb3\x83\x96\x13'/(\xd6v\xc7GLL\x16^y\x05;,\xf0\xb9i;\xbe\xd5\x8d\x0d=\xebNK\xaeA\xbb\x07\x0b\xbe\x93\x9b\x0f\x9e\xc2\xc1\x82W\xa6\xa2\xcc\xd1}\xdd^ot\xe0F}%\x0f\xddE~\xac\x13_\x9e\x87\xe98\xb5\xb5Ne\xb09\xf2\x1d\x19u+F\xf8\xfa\xa4u\x7f\x11\xdb\x8b\xaf\xb6\xd8/\xb9\xb5\xa3M\x89\xd9(Z\x0d\xef/cKw\xa4\xe3@\xf8\xd5[n\x9e\xa6\x81\xa3\xff\x9f;>\xe6\xb8c_\x9c\x08\xf1\xa4\xc2\x89S\x0f\x7f\x9a\xf0-J\xd0\xb5\x8a\xf8\xe4GH\xffwN\x1c\x86\xf3\x0d'\x0e\x14\x8d\x1f+\xe4\xe4\x8d)\xa2\x89t\xa7\x1c\x7f\xe1\xa1\x11v\xe2q'qp\xd7\x9f\xf8\xf2-\x87\xa2`\xc5p\xa2a,\xb34M\x11\xc3\x93\xae\x1b\x1b\xfb\x96\xdb\xd6\xd1m\xd5h\xf6$\x96`}K\xfd-t\xb5f\x8c\xad1\x00\x90]\xab\x12\xf6t\xc15\x11cO\x8a\xc3*\x0c\x92@!\x14,PO\xbd\x07\xc6\x0b\xa5A4\xa6s\x9c\x14d\xffw\x02\xb3-\xe7u\xea\xa6\xa8\xff\xb4\xdd\xbd\x00\xe6\x00\x17\xe0\xdfm\xb9\xa3N\x1ec\x94Aq\xca\xd7\xcfB\x9b-&\x1c\x95>\xdaF}\xa6\xdb\x03\xe9\xad\x81\x0b\xbex\xaeu\x1c=\x8cW\xd7\xda\xfbV\xf9\xcbL\x0c\xac\x90\xb00\xc9\x91\x0c\x01m\xe7\"\x8e\xc2\xe0\xa1\x98+\xebJ\x02$;\xa7\xd2\xc6}\xa4\xbeLi\xda\x04'K]p3a\xb0\xc5\x89\x8f\x90}\x1d\xa3/c\xbah=\xdbl\x97\x19__\xcb\xd3\x17q\x14\n\x97\x0f;\xfa6\x82q/\x83\xf6\xdb#x]\xb7U\x90F\xf1U\x11__l*\xbd\x8b\xb0\xb2\x8f\xfabxi3\x98\xdbS.p\xf3}\xb0,f\xfe\x17JD\xe4\x9d\x0c\xf3\x15\xdf\x8b\x13\x00/\x87\x94d\xd2n7Hc\xdb\x8aq\x1c\x8f\x90\x82\xb3GY\x85\xe3;\x9a.\x188\xf7\x00&\xa4]6G\xc1\xa8\xd7\xd2D\x8e\xed\xae\xd7\xe2\x05K\xa9\x9d*Bu\xe4\xa6}\xf7\xa7\xb0w\x04*\x8e\xb6\xe5#R\xe4\xa1\xba\"\x0e\x7f\xa21Te'\xde\x8d\x0d{\xc4\xce\xdc\x87\x1a\xe69\xfal\x83\xc1P8\xb5u\x98\xb4\xban\x10)\xf9\x07\xec3\x84\x1b\\\xf9\xbc\xe8Gi\x0f{U!\x90\xe6Z\xd8:h\x0d;\xa5\"\x90\xc7\xa9\x97H\xa03\x16m%\x1d\x14\xda\x15m\xed)js\x84\xdb\xc8\xaa\x0f\xe1jH~0\x93*\xb8'\x99nS\xd2G\x83K\x89\x93\x19\xd68Ki\xd8\x84\xc4p[\x8fg\x1bu\xdc\x9a\xd6Q\x04;\xd3r\xd3\xa6lc~\xa6?\xb1\xd7\x0f\x06Q|?T\xd18\x84\xd7\xe1\x18\x9bg\xd4\xadK3{\xb1U\xc7}\x03\x1d\x8e\xf1.HP/\x9b\xbf\xfc0\xde|/cA\x83\xa7\x1bp\x98\xee\xf8\xe2P\x0d\x1ez\xe7\"\xc5\x10'2\xfb\xfaz\xa4b\xc6\xfcY\xd7^\x15\x1aM\x1f#	\xa7\xf8\x86\x873\x92\xf57\xd4\x90G\x81\x01|&=*\xdbT\x83\xe9\x1c\xb1SF9\xdb\xf2\x8dy\xda\xa6\xab\xc4\x0f\xbaT\xe0\xe8\x06\xd2n\xcf\xb0\xa4J\x91\xac\x9dBW,Ih01\xc6sk\xb8\xdf\xed)p\xd2TK1(\xd9d\x10\xbc\xe8C\xec\xa1\xb6M\xda\x9c\xe7\xa9~*\xada\x05\x94\xaa\xa0y\x82-\xd0\xd4\x15\xf8=\xd9\x0c\x82AJ\xef\xa3^A\xc7k\xe2\xaf\x0fF\x91I\x1e>\xe9\xa6%b\x18\x8c\x99\x90\x03\xf1\xdej\xc2\x84\xeb\x13\xaf\x19\x9a)M\xc7!\xa3\xb8Ku\x8c\xb3N\x87X#nT\xa5\xd53;\xc0F\xbd\xccV\x9cU}8\xdbv\xfc\xf3$\xdd\xac\x9dv)~\x8a\xf3\xaf'\x01K\xfb\xc1\xec\x04h#\xd2\x98(\x95E\x0ecV\xacM\xd1Tz\xe3\xdf\x1c\xfbk\xada\x95\xce!\xbaG#/\x06\x890(R\xe1\xe5G|\xbc\xac\x02(\xa0\xcb\xb2\xe4\xae\x03\xda\x80\x0e\xb0S\xf8y\xb3\xa7\xeb\xf3\xd1\x11\x07\x13/\xea{\xbc\x88\x13\x1c\x87\xbb\xa3d<\xa4)\xc3x\xe9\xe8\xb1\x007s\x0ca\x80\xda\x9a\x9d\xe8\x05\xc1\x91\xa3\xf5q\x03UbF4|3UHN\xae\x83P\xacMa]c\x9d\x0c\xcaS<\x1f\xb0(=\x1aej/\xc6\xbb\xc7\x8d-_\x8c\x18\x90V\x7f\x9d\xd0\x18\xcc\xab3\xe9(\xa94z\xe1\xd4\xb3\xa6\xab[V\xb9Q\x07x\xe6'\x1a^D\xdd\xbf2\x9c\xce\x10\x00A,z\x93\xae1\xe1\xaf\xf0lC\x1a\xe4;h\x97^\xd7\x1ac\xbb6\xe0\x1b\xedh\x80\x9d\xe3\xaaS\x1e\xfd6\xae\xe0\xc7\x13\xc8a\xa9inA\x1b(U\x90\xba\xa2\x08\xcf\xd3F\xb4E7K\xc9\x97\x1c\x85r4\x0cI\xd8:\x12CU\xed4V\xe1\x92T\xa8\xcd\xae\xd5\x9eJ\xa4\xf4\x85i\xeb\x8dr\xfd\xa4h*\x8d\xa9\x97\xb3\xa5Z\xfd\xe4\xdb\x17u\x04G\xca\xc14]L\xb4o0vW2\xf0X\x17Rx\x93\xf5E\x1ce\xec4\xa0\xd1\xb7\xce\xe9\xef\xcf\xe1\xe94!\x96\xee\x07\x13S\xd6\xc54\x01\xa3\xad\x92\x82\xfb\x19=4\x07q\xc6\x1c^\x9c\xbd\xbf\xce`!\x1c\xcf\x89\xe0\xe4\x8a\xec\xd8\x0d\xb0\xd8\x04\xf3\xd3\x12,M\xcfss\xeeq\x06\xcf-\xfd<I\xd81M\xa3\x0e\xbc\x83\xa0\xc2\x8b\xe1\x13\x1a\x84\xe3\xf0;\x9cG\xf5\xf1\xf6n\xe4\xc1'\x98\x9a-\x8d.\x88\xd1\xc5\xd48=\x888M\x8f\xe8\xbd/\xa4#2\xec\x92@w\x1b9\x0cs\xbf#\xf9\x93\xed^\xf3\xd7\x0f\xf4\x98\xc3\xd6\n\x9d\xcc\x0bJ\x07]\x8b\xd1w\xb3\xcf\xf1\xc1\x06\xe60\x92;\xb506\nv-\xb5s\"/\xe1`ageE\xdeM\xbe\xe7\x9e\x93\xd91*\x082\xb4<\xd8[U\x83\x1e\x00\xfdUz\xea3z@\xc3\x94lm\xbb\x92\xdd\xef%\x0f'\x99\x12\x9c\x8ai\xa4[\xb2\xe3xR\x1d\x7f\xe7!\xd4|\x01_\xa7\xcb\xe5t\xbe~\"\xa3x?\x81\xab\xfcz\xfa\xb8\xcaa}\x97\xc3\xc3r\xf1i9\xfd\x0c\xb3U\x9a\x93\xbd\x81\xdbe\x9e\xc3\xe2\x16\xae\xef\xa6\xcbOy\x86\xdf-s\xfcbH\xebv\xb1\x1c\x12\xc8`\xbd\xa0?\xe7\xff\\\xe7\xf35<\xe4\xcb\xcf\xb3\xf5:\xbf\x81\xab'\x98><\xdc\xcf\xae\xa7W\xf79\xdcO\xbfN\x00\xf2\x7f^\xe7\x0fk\xf8z\x97\xcf\xc5\x02\xc9\x7f\x9d\xadrX\xad\xa7\xb8`6\x87\xaf\xcb\xd9z6\xffD\x04\xaf\x17\x0fO\xcb\xd9\xa7\xbb5\xdc-\xeeo\xf2%\xcd\xef\xfe\xb4X\x02-\x84\x87\xe9r=\xcbW\xe2a\xb9\xf82\xbb\x19\x1f\xea\xcdt\x05\xb3\xd5\x1b\xf8:[\xdf-\x1e\xd7\x1d\xf3x\xb8\xe9\xfc	\xfe>\x9b\xdfd\x90\xcf\x88P\xfe\xcf\x87e\xbeZ\xe57b\xb1\x84\xd9\xe7\x87\xfbY~\x93\xc1l~}\xffxC\xa3\xc1W\x8fk\x98/\xd6p?\xfb<C>\xd7\x0b\x12M\xfa6Q\x9f\xe5+X\xdc\x8a\xcf\xf9\xf2\xfan:_O\xaff\xf7\xb3\xf5\x13\xcd\x12\xdf\xce\xd6\xf3|\xc5\x13\xc7S\xe6\xfc\xfa\xf1~\xba\x84\x87\xc7\xe5\xc3b\x95O\x80E8_\xcf\x969,g\xab\xbf\xc3t%\xa2`\xff\xf18\xed\x08=\xe4\xcb\xdb\xc5\xf2\xf3t~M\x8a:Q$\x1e\x17\x9e\x16\x8f\x98J\xee\x16\x8f\xf77\xf8\x81H\x1f\xa0\xa0r\xb8\xc9o\xf3\xeb\xf5\xecK\x9e\xe1\x970]\xad\x1e?\xe7Q\xde\xab5	\xe8\xfe\x1e\xe6\xf9u\xbeZM\x97O\xb0\xca\x97_f\xd7(\x07\xb1\xcc\x1f\xa6\xb3%\xd0\xd4\xf4r\x89T\x16s\x0e8\xbfLPy\xf3\x05\xe4_\xd0\x06\x1e\xe7\xf7x\xdae\xfe\x8f\xc7\xd9\xf2\x9c% \x8d\xe9\xa7eN\xc2\x1c\xe8]|\x9d\xdd\xdf\x93\x86N\x95\x9f\xd1\x92\xf9\xd3@\xf9O\xf0\xf5n\x01\x9f\xa7O<\xaa\xfd\x14\xcdC,\xf3n\x96{l\x15\xd3\xd5\xc0:\xa7W\x0b\x94\xc1U\x0e\xf73bk\xbd \x81\xa0\x8an\xa6\x9f\xa7\x9f\xf2U&:#\xa0\xad\xe3xy\x06\xab\x87\xfcz\x86\xffg6\xbf\x9e\xdd\xe4\xf3\xf5\xf4\x9e\xa52_\xe5\xffxD-N\xef\x13\x11\x98.g+<\x1a\xdaaT\x19\xfa \xda\xda<\xd9\xc8z\x01\xa7~y\xd1\xef}b\x7fh\x17\xf7\x8b\x15\x1a\x1b\xdcL\xd7S \x8e\xd7S\xb8\xca\xf1\xebe>\xbf\xc9\x97\xe4N\xd3\xeb\xeb\xc7\xe5tM\x9b\xe1\x8a|\x05\xab\xc7\xd5z:\x9b\x93R\x04\x9e\x97\xbcy\xb6\xbcI\xfeDr\x86\xdb\xe9\xec\xfeq\xf9\xc2\xc6\xd6\x0bX<\xe4D\x92l\xadSH2\xb2\xd5eF6\x00\xb3[X=^\xdfE\xed\xc1\xc8k\x9f\xe0n\xba\x82\xab<\x9f\xc3\xf4\xe6\xcb\x0c#\x0f\xef#\x1e\x16\xab\xd5,\xcad\x11)D9\xbe\x16\xed\xf29\xaf>3\xe0?^q\xc7\xc3TS\xaaZ\xb9\x13\xbb&\xa0\x10,<ad\x9e\xabCJ\x87\x1e\x97\xc6\x14Z\xaagU\xd9\x06d\x82M\xbbn\xda\xf2\xd8?\x89\xe3;\xdd\x94Uw\xf4d\xc4\x07\xd1X\xcf\xed\xb4\xd6w\x89\x8aK\xc0X\x99ciq\x90G\xee]\xef\xb1\x14at\xc4\xd3\xf0\x94\xact\x10\xe3\xa4\xc1\xc9\xb2{\xe3SH3n\x82\xca\xfe\xf1hw\xa7\x9c\xda\x8c\xe9\x11]j\xdd\x86 \xe3\xcdT\x8f\xa1\xba\x91\xdf\x041\xb9]\x01\xc0%\xbd\x97[<\x1ar\xdc\xad\xae\xd3\xc74\x05HWQ\xf8K\xbc\x8a\xd9\xeaJAz^\xca\x8fVx\xb2\xb0\xb0\xe6Yqf%\x94\xef#\x9e\xebG\x92i\xd2\x07I\x11\x0d\xbf\xa7\x86\x0b!\xc04\x14@`\xffM\x87\x1b\xde@\xa5MloAc\xa9T\xc2\xbd\x0f4\xefG\x07m\xf9r\x82\x1eD\"\x00hMzT\xfc\x17\x94'\xadO\x83\x05\x03\x01\xbc\xf5`d\x9dHo\x9cV[\xd0\xa5\x92\xc40\x95\x93\x81g\xc7\xffJ\xb4\xc6o\xb2?~<*\xe9>~\x04\xf8\xf8\xd1\x1e\x8cr\x1f?\xf2\x96T\xba\x0e\x9e\x1b\x8dT\xfd!5\x00\xc7\nf\x84\xdc?%\xe3\x11\xcbp~\x1e\xf4\xdc\xb3\xe4~t\xdb\x8f\xb0e7\xce\xf7:\x98\xea_Z\xf0C\xf4\xb4\xc9}\x7f_FT.\xc6c\xd4\x97/1\xf6\xe4\xbc\x00\x86\x97\xb5\xb1T\xdb\xdb&V\xfc4\xa8\xc0\xc0\xac\xf5j\xdbV\x19\x0f\x94\xb4\xa1\xcb\xf5\x18\x93R\xbe\xff\xd0=\xd1\x88\x97\x89\xd4\x01\xaeh\xa60\xcd|\xda-\x918M\xdb\xd6\xfd7Y{\xa5\xc8D\x88\xc2\x0f\xc4\xcco\xcf\xe9\xcd/\xd6b>\x1e\xfd\xc9\xb6#\x93\xeeG-F\x93$?\xd2_\x85E3\xd2\x8a\x17\x9d\xbd,?`\xcdk\xec)*\xff\xdd\x7f\x11\x00\xe0\x7f\xf1\x8f\x02L\x84\x98V\xdeR'a8HbM\x1af\xa5\xf9\x03~\x97\x89\xa0ZU\xaa\x08\xce\x1a]\x90_5\xb2Q\x0ej\xa9+\xee\x8e\x86\xfd\xe8-\xde`\x845K\xc11\xbd<\x91(J\xd7M\xfdV\xfa\x1b\x87RA#\x92:ph\xf2\xfc\xecb4\x0c[\xdbR\xc5\x99\xabO\xc6\xd6\xf6\x99+\x80d\xe2\x7f\xfa5;qfte\x8e\x05\x88\xf3\xb9\xcc9]]\xd8Z\xc5\x17\xa7\xd3\xab\xd5\xe2\xfeq\x9d\xdf?\x0d\x81\xf4\x07\xb2\x8ah\x10\x10\x8e\x8d\x82\x7f\xd1[\xd7\xc3\xdbI\xef\x18\xa7\x11\xa1O<\x94	T\x85\xfb\xa0\\O\x02\x04Q\x88\x8f\xad\xba\x1eS*\xdb>\x0c\xb7+\xde\x0e\x19\x99\xf0p\xcb\xfe\xd8`1HWa\xfdXx\xe2\x8fx\xe8VG\x0bN\xeftG\xcfOF\xb5\xe6\xab\x0f\xd4\x16[\xba}\x89\x17&\xfd~t\xbb\xec\x15\x96\xc6b\x83\x89\x8e\xee\xfa\xa9\x96\xa3.\xc4\xe0u\xd4Y\xd6\xe2c'n\xe6S\x04\xd8(Q\xdb\xd6\xabwE\xa5\x8bo\xd4\xfc\xa8\x95iA\x07U\xfbw\xef0\x8cS\xc1\xed[\xcd\x97\xbf\xdd\xbf\x10\x10\x9f\x99\xc4\xc3\xd2\xf8\xdeN\xc5\x10\xa7\xea\xa6\xb2G\xe5\xe0\"\xbd\x93\xef\x06\x96\xe3\xeaZ\xb9K\xe0\x97\xdfNx,\xf3+\xbe\x0e1<\xf2\xee\xf5\xce\x80\x1c\xa4\xb1\xc1\x1b\x9d7\xfdS\x96\x04>\xf4V\x18U(\xef\xf9}\xe7]\x1ce\x97\xe0\xe9\xa6\xe5\x03\xcfY\xd1\x1a4S~\x90\xf1d\x8f\xb6<\x1a\x15=\x9dn\x007\xc7n#\x9e \xea\x19 \x0fAx\x12\x83p\xdc\\\x00\xfck`\xe7o\xe1\"N\x15\xcao\xca\xf3\x03`\x0fq\x96EW\xca\xf9\xcb\xae\xf1\xb69\xc2\xdf\x90\x1b\xb8\x93\xc57\xe5(\x08\xfe\x85\x87MZG\xbe\xb4>\xc2\xb5\xb5\xe6\xaf\x19\xbc\x87i\xe3tE\xff\x96	\"\x16\xfe!\x83\x07\xa7\xbcN\x8f\xc0\xbe\xe8B\xc5\xe6\xef+\xf1\xb1\xeb\xc5\xc4\xcb\xa5\xbe\x0f\x82\xf63\xd4/u@\xc4\xe0\xddl\xfa\xc1w7qn\x18\x8a$\xf8v\xe3l\x1b4A\x86\x8d\x93\xee\xd85rD\x9a \xa7'\x9c\x18\xf89[\xd1\x0d%sRiC\xf3_\xc3\x1d\x07\xcdw\xdfM\xae\x88H<5\x9a8(\x1c\xd2 iz\x04^\xda\x0c\xd2\x13\x9b\x97\xff4\x868\xffOc\xbc\xec\x7f\xfe\xbf\x00\x00\x00\xff\xffPK\x07\x08\x07\xa1\x8f\xe2\x8a\x1a\x00\x00\xa7F\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce9sO\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0e\x00	\x00gpl2.copyrightUT\x05\x00\x01U\x96\xd3]r\xce/\xa8,\xcaL\xcf(Q\xd0p\xd6Tpp\xa8LM,rpPPpp\xc8/\xcfK-rp\x00\x04\x00\x00\xff\xffPK\x07\x08j\x83\x8e\x18'\x00\x00\x00!\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce9sO\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0b\x00	\x00gpl2.headerUT\x05\x00\x01U\x96\xd3]\x8c\x91\xc1n\xdbL\x0c\x84\xef\xfb\x14s\xfc\x7f@u\xe3\x14=\xf9\xa4\x04Q\" q\x0cIF\xe0\xe3Z\xa2\"\xa2\xeb\xa5\xc0\xa5l\xe8\xed\x0b\xa5)R\xf4\x10\xf4\xb8\x0br\x86\xdfL3p\xc2\xa8\xf2\xaa\xfe\x04N\xe8\x95\x08Iz\xbbx\xa5\x0df\x99\xd0\xfa\x08\xa5\x8e\x93)\x1f'#\xb0\xc1\xc7\xee\xab(N\xd2q?;6L\xb1#\x85\x0d\x04#=%H\xff\xf6\xb8\xdf\xeeqO\x91\xd4\x07\xec\xa6c\xe0\x16\x8f\xdcRL\x04\x9f0.?i\xa0\x0e\xc7\xd9-\xe3\xc5\xe2^\xbf\xbb\xa3\x90)v\xdeX\xe2\x06\xc46\x90\xe2L\x9aX\"\xae\x7f\x1b\xbc\xabe\x10u\xffy[\x0eV\xc8\xb8,\xfd\x0f\x1fg\x04o\x1f{+\xe7\xfe\x06\xfe\xe0\xea\xc0\xf1Ms\x90\x91`\x83\xb7\x85\xf4\xc2!\xe0H\x98\x12\xf5S\xc8\xdcq2\xbc\x94\xcd\xc3\xf3\xbeA\xbe=\xe0%\xaf\xaa|\xdb\x1c6\xb8\xb0\x0d2\x19\xe8L\xbft\xf84\x06\xa6\x0e\x17\xaf\xea\xa3\xcd\x90\xde=\xddU\xb7\x0f\xf9\xb6\xc9o\xca\xc7\xb29@\x14E\xd9l\xef\xea\x1a\xc5s\x85\x1c\xbb\xbcj\xca\xdb\xfdc^a\xb7\xafv\xcf\xf5\xdd\n\xa8i9\x88\xdc'q\xf6ou(\xa1#\xf3\x1c\xd2\xca\xb9\x83LH\x83L\xa1\xc3\xe0\xcf\x04\xa5\x96\xf8L\x1d<Z\x19\xe7\x7f\xe9(H|u\x0b\x18\xec\x8f\xdc6\xe0\x1eQ,\xc3E\xd9\x08&\xf8\xac\xbd\x0celW\x99\xfb\xbeF\xa1>\xfe\x08\x1cQ\x9b\x12Y\x86\x82{\x1bP\x04\x11\xcdp#\xc9\x96\xf1\xa7\x1cW\xd7\xeb\xf5\xd5\x97\xf5\xb7\xab5\xf6u\xbe\xfa\x19\x00\x00\xff\xffPK\x07\x08}\xb6\x80,\x94\x01\x00\x00\xa7\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce9sO\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x04\x00	\x00gpl3UT\x05\x00\x01U\x96\xd3]\xc4}[s\x1b9\x92\xee;~\x05\x82/\xb6\"h\xf6\xb8{.;\xd3\x13\x1dM\xcbt\x9bgeI+J\xed\xf5\xdb\x01\xab@\x12\xeb*\x80\x0b\xa0Ds\x7f\xfd\x89\xbc\xe0R$\xe5\x9e\xd8\x973/\xd3\x16\xabpI$2\xbf\xbc\x96\xbc\xf0\xbf\xdfn\x9f\xe4o\x8b\xdb\xc5\xc3\xfcF\xde?\xbd\xbbY^\xcb\x9b\xe5\xf5\xe2v\xb5\x10\x97\x1e\x97R\xfe\xae}0\xce\xca\x9f\xa6\xf2\xc7\xbf\xcb\xff3X-\x7f\xfc\xd3\x9f\xfe&\x84\xbcv\xfb\xa37\xdb]\x94\xaf\xaf\xaf\xf0\x8f\xf2\x83\xd7Z\xae\xdc&\x1e\x94\xd7\xf2\x83\x1bl\xab\xa2qv*\x97\xb6\x99\xc9\x7f\xeeb\xdc\xff\xe3\x87\x1f6a3s~\xfb\xc3/B.\x9e\xb5?:\xab\xa5	r\xaf}ob\xd4\xad\x8cN6n\x7f\x94\xca\xb6\xb25!z\xb3\x1e\xa2\x96\xcf\xda\xafU4=\xfcht\x10\xd2md\xdc\x99 ;\xd3h\x1b\xb4l]3\xf4\xda\xc6\xa9\\\x0fQ6;e\xb7\xc6n\xa5\x890\xbcuQ\xaa\xaes\x07\xdd\xce\xc4K\xdb\xc5\xff\xdd{\xad\xfau\xa7\xe1\xa9\xc7\x9d&\xaai\xab\xbd\xea\xe4\xfd\xb0\xeeL#oxF\x13\xa4\x92\x1b\xaf\xf5\x14W\xdc\xe9M\xcc\xab\xd98/B\"\x06l\xc5\xc5\x9d\xf6\xf2\xab\xb1m\x80\xa5\x1f\x9c\xff\x1afi\x12~+\xc0k\xb2w!\xca\x0b\xef\xee\xbdj\xa2iTG/K\xf8\xb5\xd5\xc1l\xadnEt2\xaa\xafZ\xaa\x83:\xca\xa3\x1b<.\xacu=\xd03\xec\xd2HH\x16-\xe3N\xf3\n\xa4|w\x94\x8d\xb3\xd1\xab\x10\xa7\"\xfe\xe1\x8e\x8d\x8d\xda\xb6tN\xdbAye\xa3\xd6\xa73\x8a\xb3\x19U\xd7\xc1\x11\x02;\xe1\xfe\x95\xdc{\xb7\xf5\xaa\x7f\xf3&:\xd9\xc3\xd2\xc3\xe05\x1c\x97\xd7\xbd26\xe0p\x85\x86@\x19\x18\xc4\xc4 \x87\xa0=,\xfd\xb3\x9e\xe2V^f\xbd!\xe0f\xc5w\xf6\x94I\xee6\x12v\x91f\xfc\x19\xd6\xa2\xf6\xfb\xce\xe8 U\x17\x1c\xecK\xd9#\x9f\x06\x90Oz\xddi\x15\x80\x18\xc0\x89@\xfa\xf5\x11W\xa8\x86\xb8s\xb8\xc6/n\x90\x8d\xb28\x12\xfc\x06\xa3 \xb5x\xffa*\xa3s\xc8	\x9fw\xda\xca\x83\x96a\xaf\xd5WX\x0eP \xafg\n?\xc1\xfe\xbc\xdeh\xef\x81\xb7\xa3K4\x9f\x02\x87\x8b\xbd7\x8d\x9eIy7\xf8\x17v;\xe6\x1a9\"}\xdc\xa9\x08\x07)v\xea\x99X\xa4b\xa1\xea&\xd2\x05<[\x9f|\xcd\xc7\xed\xb7\xc4\xfeq\xa7{i60\xa4<\x98\xb0\xbb\x9a\xe6)\xa4\xd7\x8d6\xcf\xf0\xf2\xe0\x1b\x18\xb2\xd5\xd2y$\xd4VG\xbc\xb4\xf8\xa28(\x0b\xff\xac^\x85g*6\xce\xd3;\x8f\xa7\xbd7\xba\xa1\xd5\xc1 VZ}\x10\xb8\xceBoXg\x1e\xee\xabu\x87<n\xeb`L\xe4\x19c\xb7|?\x1d\xbc\x1au\x13\x89\xcbQ\xec\x05<\x0d\xab\x89\x86{\xaf\x9f\xb5\x8d\xc4\x19\xc0\xb8\xae\x97\xad\xb6G8#\xd8\x04\x8dI/\xc2:U\xf8\xca?\xe1\xed\x1c\xbc\x87+\xe5q?\xf4\xd4\x0c\xe5\x82\xd7\x1b\x07\x07\x0f\x0f\xc2\xa1\x88F\xfb\xa8\x8c\x95^\x87\xbd\xb3\xc1\xacMg\"\x1c\x06\x93\xf9\xe2)\xd5T\x9a\xc2\xf4f\x03\x1c({\xd7\x9a\x0d\xb0\xe4?\xce\xc7\x8b\x0e\xff\x06\x9b\xae\x19\x01\xae\x08\xee\x11)\xf3\xc1y\xa9\xbf\xa9~\xdf\xe9\xe9wW\x10\x86fWn\xfcT\x1ev\x1aF\x11[\xaf\xa2A\x8a\xe0\xed\x96\x1b\xcd\x9b\xed\x87\x10\xe5^\x85 \x9d\x85\xb5 atc\xf6F\xdb\x18hG\xaa\xd7\x82\xd7\x15\xce\x18\xab\xe5\x9b\x87\x03\x9d\xb0x\xdc\xe9#^\xbaizZT\xacG\xd4\xca\\9\x93rn\xdb\xb2\xa8\xb0s\x07\x89\x9c\xcd\x8c\xa2}\x1fd\xc0%\x1e\x052S\xdci\x93\xd8\x04\xe9\xf4^?\xeb\xce\xed\x815p\x01,\x94H\xd0\xde\xdf\\b/y0q'\xe3\xc1\xc9\x10\xf5>\xfcC\xbc~{%U\x08\xdaG\xd45\xa4{\x818\xa3\xc3\x05\xce~\xfd\xe3\x95t\x9b\x8d\xf6\xc4_ \x98\xf8\xf2\x8b\xadyN|\xd7\xe9\xad\xeaH\xeb\x06\xd4\xf1\xacv\xa7\xf5	*\xdb\xfe\x80\xd2\x91\x19%\x9f:\xcc\xda\xe6]\xbd\xc2yY\xe4\xbdJ\xdbA\xf9\x8b\xdb\xbc\xbf\x91M\xa7\x95\xef\x8eR\x7f\xdbw \xdcE:	\xafI=\xcb\x83\xf2\xa0K\x8e\xc8\n\xb8\xea\x91t\x99\xd1\xc4k\x17w$\xfeqR\x91'\x0d\xea\xab.\xd3y\xfd\xdf\x83\xf1\x9a\xe9\x8d\xeb7\xba-\nh\xade\xaf\xfcW\xddJ\x15\x04	\x93vJ\xa7H\xcb2(\x9f\xd7\x9d\xee\xe1(\xba\x0e\x01\xc4ZK\x15\x996\xad\xd4\xde;\xab\xdd\x10\xba#j\x06Z	\xb0;\x88\x03\xe3\x86\x90\xe7C\xba\xad\\\x8fD3\xcd\x05)\x0c\xd2\x82\xf6%U\xd3\xe8\x807\xd0\xd8\x10A\xe59/\xfd`\xc5\xf96N.7\xbc`Z\xe4\xad~*U\x17wn\xd8\xee\xf0\x91^\xd9a\xa3\x9a8x\xed\x05K\xba\xe0P\xca\x80N\x0fr\x03:\x13\xe0\x93\xea@M\xd9\xc6\xf5{\x15\xcd\xba\xd3\xcc\x8a;-\x95\x01	 \xd2\xf9\xdam:\x89JO\\\x90\xcc$\xcbd8\x86\xa8{\x15M#\xf6*F\xedm\x11\x0dk\xb8\x12\xaei\x06\x0f\x00\x83&\xf3Z\x111];4\x91\xa0\x91\xb1\xady6\xed\xa0: \x90\x18\x02h\xc5\x9div\x08!AB\x04\xdd\x1dA\xbe\x10\x940\x81T\xfb`\x81\xaa\xfb\xa8\xd6\x9d\x1e\x8b\xd6\x83&uW\x0e\x03\x08\xc2\x14N\x04\x06\x96B1\xefvfmHF0\x1a\xd3\xcc\xaf.h\x91\x96:\x93r\xc9;\xcbL\xa4\xbc	 \x84\xd6!*\x1b\x0dS\x99\xb1D\xeb\x10\xf1\xe0j\xe0\xf7Vz\xadZ`+\xa9\xbf\x01\xdc\xa2U\xed\xbd{6\xe9\xb2\xe2\x94\xe9M\x18j3\xc0\xe9f\xe6\x10e\xedS\xa9\x02\xea\xaa\xa4\xadH\xdc\x9c\x08v\x02Ux\xc3\x8d\x85\xf5M\xa5\x06\x80\x9e\xc46\x103\xee\xbcVQ\x03\x9d\x1agq+\x1db\x9e\xcc\x82{\xf8\x19\xe4\xde*\xaa\xa8\x03\xc8\xcb\xa1k\x0b\xfeN\x0f\xb0\x86\x89\xde41\x89\x12`?\x14%\xc8\x0d\x9b\x82\xfd\x1c\x88f\x843o\xf6\x83\xdf\xc3\xc6\x81?\x87\xa8} \xb8\x8fL\xe3\x02K\xf8\xd6\xa1z\x06\xd0\x81W\xf3\xd9\x99\x96Xr\xaf\x1b\xa3:\xd9\x02\x93zz8-\x88\xa0\x1eR\x88P}\xdex\x03[\x10\xa8EL\x94z\xb3\x01\xee\x7f\x06F\xdb{\xb7\xf7FG\xe5\x8f3F\n\x84\x04\xe0\xbc\x8a8R\x01\xb4\x0fI#\x91\xe6k\x94e\xa12\x04\x9a\xb6B\x01ij\xeb\xec\x1bXK\xb6\x14\x98\xc9Y\xef \xe2r\xb65\x11\xe5\x01\xb0\"\x08qc\xb7\x95\x1c\x07\x86\x01YI\xf2\xa3Ad,7\x0eN\xe3e[\xe8q\xf1\xf0i%\xe7\xb7\xef\xe5\xf5\xdd\xed\xfb\xe5\xe3\xf2\xeev\x05\x0f\xffi&\xdf\xeb\x8d\xb14#\xbe?y\xact\xcc\x84\xe0)\x9eo\xbaE?\xe5{\xf4\"\n\xa7\x81\xb2U9!\xc0\xddkeC\xd1wo:\xf3U\xcbN\x1dX\xae\x13\xa4\x8e\xee\xc4\xb6\x12h\xd9LY\xb4\x04\x19to\x80HC\x13A\x99\xa9\xf05\xaf[\xcb{\"t\xbdl\xc0\xf8yN\x85\x12\x10\xb0>\xdbh\xad\x1c\xf8\x94L\x10i\xf5R.T\xb3K\x8f\x90e\xd8\xb6^\x87\x80*FN\x8en\x98\xcc\xa4\x9c\xf0\x0b:L\xf0H&\x05\xd4Ld\x0f\xe6\x83\x1e\xc98\xe7\xa5\xf3[e\xcd\xff\xa8B\xefG''\xa4\x92'R\xd1\xda\x88P\xc9rF\xfc	\x80\xaaU{\xbcv\xf0\x8f\xbd\xf21\x9d\x03\xbc#\x8c\x05>Wa\x07GD\n\x13DzA\x17\x05\x1cL\x99\xc2q\xa7,\xab\x13\x04\xb0`\xc7Y\xa1\xbf\xa9\x86P	Kz\xaf\xc3\xd0\xa1~\xc0\xc5\x19\xe0\xf5\xae\x03J\xf0\xc2+%6\xe15	\xc0\x07&\xd9U\x88\x06\xf1\xbf&k\xb4\xaf\xe0A\x98\xb8~\n\x891\x97\x93\xc6=k\xaf[\xfc\xdb\x84I\xa1\x0d/X\xcb\xc1\xe69\xf9\xb0\xab\xe1qt\xc18\x8a\x7f\xceD\x86\xdb\xad\xb6*\xeas:\xb7\xc8&h%\x90~D\xad\xa0\xe2\x14\xff\xe5\x86(j\xea\x1dP\x06\xa2\x00!\x8c\xecu\x03\x82\xd3y\x194\xb0\xa6\xf2\xa6;\xca\xce \xb7\x81\xedd\xec\x06NC\xa3H$\x86C\xf9\xd4\xe0\x13\xe5\x8c:u\x98J\xfd\x0dt\x9b\xd4\xdft3D\xf6y\xc0\x9d\x17IH\xca\x0c\xe2\xe0W\x00\xe2\xe6Y\x11B\x873\xbb\xe7}\x02#\x18\xdbtC\xabC\x96#b$G^\xe3f\x9dO\xdb\x94\xb5P\xb9\x9a&\xc6P\xcf\xcat\xb8T\x02\xefb\x8f\xb7\x9d\x00\xaa\xb12\x00\x16j\xdc`\xa3G\x13\x01\x0f\x0b\xf4\xe93\x99\x1f*\xc8\x83\xee\xba|\x12\x8d\xb3\xcf\xfa\x94\xdd\xe1\x9e\xc2\x9dg\x94\x90\xb7\x80\xb2A[\x98\x9e\x87\x16\xc0\xfcl\xd6\xe0)\x00\xa2b\x0b\x94\xac\x94\x99\x94\x9f\x103\xd8\xa8Q\xb1;K\xe7\xaaP/\x82\xe2\x03,%TV<\xd2\xea\x08\x8b\xa1\x13\x07\x04\x1b\xbd\xb2\x01p7\xba7\x08L\xb3\xeb\x89\xd6o\xec\x96x\xd6\x96y\x9e5M\x80\x7f\xd8\xa8F\x83\xdc\xdew\xea\x18\xe4d\xbe'\xfd\x02Gu\x83x\xfd\xd6\x01\xe4\x08\x13\xc16\x11\xa2\x03\xe2<2w\xf9\xf0\x14\xcdhM\xd2\xa8{\xefzc5\xaak\xc0\x10\xebN\x8b\x8dV1\x1bE`_\xe4\x99\xc9]\x91\xe7.\xecfq\xfelg\x88\xa8\xbb\x8e\xcc1\xa6\xd2\xf7A=K\xd2\xd7\xcc\xb0\xcc\x1b\xf5&\xf8\x05\xc3\x10\x19\x11O\xab\xdb\xe4:H\x026\xa0\xb0$\xaa\xe2\x188n\x11\xccI\xad\xd0J\xd1ds\xf2\xd9\xe8\x03\x1fLv\x1e\x16\x01\xbe\xdc\xa0\xf3\xab\x9c\xc3\xde\xeb@\xc0@v\x86\xdcC\xb8\xc9\xc6\xf5\xbdBM\xe3\xa5\xdb\xa3X.\xbaF\x89^\xdbaJ\xc6.Q\\\x9a\xa8\xfb\x84jq\xa4^k4bA0z\x13\xb57\xce\"c\xbc\x9d\xc9\x15\x99\x9e\xd7`z&\x9d?\xa9\xec\xd1	\x9b\xca\xb58\"X\xb0\xd1\x1e\xa4\xe0\xc6\xf9~$\xe7\xd1\xbfE\xd7\xb2\xbe\xacdaDPJw\xeb\xff\xd2(\xc1a\xf8r\xb7\x00{\xd0\xcc\"\x0d\xaaF\x82w\x05PU\xf9V.\x13\xd1\xca\xeb\x15!\xe9>\x92@6\xf8\x9b\xdbl\x0c\xe00\x02\xbb0B\x0b\x98B\xb7\x00%\x15\\M\xb7\xb5\xe6\x7ft+\xd3\x03A\xae]{\x9cJ\xe7\xa7\x89\x94\x8d\"\x94\x98'\n\x04\xefP\xd2\x13\x91\xf0\xda7C\xa7\xb2\xb7\xad\x072t\xcan\x07\xb5\xd5S\xe9,-O\x180\xf3Z\x80t\x08\xc6T\xef\xec\xb6\xb2pq\xdb(YY\xbc\xa4!\xca\x19\xad\xd0\xbc\x917f\xed\x15\x08\xb5	iG\x96\xca\x05F\xf0\x1d\xcd\xea\x83u\xab\xc8\xba\x15\x9f\x02V\x92\x87\x9d\xeb4s\xfekuE\xdeW|\xbbMD\xb0\xce\xf7\xaaK\x87.\xf6\xaa\xf9\xaa\xb6$\xe4?\xa9\xffr^^\xbb~\xeflv\x8bgc	\xa4R\x81\x04*\xd2\xe3\xa2z\x1c\xef\xf8\xfaJ\x06\xed\x9fA\x90Z\xc2Z$X\x19\xa2\x97\x05\xb3\x91\xa8\xa28\x9b\x17\xae\xbe\x93\xa6\xdfw\xa4\xce\x94<g\x1c<0Z\x9c\xb2\"?\xcb:)\x9c)\x14I\n\x85tIq!\x02\x1dfR\xce\xc5\xe4d\x15\x13f\x1b\xb8r\xceF\xfd-N\x13\xab\xca\x1e\x1f\x05\xac\x86\x86\x19Jx|I\xbc\xfe\xaa\xbd\xd5\x1d\x88x\xdb\xba\x03\x9b\xb0D\x9a\xe0\xa4\xb3W\xd9\x04'\xcek$\xb0\x8bB%L\x0f\x8b\xd7\x06\xd8\xe0x\x05\x1a\x996H\x82{\xcc\x15~\x00)\x82L\x0b\xd3\x9bN\xfbd\x16\xb0YY\x9c\xf6\xf4\x9c\x95\xae\\[\xbam{\xafcy\x0f\xc6L>\x1b\xe4\xd0k\xe7\xc9\xcf\xd7\xc2\xf2H\xd0\x8c\xc4\x89\x19\x8f\x89L\xc5D\xea:\x11\xc7\x8e\xb1\xca\xa0$\xdb,\xeai\xf2X0\xf3lh\x9de\xaf(\xa6\xafpa0Z=\x19\xfad]r5\x95\xad\x12\xc3#=\x1bo\xf6h8\n\x8cW\xb8\x8eM\xbe\x82\x1bfR~t\x07\xb0Z\xa7\xa0\x0e[\xa7\x89\xd1\xd3\x9dK\xc3\xbe\n\xe2\xf4\xba\"QO\x8d\xcc\xe8\x1c\x81p\xfe\xa1;V\x8c\x88\xe1\x89\xe4TN\xbc\xeb\xd9\x9a\xab@\xa7\xb1\x80\xa4\x81\x98\xe8\xb2?Y2\xdcJQ^\x1e_KZ\xed\xec\xc4\xd3z\xe9\x1cEV\xfd\x15\x90\xc8v\x9a\xdc\x98\x0eQUp\x0dh\xf4\x96\xae+\x9f&\xfd\xc8\x1e{&;\xb9\xc8\xf5\xe9\xe5\xa2HR+\xbbD6\x8a\xd2\x1d\xad\xea\x0d`\xfc\xa3\xe8\x8c\xfd\nr{Xg\xd2$T\x90\xad\x81tY\x90\xa0\xb5\x17\x8c\xddvS\x91\xd4\xe9\xfa\x08\xfb1=\xa0\x90VE\xbc\x1c\xfd`\x93\x11\x8b\xe6.\xb1\xc2\xa6s\x07\xb9\xd6\xf1\xa05\xbb\x02D\xbd\x86*\x8a\xa6|\x0c#\xf2\xa6\x0br\x89\xae\xe4\xe2\xafy(\xc3\xfe\xe4\xca\xf5\x01\x1dj^\xa7k \xd5\x10\x1d:\xbap\x83d\x8d\x9d\xcf=\x9aN\xd0t\xdf_\xcb\xf8\xaa\x9e\xca=\xf2\xcf\xa8(\x82\xea\xab\x9d\xfd8\x93\xefT0\x8d\xbc\xcf\x06	\x99\x91\xf3\xaeK\xce\xe6-F\xf1\xda\x0b\x00\n\x992\xfd\x9c@\\\xd4\xa4m\xce\x1c\xd1\xf7\xc9\xc1\x8f\xfe`@\x81\xde\xebgGFK\xc2r\xc4W\x11\xd8PT\xbe\x0bx\xbc\xd71\xb9$\xd3\xfc\xfa\x1bX=\x06p\xab\xdal\x8c\xef\x03\xb9\xc9\x07\xdb\x99\xde\xc0\x18c\x1fv\x92-\xe7V\x1f\x1b\xa7n\x88\xfb!\xd2\xa9\xf8\xc1ZT\x96\xa2\xb6!\xd1`\xe5\x7f\xaf\x8fcr\xa0\x164t\x804\xd2Tn\xcd\xb3\x06I\x1bP.\xa1\xcaC\xe7\x98\x89Cd,^\x06?\xdd\x9fj\xbeZw\xe8t\xbb\xd5\xb43\x91\xc2D\x1b\xb9Q\x86bZ\x804\x91\x81\xe0~<\xab\x8e\xf4s($]\x1f\xc76!\x1e0\xc6?\xd4\x11-\x9e)\x12\x86-\x012jG\xcb\xaa\"(\xad\xc3X\"\x81\xebl\xcf\xd6n\xa6\xe0d\x07\xf8H\xf1Y\xa4\x987\xae\xf1`\x82N!\\a,0\x0d\xc6$\xd3j\x18\xb4\x9fL\xeer\xec\x8cy,8`\x19\x92\xc3\xc2m\xe4N=\xd3\xa5\xd3=\x99pc,\xab\xbf5\xdd\x10\xc8)\x07C\x1c\xdd\x80\x12\x9d)D\xa1D\x90y\x1b\xd5\xa4\x18\xd7\x86\xfc\xea\xb6\x88\xe5\x039\x8e*NM1G\xd7\xef\xbb#\xd2B\xa4\x1b\x10N\x8d\x08\xb8\x94\xd9\xd0C\xb7\x0b\xc8-o\x08\x9f\xb1\x86 \n\x0b6\nQt\xe5\x93C\xde '\xe6\x10\xb2\x8f\xa5^\xe4\xc9\xa1	\xde*\x85\xa6\xd0\xa5?\xa2\x84\xb3t@k\xbdS\xddf\xca\xf7\x1b\xffD>\x08\xe3\xac`\x1f\",e\x8a\x17\x19\xf7F\xae\xd1\xca\xe1\xdd\xd3\x95I\x06>\xf9\xc8(\xbeG\xf1\xec\xbc\x0d\xdd\x96\x8d\xbb!\xa6\x90\x84\x01\x9b\xbb\xa3\xf3\xda\x99=\xa9\xa0\xa3\x1b\x90W\xaf3\xdd\xd8\xd9\x91\xe3\xec\x8d\xf1\xcd\xd0\x83\x1d\x00\x08\x7f\x94)\x02<\x02\x88\x1d\xde\x10D\x9c\xc2\xa3(`\xe4Zw\xee0\x93r\x85pQ\xdb\x80 ~\x94\x0f\xf2\xb3\x0cD	\xf9\xf6O\xe8\xe4\x0d\x80\x1d\x06ku\xa3CP\xfe\x88\x0b\xfci\x06r$\xc5=\x9e(\xeeAF\xf9\x03]\xd8\x0f@\x9e\xb9\x8d\xe6\xcd5.\xf9\x19\xe0\xa4\xb3\xf2\x86\xaf\xe3\xad\x1b\x1d\x1e\xa8\xd2\xae\x93k\xd0\xd3\xba\xd7mV\xfb\x80\x98\x92\x8bYF\xdd\xec\xac\xeb\xdc\x16\x94\x89\xe8\xb5\xc20f\xa1Q\xe5\x14\xea\xd4An\x86nc\xba\x0e\xf9f\xdd\x99-\xdf\x0e~\x1e\x8c\xa1N\x8b\xb7o\x93\n\xfa\xbc\xbc\xbf\xab\x04G\xf4Z\xc5\xa3T\xad\xdbG\xf4\xb9\xc9\x1f\xff$\xdf\xebF\xf7k\xed\xe5\xdb\xbf\xff\xfd\xafp\xa7D0\xbd\x01\x93\n\x1d\xb1\x89E\x12\xab\xb2K\x1f=\x89#2p\xac'\xed!\x94\x8c\x07\xba`(\x15\xc6\xb2\x92b\xc1\x07\x05\x84\x80\xcdr\xcc\xd2\x1d4Z\x14\x1b\xe7\xd7\xa6\x15g\xd3\x8ch&\xd3|r\xec2A\x841z\x15l@\"<	T\xfdM\xfb\xc6 \xc3\xb0H\xbe\xa0\x1e\x91\x89s\xa4\xdc\x89\xd3+J\xaa\x90\x03\xe3M\xa7L\x8f;\xc1$\x9a\xc8*\x0b\x15Y2\x1c\x10\xd5\x8c]\xf5\xb5\x99\x85v!ar\xb7\x91\xda\x82tE#RmA\xe0\xc6\x1a\xe2\"6\x99\xd2u\xa7\x98\xaa'.3:\xbcbb\xf2\xce25\xcf\x0eM\\\xa6&\x9e\xde\x9fg\xd5\xbd\xfd=\xe5g]\x93C\xad\xd6@|\xba')\\ic\xac\x9f_\x85\x11\xa4!\xe5\"\x92\x9b\xceD\xb4\xe0\x80x\xbdn\xcd\xd0_\x16\xd36\xecM3PP\x16\xe3\xc3\xc5\x8d\xd5\x1d\xc9h\x0c;\xe0l\xad\xe0\xfc)\xcf\xec\xbb\xce\xae\x9f\xc5W\xad\xf7pb\xaa!g:\xfd\x9dDL\x06\x82c\xd0\x04\xf0\xc7\x1e\x85u\xf6M\x82'\xcf9f\xd3\xb2\xfd\xae\x9a\xc6\xf9\x04\xc5Y\x04\xfd\xad\x045\x88\x95\xda\xef,\x80\xe9\xa7\xd6A\xdbF\x93\xec8f\x9f\xdb\xcf\xb8\x8c-^\x9e\xae\x13U\xfa\xc4e\x1f\x98T\xa8\xd4s\xb4\xb7\xf6\x86\xe7\x83\xa4\xec\x1e\x98\x06s\x8d\x80\xaf\xac\xe3\xff\x06eT\xc8Z\x1f\n\x00	\x91.\x02\x8cC\xf9	a\xd8\xef\x1d\x08=_\x1c\x85%y\xa0\xe4\x84\xe0\x12\xfeR3\xdb\xa7\x84\xed\x18\x19\xff^\x07\xdaO\xb8\xae\xf6\xf4\x9f\x01UF\x1b\xa7\x8e\xb1ls\x1bF\x8a\xa3\x97\xd8\xf7\x92\x9cb5\xd7&\x11\xa1E\x86\x08\xe9h\xff|\x89c9\xcc\xa59L\x83\x07\x1ajE\xf6\x0f\x8a\xd0\xa9+\x04\xaf\xe4\xf5\x03e\xdf(O\xa1Yv2^d\xca\x9c\xe5ct\x8b\xc3\x18\xf6\xebp\"\x88\xc2\x04\xb6ge#XW\x9a\xa3\x81\xeb\xff\xd5\\\x18m\xc7\xf7sR\xdc%s\x82nF\xad\xa9\xe9>\xd0\xb3L+\x1c\xe6o	*\xb3U\x88\xbe#\xde\x0d9=\xeb\x1f\xc0 J\x84\x86\x03\x84\x11&/\\\x9c	o\xb4\xb9*9B	\xc3\xa2\x8a\xb0\xd1\xf8l	W\x9e\xb8*\xf2\x07\xef\xa7MQ\xcc\xd0Y\x0d\xcf\x01V$3\xdc\xc9\xbd\x0bA\x87\x94I\xa0J\x8c\xecd\x00\xcc0\x89))\x81D\xc0\xb4\xbe\x8f'\xaa>K\x0b\xe2\x8d\x96H\xa9:\x920\xd3$=p\xd5\xb5\xfa`\xc3\x8c\xb3(\xd1\x1a\x9d\xf2\x91m\x95o;\x1d\x90c9\xc5\xe9H.xt)bB\xd5\xc8p\x01\xc1\x028\n\xdf\x1f\xdb`5-\x93\xb5Z%N\xaa#\xc7\xec\x8b\x87\x86\x98\xd3>\xab\xce\x00#r\xeeD\x19\x94\x93\xcb0S#\xe8\xbd\xf2$\xcfS\xb2WrsI\xd9^\xc9e\xa5.w*|'\xd4\x12\xa6$\xaf\x08=S\xf0\x03Gy1\xf0\xf23\xd0\x86\xfdK#\xe5u:\x13o({\xa69-\x01q'\xcf\xf4\xf2,\xa4\xb3q\x08\xdcE\xf6A\xa0\xe1\x83X\x9c\xd2w\xc8\x0fO\x0e\xc3\x8c\x15\xc6\x18\x8a\xf8\x87(\x9f(\xc7Q\xb8V\xef\xb5m\xb5\x8d)`>vC\xa1\xe9\x0b\xa8\xddR\x98\x08\x81\xd3(\xefh\x04tP\xbe\x8fGh\\\xbfF\xaf~\x8a\x90&\xb7\x0e\xc1\x8d^*\xd9\x81>\xc9\xde\xf9)\x18\x8c`\xecZ\xa9\xe4\xb3\xeb\x86\x9e\xb4\x9a\x0c\xd1y\xb5Eu3\nG&(P\x85\x98\xad\x98\xa8\xed\x16\x18:\xeaI:\xa5\x9aD\xb8\xf9\x18\xaa(uQ\xf9\xbcr\x91\\\xa8\x04\xcdP\xc9RV\x96\xf3c\xe0\xe4\xce\xc6\x7f\xc5\xe9\xc9b\xad\x8f\x0eI\xc2\xde\xaf\x12\xd7g\xa3\x97\x0c\x99\x99\x94K\x8b&\xdb\xa5\xe3\xc3(\xbd\x95yG\xc5\xa7\xd9(J#\xac\xae$\xc8\xa1\x1a=\x94\xd8g\xc1\ni \xe4\x9d\xbf\xd6:\xf5\xd6\xd97\xacN?8\xdf\xbf\xa0K\xc7\x8e\x92\x0b\x0e\xe3\xac\x01I\x18\x89\xa2\x01\x83\xfc3\x12\xff//*\xc2*\xa0\xd7\xabfg\xac~\xe3\xb5jQ\xe6]\xf4\x88\x9dLv\ni\x88\x9f\xac.\x8a\xf5\xa0\x8e\x95J\xbd\xce\xf3\x9d8\xd3\x11\x18\xe8~\xedZ\xf2\xe0b@ow\x0c\x88\x819\xcd\x0b\x07y]\xfc\xd3\xd5\x13\x17x\xf4j\x8ax\xaf\xdf+k\x92_\x89\xa4\xc4eW\x9f\xf9FhE\xc9v\xf0\xe4?K\xa3\xd3\x80\xa4\xc1\x86\x10]O\xd9\x03\xc8\xb3\xe8\xa3-\xe9\x80Q{J\xc8+\xaa\xfd\xff\xeb\x9e\x15	5\x0f\xf6\xbb%\x148\x95(\xf5	\xedE	\xd0!b\x9e\x99\x96G\xad<\xb9n\xabGHsV\xfe\xa7\x04&\xf7\xa4\xad<\xa5X\x13e*\x90I\x8e%rj\xe4\xad\xf4\xae\xd5\x1d\xea\xcb-\x1b\x99I\x8b\xb3\xeaf\xa4QS\x8a#\x99\x98\x90K\x87\x90\xc1\xf4\xf7\xfc\xb6\xa4\xe1\xeb\xc3\xc9\x1c\xc0+b\x1c\xf5\xa2\xf3qz\x99\x1fh#H\xf0\x7f\x9d\x1f\xa6)B\x8a\xc8\x9d\xb5x\xef(\x1b\x80\xbdF^\xab\xe0,'\x9cP\x00<\xcd	\xb6T\x1d\xd3`<S\xbc_\x19\x16#W\xbd\xfe\xf1\xaaJke\xf3\xe0{\xdc\x0f\x90[\xa5\x0c\x0b\x8a@\"wX\xc7&HAp\xcc\xcf\x95t\x1d[\x97\xf5\xd1qFGu`\xe7\xfc()C\xf2\x99\xbdV\x17\x17Xc8\xd5E\xed\xadBxaBr*\x91\xa3\xd85\x8d\n\x88\xcc\xc8\x1c\x95\xd6\xd9\xc6\xf5\xbd\xf6\x8d\xa1\x0cK\xb0Qa\x94\xe4W\xaeS\xd8//\x9fth\xbe<\xd9\x8e\xa4\x9d\xd0\x13\xeb\x04\x10\xff\xba.\xb8\xe8\x85\x8b\xbffk\x0c\xaf3\x9d\x11\x93\x9f\"3\xe8\xa7G.\xedT\xa3\xe5\xeb\xd3\x9c}:\x8f+\x82\x96D\xc1\xe2\xa5\xaeN\xfd\xbb\x07\xce\x16\x15E.\xd41\xa5\xdb\x94?\xd2\xe4\xc8\x018\xcaf\xf0\xe4\x1d$n E\x95q\x12\x1b\x06\xa3\x92\x81\x7f\x85\xefN,\xe0\x8aL\x94\xd2\x0b\x7f\xa4\x95\xb0\x85\x91\x86\x1c\x8b\xd2p\xc6\xbb\xd3\x17Y\x89.\x1ee\xfe\xd1\xf56@CtQ\x11\xdb\xbf&\xcf\x10\xc9\x03\x94w@\xf6\xe2\xcd9^\xe1\x18(<X\xd8\x85\xfa\x088\x91\xab\xf2|W\xfa\x97\x0cr0\x91\x0cY]\x98\xa8_\xbc\xc3AZ\xfd-f@Q\xed2(\x1c\x95R\xad\x01\xd0\x19\n\x19\xbeH\xdd\x99\x94\x0f#3\x03\x91\x11or\xe7\x02\xd5u\xbc\xf8\xfa\x94\xef\x06\xac697	\xa4i[j;\x8a\x15Z\x05jQmd\x95Q\xe2\xd6\x018\x99\xa2\xcdadM\x06\xbe5\xfa\xc5[3\xa0_p\xaf\xb5\x7f\x13\xdd\x1b\xf8\x7fJ\xff\xca)\x7f\x89\xc28\x0e\xac\xdcX\xf2\x17P PcR	\xd1\xeeB$|\x1c\x1b\x84!\x98CG\xbe@\xaf\xe5Z\x93\xb4\xdd\xa0\xc2\xe0c\xe2hu\xca\x91(\xb7\x86\xdd7lkWb\xa2eS\x82,\x04\xd4.\xce\xd7\xce\xc7j\x81`'\xb80\x0e\x0d\x1b\x8e\xc0\xc0\x86\xb3\xbf\xe4\xf2\x15\x83\xcb1\n\xbe\x1f\xa7\xe5\xe2\xaes \xbb\x1dGS\xceDa\x95\x86\xf4\x04F\xdd=\xe9\xd0	.\xa5\xd2\xd0\x98;\x18\x86\x9e\x8c\x0c|$\x19:9\xd3IDe\xb7X\xea\xb0\xd7>\xa0!\x0d\x96\x99\xf6\xf1X'\xcc\xf8\x1e\xe5x\xd6\xab\xe9\xe1\xa9\xdc\xa8\xdet\xc7\xa9p\xc0\xc8C\xd0;\xd7\xb5)x\x15\x8a\x06L\x91\xe3\x1c\xf2F\xe5\xdc\xd1h\xc66\xce\xef\x1d\xf9\x80\x05\xba\x0f\x94l\x0f\x1a\xbd\xf9h$\xc8V\x03\xca5\x96\xef\x1d%Mf\xf4`8\xedo\xb4\xd9\xa9h\xdd\xb0\x8e\x9b\xa1\xc3|\xa9P\xa2\x0e^\x07\xd7=\x13\x9d7\xea\xd9a\xda\"\"\x0f\xb5M\xd56u\x06U\xaan(\xea	s\xb5\xaa\x14+0{\xa6r2\"\xd4(\xafZ\xc4\xe3\x1e\xb1\xa2\xa3,:gK\x1a\x91\x8a\xb2\xe9T\xe0\xfa\x19Z\xfb\x89[\"r\xdcx\xc8\xb5\x0d'\x93K\xda\x04^\x10\x85\xe5\x15%\xe1\xe6\xe4Q\xa1\x9a8\xa4U\xd2\x11\xe9o{\xdd\x10xDv\xdeS$ :\x89e&\x91\xb2\xf2aa3`\xbb\x04#/\x92\xfdd\xe5\xe9\xb0\xaa1\xd0aP\x95\x83\x88\x82\x0b@\xa9\xb7\x03\xa0i\"\x95u\xf6M\x9e\x80\x96;X\x1c\x1a\xb1\x00\xfcEz\xcd\xe9\x8a\x18\x81@4\x01<\x86NMr\x9b!\x9d\xab\xac\xad\xb4\x17\xb8D\x93%\xe5\xed\x90\x81\xbcDI\x85\xff\x9d\xd2\x83\xea+Ve\x08\xf6:\xee\\\x1b\xa6\xc0\x1b\x8dn\x07\x0fk\xa3B(\xceX\x97_\xf5\x91\xc8K\x82\xcf\x94\xb1\x93\xc0E\x12s\xe2\x10:\x11(_H_(\xdb:\xf7n\xa4|\xbc\xd1\x02A\x02	u\xf6\xbe\xc4\x9a\xd0\xf02\xa2\xd3\xa3\xe5\xf5C\x88\"\x0c\x9b\x8dA}?R3\x91\x82\x8d\xd1\xd8\x01\x84\xc1`Q\x8e2\xf0\x8d\xbbj\xed$\xb4D\x92\x92\xc6\"\x86UA\xa7R\x11\x12\x03\xe4*\xa2}Qj\x0e\x866\xd7\x1a\xcd\xfcq<\x088g\xad\xb5\x95\xbd\xe2$\xd1\xe5f\x14D\xb3g\xa2\xb2v\xc5&\xa1\xcf\x16\x1fLGa\xbd:+g\xc3\xd5\xb4d\x06\xd6\xd4-\xb9A\x15\xda\xa7\xca-\x15D\x0eb\x92:Ti\xaa\xea&r\xc6\xc8\xa6\xf6\x8e\xaa\\\xf4\x83\x18`t\x9a&\xe4\xcc\xeaJ\xc7eh\xc7\xf9U{\x1d\x07\x13\x8f\x19\x97\n\xb2\xa01U\xe5\xf5E\xf7\xe6x\x85\x01\x95\xa3j\xa2\xf6\xe6\x7f8\xe1X\x8b\x8b*\x8c\xf6=\xf6o'\xa2\xa2+q\xadk\xbbW\x90\xad/_\xbac3)\xdf\x0d\x1c@\xaa=\xda\xd9\xd3\x83>\x1da6\xd2\xb2b\x83\xb3\xb6\x8e\x02\xc0\x15\x0e\x94^G,\x06\x8b\x18\x14\x02\xb0w\xac\xef\xd6	Or\xd15!\xef\x11\xc51q/\xa7\x9b\xc5\xca\x99*\x90\xefx@\xd2\x1d\x0fw\x9f\xaer\xdaR\xbd\xfe\xca\x8ezi\xeb\xe7\x19zJ\x9c\x0c\x91nY=\\2\xe9\x01;b:z\x8a\x1e!C\x0f\xfbVEM\xb9\x11\x1c\xfb\xc1;[\xaeM\xa6\x83\xaf\xb6\xc2\xa7\x94\xf9j\xca\xac$\xce\xc8\x93\xb9\xd9\xfc\xd1\xa0\xa0(\xb2\x01\xa4D\xb2	\x18\xee\xb7\x1a\xdd\"\x87\x9d\xb6\x95\xcc\xe0Knb\xd0\xdd&'R\xa4pf\x0b\xb2LS2\x14j+\x14\xf7%tL\xd2'M\xe4\xbc|6\xae\xc3B<\xdc\xdc\xd0Q\xca\x1e\xd6p\xba\xc6u\xa9f\xac\xce\xaaS\x8dw!\xd4\x03q\x8a\xc6w\xee\x02I\x85\x17\xcf9\xa1at\xc8\xd5q\xcf\x8b\x97\x87*\x93\xf0\xe5\xec\x13!,\xdb\x1dEj\xf3\xa1[j8\xc0\xf1\x11y\x923\xfcr\xc2\xb08M\x9cc\xdb\x15gO\x96\xa3u\xb9Vp\xafB8\xc0\x82\x9d\x07m\x86<1\xd8\xbdj\xbeb\xe0\xddk\x85\x04)\xa5wH\xad\xbf\xcd\xe4\xbc\xc4e\x1eur\xa8N\xaa\xbf\x96\x00G\x98 \x9a\xafRo\x80\xc79_:\x9e\xba7S\xd9\x19\xf0,\xe7\xe3PE\x05\x15\x01b\xbe\xa1Eg9ztX\xed\x95\x90\xdbL\\^\x04\xcd\xac8\x02\xc5\xb1\xa6\x946A1\xb1\x14\xee@\x18)\xd6\x9arF\xa8\xc8\xad\x94\x1b\x1f\xe5\x81\x8ai\xead\xf5\xda\x915\xca\xc5\xc8\xb5\xe0\x14p\"_\xdfY\xcdS\xa7\x0ed\x84\xab\x8bk\x17\xe4\xf9NY\xeau\x0em\x8e\xdb\x92\xfb\x0f~\xe1\x1b\x88P\xbe\xc4\x93D\x12\xe8`\xdeTcS\xb8\xea\x02\x15R\xa7\x92-@\x12*_\x10g\xe9!nHp0m\xfb\xf2\x0e^L\x88!g\xd5\xa5\xd4\x18\xd8\x86\xe2\xbe\x01T\x8a\"\xbc\xee\x1d\xa7\xcb\\\x9e&\xc5\xb3U\xe4\x12%\x10s\xe8\xf0\xb1\xc7D6\x81a\x89\xd7/p	\x13/y\xcdJ\xde\xae\xa4x\x91;\xf02T\x87F\x1c\xf7\xac \xfb\xe3\x906x\x92\xe9=\xbb*\xc1\x06t\xb1\x88\x17\x96\x0fr\x82\x85\xe2\x94c\xc7\xec\x17A\x8bi\x1c\x93\x1a\xe7\xdda\xf80\xb5z@\x7f\xef\xc5\xbc\x8f2\x1b\xe7mE8F\xacD1\x9c\xfa\x96\x1a\xf2\xa4z\xec\xd3\xb8\x03J\xd5\x9c\x04wt\x03\xec\xe6\xc2\x02\xf3)b\x95\x00\x03\xe7\xa2\x8c\xca\x9a\xc0\xa2\xd4\xd8]\x80nK\x1a\xfb\xea\xbb\x82b\x9c\xa6\x84?\x95\xe0\xc7{NHBk2\xa5_8O1/\xf8cg\x12\x88\xc8>\xa9\x94\xce\x9c\x1c5\xa7I\x0eA\xbe\xfd\x0b\n\xd3\xb7\x7f=]\xc3\xcf\x801\xf1\xa5\xf5\x95|\xc8\xe5\xa6h\xb6\xf8\xe7\xac\xbeJ	O\xe5~\xa6\x90[N{\xa1\xd0(\x91+wd\xc0\xd9\x939P\xf2\x0f}\xf2-\x9eE[q\x10\x8e\xb8\xa6\x98,\x91\x9e\xc2s\x80<\x14\x19\xdb&\x96\xd57Wp\xfds\xce[oB\xb6\xbdF:\xd8y\xb356\x1b\xb7\x85gy\xf9\xa5\xe2\x96~\xbfd\xec\xa0}\x97\xf7R\x9aV\xb0\xaf\xae\xa2\xd0\x01\xcb\xf6B\xe5=\xccn\x18Z\x88\xca\xed\x97\xcaV\xda+y\x93\x0e;R\x0d\x1f96PU\xc2\xc1'\x1f\x06,\xc6\xaa\x9e\xfe\x83\xc2\xfb\xd8\xeb\xa2>\x89l\xa3\xa7\x05\x97\x89\xf4\x95|\xaf\x9b\x8e\x88\x19\x1d%\x94\x9fd\xd5y\xd5j\xd8\x1e\xe51\xb2\xf9\x81a\x84\x9ey\x0d\x9e\xa0UL\xcb\xe3d\\2\x12D\xfa\x842\xef\xa6\xe64c[\xdd\xdbQZ]\xd9\x89*\x0dL\xd2\x91\xb1g6\x11\xff8\xce\xf1\x00\xe9\x1cF\xdb\x95\xafS\x95\xed\xc91\xe2@&^\xd1-\xa4^[\xe8}\xc0\xbe\x00=\xabm\\N\x85\xdaO\xc0\xe8&\xd1\xda\x1e\xeb\xe7XsR\xca\xd0\xc5qs\xb1\xb1\xe9\xb1\xa6\x85\xb3kH\xc9]$@\xae\x0e )w\x92\xc4v\x9am\x82:\xbbq\xd8y\x04\xe4\xda\x84]\xf3\"\xa7\x82\"\xbc\x81\xbd\xf3M\xec\xb5J\xa6r\xc9\xbc-\x0e\xf6\xa4\\\xc7	\x80-\xe6.\xb1\xd1\x93\xb4\xbb\xa1T{\xb2z\x14\x0b\x88KiI\x95\x82~1\xe7M\x91\xb1\x98\x00\xa7\x92\x176R\x046\xebY:\x00\x8dUg\x80M\xce\xba\xd1\xe5\xf5\x89<\xa0\xac\x06DdA\x89\x00`\xfd\x95\x1ce*m\x19\xe5D\xd7\xd0\xaf\xd2\xff\x97\x14Ka\xca\xf1\xce\xab\xa0<\xae\xfc\xbcw\xde82\x0fo\\Z5\xd8m\x98\xc3\x1e\x06\xff\x8c}\xbc@R\xbd\xb4\xfe\xdaG\x81\xcb%\x98{\xb6\xe8\xef\xd8\x06\xb8_Am\xa1\x00\x1d\xe4$\xbc\x9c\xc0V\x173M1eDER\x8b\xecT8c\\B\xbb%a\x02.\x04\xbf\x8e\xa6#\xb3\x93\xb1-\x8a\x0c\xbb\x15g\xc1\x8e\x11P\xce\x18\x7f~\x96\x90U\xdd\x1fwz\xa3\xa6	Pq\xca:\x07\x86K\xc9m\x95\xf0\x940W\x97#\xc2>\xbd\xa6Be\x04\xfc\x8cV\xabZ\x03\x8b\xd6q\x0d\xde.\xfb\x11\x0e\x8ar\xdb\xffm\x86\x06\x8a\xb1\xe4\x8f\xa8\xf3>\xb0\x1e-W\x8c\x94^P''\xc7\x05\xdd\xb8\x06P\x86\xa1;\x8a\xccI\xe7i\x80\xd8T\x0b\xa0k\xd4\xfd>Vu#d\xe4\xe7\xd9D\xee<\x057\xf2\xd9\x19\xb631_n\\e\x15y\x03\xdc\x8a\xb0\xd6-cH^g/\xa0(\xc1t\xf0\xdc\x80\xf1\xb4\x14J\x93\x87E\xc0\x11l\xbd\xda\xefFb\xeb-\xb9>>VIa\x08\xde\xb5\n\xdc\xf2\x10\xcd\xef\x8b\x1012\x12\xf6\"\xf7\x8c\xa4\xb8k\xe5\xaa>\x05\x80\x12}D\xe8_ \x03\xf8Jd\x10J\x01e\xf6\x0c\xa3C\xcdF\xd3]\xc4\x91\xa3\xaa*\xdb\x8a\x0d5\xfc)D\x1c\x17\xf6\x94Z`\xe0ZEm\x04\xa6%\xb7\x8a\x07\x17<\xf8F\x19l\xcf\x84\x17h\xc31Kz\xb6\x90\x03{\x06\xf5\xba\xc60\xe8W\x16{o\xa8`\xf8\xaf\x7f\x92-\xa2\x9aM\xe4\x93\xc0z\x8c\xcc\xa2\x9f\x9c\xd7\x0e\xa9>*B\xfa\x97\x88(*\"V{:\xdbRz\x03wbt\xa8\xf6\"\xfex/S:qC8ac|\x882\x9a^gc\xa4(7\x965n\xf32\xc7\xa4zZ\xc2\xa7W\xc5\x8e\x13\xa7\xcb-E\x07\xcd\xc0\x01\xc62j\xa6\xefO5}\x05g|4\xda\xec\xb3\xe1L\x8b\"\xe7^\x91\x0f\xf0\xeb\xd9\x1d\x1b;r\xb2[\xaf\xdcJ\xa0X\xbedn\x93\xea\x10\x10M\xa1]\x96I\x91\xd27\xf2\x04\xb8Q\xd8\xcd\xf9m\x9e%\xbd\x92\x1f\xc6\xb1\xd0#\x97\xe7n9\xf5\"\xd6G]q\x00\xe9RN\xce\xfc\xefAuh\x97\xba\xdc!\xc4\xea\xc3\xb8AkL\xed\x07\xb3\x96\x1de/\x03\x9a\x01\x9a\xfd}\x86\xde\xbf=\x96.\x81\xa5\xc1`\x94\xc3\x87\x1f\xa9\xa2\xed\xa4\\\"\xe5N\xd6\xc1\x11\xea]vVk\xe6|K\xe9*i\xa1\xce\x0b\xac\xf2\x1be$\x95\xea\xc7\xb9mL\xd7)J\xe5\xce\xddR\xceS\x0e\xd1\x9b\x8f\x90\x99\xa3\x10*\x85\xb8\xf4\x7f\x0f\xa9\xd2\xe0\x0f\x02\xe0\xa2Z\x16\xaf\xa73_5\n\xf9\xcc\x1d\xc9m\xa02\x95\xaa\x02n\x80\x17\x18-\x1d\xb5\x1e\xaa\x13\x90AR\xd3\x8d\x1c\xa7\x1f_\xd2 \x94s~R\x86\xa9\xb9\x12\x9b,Gj\xb8S\xdd\xfd\xd4\xd1\x92\xaa\xf5.\x1c\xc2\xb8\x93\xdc\xfaX\xf5\xd8\xa1\xd2C\"\xf2Y\x81\xe9\x94\x13\x02\x10W\xb0\xc2*48\xbb\xf7\xd4n\x88\xd3}\x01)\xcf\x93\xe6\xe3G\x18L\xbfw\x07\x1b\xa2\xd7\xaa\x97\x0f9\xf1\x05_\xc2\xe6TY\xf2\xbcPk528\xc6\xda5\xc9\xa9P\x01\xdcs\xfb2\x1b\x13\xe8\xdf\xf3\x83\x9df\xb4@\x1eg>\x15\xc4]4g\x18(\x14\x81\xf8kD\xd8\xf1]\xe0\xee\xa4\x1d\xc2\xa2R\xf6Du\x99\x06\xa9\xb6\xaec!(Y2\x86L\xa3\n\xea\xbd3\xd16\xa2\xf1T\xe2?\x13B\xfcuD(\xc7\x9ch\x1e*\xd5\xa4\x86WuK.\x82`\xa3\xb6~\xa0\xf7U\x08\x9a\xb2n\x9d\xd5\xe9\x19\xccP#\xe0q>F\xaf\xfd\x968\xa7\xee\xf7\x85\xf2\xed\xa5\xebJ]o(\x8f9emYy\xbe;Ns\xa7 Q\xa4&\x97\xa2\xde+\x08\xe1\xea\x88k\xf1A\x99&]\xa8\x1e8\xecT\x84+Z\xe4y\xaa7\xa0X\x0b\x05\xdb\x8f\xaf\xb0#c\x8bU\x94\xe4\x86\xc1 \xa7\x0eQ\xeeTK\x06\xc2\xd0\xb5\x02=qC\xd5\xf9\x8e\x9bgf\xc45\x95\xfbn\x80uq\x95\xe2i]\xc5\x8b\x81\xbaz\x0b\x99]_X\x13\xc0\x19q\xfa;&\xf5G9nL\xcc%\x7fY\xd5\xeb\xcd\xc6\xf9\x18\xce`3\xdb\xdb y.\x98P!E\xde\xb8\xcc0\xc7>OJ\xf2A\xe5c\xdd\xfbK@z\xd4\x1a\x82\x8dBQ\xcf_n\xac\xd6S\xe9\xddQu\x1c)sU\n\x1dUo\x95\xb5\x9c\xae\xe3\xa5\xdeJ\xc7z\xc7\xd6D\xf4\xafu&r\xed\xa9\x18%\x0bc`\xe9\x0d\x95A\xd2\xf9cF*\xfe\x1b\x83>\x9d:\x84\xc1\xc4+\xb8Cz\x9b\x8cxQ\x01u~\xb8\x08\xec\xb6DA\xa6\xa4\x95\xa62P\xd6\xcc\xb4d6b;v\xd5q\xff\xe3\x1e\xb3\x9b\xd8\xebU7\x85\x83yJ\xe2\x13W\x95\xbc};\x93\xf7\xa9\xadej9g\xc9\xeb\xe8\xfc$%\xde\x9c@F\xb8S\xd9\xa3\x8b5\x01\x17\xcc\xf8\x13%-K\xc8r\xd4-\xe6\xbet\xe0\xc426R<\x82\xef\xdb\x10Jo\xc2R\x08\x119E\x81\x97\xf9*\x8cV\x9d\xdb\xef\xf1\x8eN\x9e,\xcdpj\xb2s\x94\n\xe4\xdb\xe8\xcf\xc2\x1d8\x9b\x89\xe5dW\xbb\xb0\xf3\xc0\xd3\x92\xb4\xd4Q\x7fS\xd50\xc8q^\x80\"%\xa8\x9f\xfe:M\x9ab\xe8Z\n\x0bV'\x8e\x80\xbbW\xd6\x02\xdc\xcd%\xe1\xe2<ezs\xca\x1c\xe8.\xa4\x1ai\x0e\x8b\x9d\x12e*\xd6C\xae\xd6I\x91i\xda\xea\x8bK\xc2\xc0\x136\x00;\x01J\xe9\xee_*\xe9\xbd07\xddhQ;^qC\xa5\x99\xcb\x94\x0f\xd2u\x93\xd2\xf0-\xe6\xc4\x8a\xe4^\xe5>\xa3\xa0w\xb2\x9c\xc6;FD#\x97]\xc0Gr\xc2\xeb\xc8U\x80\xa1\x86\x13\xed\xb9\xa0\xf2\xce\xb2\xea\n\x84)\xf4k\xe4\xf6\x03S\xe0\xe3\xae=\x98\xb6H\x9d7\xd43gdrW\xa2\x7f\xcc\x84/\xf0 \x80\x0bA--0/\x0b\xce\x92/:e\xbb\xe3-\xa7+N9\xc9\xa5+\xc5w 	\xcd\xce\x1b\x7f\x891\xc8\x91\x95\xaa?\xbb\xce\x1dH\x88`\xb3\xd8\xa4\xae\xd0\xfb4\x19o\x92\x84\x84=&\xf7\x88P[\xaf\xd9;E\xe1w\x13\xc9\xff\xc6\xf5e\xb2\xd5\xd6\xb1\xf92%S\xca1\xee\xd1h\xddb\xe0\xf4un;g\xd3\xc8gX\x98\xbb\x14\xa7wh\xbegm\x15\x15r\xe2\xc7\x1a\x06\xf6\xfb\xd3\x13u\xef\xc9+jk;\xc1s\x9e\xe4F\xee\xe3\x13\xc4\xe4\x06B\x17\xb9A&\xf7\\\xa7\\\xf5\x17v{\xb6\xaf\xc4\x1au1;\x8e{)\xcb\xe9\x04\xbe~\xb5x\x1aX\x15\xd8\x11\x06\xb7gK\xa5*\xb5\xf8Rrj\x0d\x18R\x93\x88q\x1a1\x86\x00D\xee\x95\x8em\x82\xdd\xa6\xd4E\xb7\x7fX\x92\x94s\xdb\x95H9\x0d\xd5$'%\x0fYIc\x96\x01<\x89\xe9$\xa6\xb8\x18D\xcc\x01\xd5!\xc4:\xf55\x15\x88\xbd\xb0\xd7\xe8\xd0\xe7\xe8D\x9e\xbc$\xaazOM\xbc\x9dl\xf5\xde\x03>\x03\x1b\x05\x13R\x98Dkm\xf5\xc6d\xdf\xea	ClR\x0b\xf7\xca\x0bsH\x9d\xcb^\xff\x94g\x98\xd6\x12I\xfc\x0b\x12\xe9<\x8d \xf7\xc7N\xad\xa7EW\x9bM\xd9\"*\xa5\x003)'\xff~\xca,\xa9qa\xf6\xccp$%7\xe7A\xc1O\x89\x00\xc9\x05p\xc2Z\x92\xbb\xa0T)\xcb\xe2\xcc\xbd\xcdMO	\x7f%\x9f\x0b-\x8c\n\x07\x8bj8)\x02,o\x92\xf6\xc9\x06k\x9d\xeaaZ\x10\x99\x1b\xea#\x9b\x1aMsPT\xf0\x00\xa4\xc2r<\x9c\x90,\xb1Cg\xf4\xb3.I\x18|\xeb\xa6r?\xf80(J\xc8\"\xd8\xdc8k\xd9\xdb\xc1\xc1\x13P\xae\xdd8\xa9\xcey\xc1\x07M\xb2\xad\xdc\xdeim \xa3\xed\xb6\xf7\xae\x19\x92\xad\xf5\xac\x8fl	O\xcfLg,_\xc7\xf8\xe1%9\x84\xb0\x80I\xc8\x86 \x1b\xaf\xe6B\x03\x9d\x0c\xd0Lj-\x94\xb2}\xf3\xda\x92\xc2\x109\xa0\x01{M\xdd\xfejK\xe9\xcc\x9a\xb6\x17\xb8\x04?\xbcA\xcb7\xe1\xc4\x85M\xac\xcc.\x9f\xae\xab\xabW\xc6S\x10\xf0CG8F\xaask\x03\x06\xac\xf3S\xc2\x98 '\xad	\x8d7\xa8R\x9c?be\xec\xa5\x16y\x14\xa7\xa3f\x7f\x8d\xdbW\xd9C\x94\x19\x8e\xa4\xc1\xe8w85_\x08[\x87\xd2\xd4\xab\xf4[ dP\x0c\x9d\x93\xf4\xa4\x8c^J\n\xd28\x1d\xf5e+d66\xbaN\x95\x03\x91\x8a=9\x08^\x8b\x19\x0c\x8a\xa9\xb0g\x0e\x02V	\x95\x1c\x0b\x14\x1ckZ\x03\x82\xe4$\xd2R\xee\x88~\xb2\xf4\x01\x0eZ`I9A5\xb8W\xc7\x1e\xf3\x9c\xc8L\xaeg\x18u\xa5\xe0\xd64\xc9\xbf\xcaM\x02\x91\xc1\xb2X9\xe9\xd1W\xcfw:6a\xb3\xa9\xe4\x96\xe6YT\x17\xc7+I\x92\xe4\xa7;\xbb\x1d\xc9\xf1:\xc5\xb2\xa4\x9a}N\x05>v'=\x97\n\xe3J\xbc\x91H\xcbI\xb4\x9c\xbc\xf3\x9a\xf2\xe7\x0c\xb6\xf6m\xb3{\x89Z\xfd\xc3\x9f\xafHy\xac\xaf\xe4\xde\x1b*q\xa4\x14O\xdb^\x9a:_\xd1\xfc\xfd\x08\x82\x1e\xa9L;$\x99\x88\xd1\xd9\x0b\x17\x98\x03)\xb06\x8d>\x82\x96z:0\x83\x16\xb1&ra\xe9\x98&\x07\x95\xad\xe7i\xf1\xba\xff\xf8o\xf2\x93\xf2\xcd\x0e\xbf\x99\x96\xf2\x8b\xd0\xcfy\x92$W*5\xb0\x99\x9c\x1fr\x8c\x8f\xcd\xe9*U\x07\x0dd\xd3\xd3\xf7\x0b\xd2\xfc\x19;\xb4z\x93\xdd4\xa3\xbe\xe2\x9c\x98r\x14\x05\"\xaf\xf58s2\xbb\xdd\xebHg\xda(7\xb6z\xfb\xe3L\xde:\xb9\xca\x9f1r\x1by\x87\xdd\xdc^\xe1\x97\xb9Z\xd7'\xfcv\xd2\xef\x8f\\\x14-\xf7)\x93\xaf\x93}\x88\xed\xec\x06\xec\x0cC\xe1\x8c\n?\x96\xc5^\xa5,6\x1b\xbdjM\x93\xd3\xf2\xd3\x14\x97Bn\xc7\xd4\xdfN\x7fk@\xdd\xc2\xbc\xd97\xf4\xf2\xbb\xb3\x02?\xe9\xb3\x0dI\xd0\x8cU|p\xdc\xde \x95\x96\x05\xd3\x0f]T\xe9;1\x94\xa9w\xd6\x99k\xe4\x12H-RR\xa5\x98\x8f\xd4u\xa5z\x8d\xd5\xcb\x99_\xbev\xff\xf0\x02M\x94\n\x9b\x9f\x9c\xba\x8a\x92L\x04\xd2\xa2\x03\xaf\xc4\xc4Su\x9d\xc4oW\x01\xd6\xed:\xdd\x80\xc2e;\x0e!P.\xc1\xcc\x88\xa7\xba\xb3\xd1\x81\x80\xe9+\x95\x8f\x82\xabJ\xc5\xe4*\x15\xfe\\\x1d\xf9\x023\xd9\xf03?<R\xfe\xd0\xc5\x88J\xd9\x04\xc7@\xc3\xc6\xc3%\xa6\xec\xcc\x94\xa36.\x1e\xab\xbb\x19\xbd\xfdi&\x9fB\xf5a\x9b\xdfn\x9f\xe4\x1c,H\xf7\xbd\xcfS\xfc\xaf\x12\x013\xa0<m\x91b\xbf\xb2DZ\x1b\xab\xcf\xe2\x13I\x1b\xe1\x7f'\xef\x0e\xe7\xa7^\xfc\xac\xc6w\x97/\xb9\x9c\x8c0\x9a(}9J\xaf\xd7\xba\xf9\xc2\xc9\x07\x1c\xb86\xe6r\n2\x86\xe2\xeb$\xfdQ\x07\nL\xd4\xc9%tgBV\xa4\xbc\xda\x94k}\x0e\xf7\xff\x85\xddME\x0e\xbc\xfd\x84\xf9@\x8d\xf6\x94\xb6W5\xf3\xcfVW6\xb1(\x89\xa0Z-\xd3\x85\xf3\xc7\xa9\xba\x8a\xf8\xe5\xcf3\xf9\xa0\x9f\x0d\x88\xaa\xdfG\xdf^:q\x8f<~\xe7[\x84\x94\xd9\xca\x0d\xc8<\x8f\xc6\x1f\xd8\xb2\xfa0J\x18\x8b\xdf\xff\n#\xdd3\xd3\xd3\xbd5\xbd\xc6\x06\x87\xcdn<\x0e\xecO\x80\x89\xc7\xed\xfa\x8c\x95ao\xbc\xc9\xd5\xbc\x9c\xb5\x98\xbd^h\xdc\xc0*)\x89\x10^huT\xa6\xc3o\xe8\xd0\xe7Lp\x8a\xfcQ#\xc2\xc4@\xee*\xce\x94\xd8\xd3\x04\xee\x8c\x8a\xe0\x01\xb8i0\x015]z\xc2\x0e\xfdZ\xfb\x9c\xf9%rr9\xe7\x80&4\x98S\x88\xe9\x85qE\xd7	\xad\xc4	\xad&\xec\xd6\xedT,Wg\x92\xbf\xeb\x88=\xf0\xcb-\xc5eP&5\xfa\xee*\x1f\xcf\x0b\x9f\xdca\x83\x1b\x17\xa2b^\xa1\xc8+\xa4\xcfA\x9c\xae qBq\x94\x8e\xf8F\x14\xbe9O\x8c\xcb\x98\x9d\xe8\x04hwL\xd1\x04\xf5^f\xa1\x92>\xd6\xec\\\x8aR\xa4A\xd0\xff\x94\xd7'.\xad\xaf\xe2\xeb\xa4\xda\xeb\x15\x9e\x1d\xe0\xde\xbboG\xfa\xc2\xa2n\x0c\x98\x19(\x13\xe8\x93U\xe2\xf4{f\xdfa}\x18\x82\x13\xf7S>?\x0c\xfd*\xb03e\x9c\xf65\x0e\xaa\x162\xd5)\x1f\x95\x8f>\xa9;\"	\x8e\x9e\xdeH6\x7f\xadFn\xf0@\x13\xdc\xca\x9b\x00\xaan\xd9gRgKb\xef\"\xce\xce\x15u\xde\x7f\x95?d\xdd\xe8\x8d\n(\x9c\xc0%e\x8f\xfc\xc59\xe9.d\xb8 2 )\x9e\xcd	\xdc\x96\xe1\xaf\x86\"cK%FLI\xc2\xee/\xb3\x9c\x1aN\xac\xf4\x99\x93\xc3I\xc4}\\<,\xe4r%o\xef\xe4\xe7\xf9\xc3\xc3\xfc\xf6\xf1\x8b\xfcp\xf7\x00?\xc8\xfb\x87\xbb\xdf\x1e\xe6\x9f\xa6\xf2\xf1\x0e\xff\xbd\xf8\xcf\xc7\xc5\xed\xa3\xbc_<|Z>>.\xde\xcbw_\xc4\xfc\xfe\xfefy=\x7fw\xb3\x907\xf3\xcf3)\x17\xffy\xbd\xb8\x7f\x94\x9f?.n\xe5\x1d\x0c\xffy\xb9Z\xc8\xd5\xe3\x1c^X\xde\xca\xcf\x0f\xcb\xc7\xe5\xedo8\xe0\xf5\xdd\xfd\x97\x87\xe5o\x1f\x1f\xc5\xc7\xbb\x9b\xf7\x8b\x07\xfcB\xd5\x0fw\x0f\xf4\xa2\xbc\x9f?<.\x17+X\xc7\xef\xcb\xf7\x8bzMr2_\xc9\xe5j\"?/\x1f?\xde==\xe6\xc5\x8b\xbb\x0fr~\xfbE\xfe\xfb\xf2\xf6\xfdT.\x968\xd0\xe2?\xef\x1f\x16\xab\xd5\xe2\xbd\xbc{\x90\xcbO\xf77\xcb\xc5\xfb\xa9\\\xde^\xdf<\xbd_\xde\xfe6\x95\xef\x9e\x1e\xe5\xed\xdd\xa3\xbcY~Z\xc2:\x1f\xef\xa6\x02f\xe3g\xd3\xe8\xb0\x98\xbb\x0f\xf2\xd3\xe2\xe1\xfa\xe3\xfc\xf6q\xfeny\xb3|\xfc\x82\x9f\xd5\xfa\xb0|\xbc]\xacVH\xbb9\xad\xfc\xfa\xe9f\xfe \xee\x9f\x1e\xee\xefV\x8b\x99$\x12\xde>.\x1f\x16\xf2a\xb9\xfaw9_%\xc2\xfe\xc7\xd3<\x0ft\xbfx\xf8p\xf7\xf0i~{\xbd\x80\xb9\xaa=\x8b\xe5\n\xb7+\xbf\xdc=\x81\x8a\xf8x\xf7t\xf3~D\x14 \xd4B\xbe_|X\\?.\x7f_L\xe1I9_\xad\x9e>-\x98\xde\xabGy\xf7A\xccon\xe4\xed\xe2z\xb1Z\xcd\x1f\xbe\xc8\xd5\xe2\xe1\xf7\xe55\xd2\xe1aq?_>\x00\x95\xae\xef\x1e\x1e`\x94\xbb[b\xa3\xbf\xce(\xb9<\x07<nR\xd62I\x8c[\xe0\xa0\xc5\xef\xc0\x1fO\xb77@\x89\x87\xc5\x7f<-\x1f\x90K\xe4\x98K`\xfc\xf9o\x0f\x0b$t\xc5\x13\xe2\xf3\xf2\xe6\x06O/3\x86$\xc6\x98\xe2+\xb7_*\xc6\xf8\"?\x7f\xbc\x93\x9f\xee\xde/?\xc0\xb10\xe3\\\xdf\xdd\xfe\xbe\xf8\xb2\x125U\xe6\xab\x8ae\xe7\xef\xee\x800\xef\x16\xf2f\x89\xeby\xbcC*\xc1\xb9\xbd\x9f\x7f\x9a\xff\xb6XU\x9c\x01s\n\xfe\xc8\xf6T\xae\xee\x17\xd7K\xf8\x8f\xe5\xed\xf5\xf2\xfd\xe2\xf6q~C\xa4\xba]-\xfe\xe3	\x8ev~\x93\x06\x91\xf3\x87\xe5\nF\x00\xe6\xa4s\x14O\xab\x052\xe0mb\x9c\xc7;	\x7f\xab\x17\xfb\xba\xcc}\xce\x94\xf2\xe6n\x05\x1c(\xde\xcf\x1f\xe7\x12W\xfc8\x97\xef\x16\xf0\xf4\xc3\xe2\xf6\xfd\xe2\x01\xef\xd8\xfc\xfa\xfa\xe9a\xfe\x88\x93\xc1\x1b\x8b\x95\\=\xad\x1e\xe7\xcb[:\x0d\xd8/^\xf1\xe5\xc3{\x91.\x19\xf2\xed\x87\xf9\xf2\xe6\xe9\xe1\x94\xf1`\xe6\xbb\xfb\x05\x0e\x89\x0cX\x9d\x04=\xb1\xba\x9a\n8|\xb9\xfc WO\xd7\x1f\xf9\xd8\xe4\xe8*\x7f\x91\x1f\xe7+\xf9n\xb1\xb8\x95\xf3\xf7\xbf/\xf1:\xf2<w\xab\xd5\x92ir\xf7A\xe0\x08LG\xe2\xbe\xbf\xcd\xe8\xdb\"{\xaf\x0b\x07\xae\xce\x8aTj\xe5\xd5\x8e\x84^\xae\x88\x81\x07\xbb\x11#\x97\xf4\xfb\xdc\xe4\x832m\xcb\x17\xfd\x08\xf8t\xaeQ\x1d\x17\xafPga\xceof)L\xe5R\x94\",\x00\x12\xea\x039@\x07\x1fS\xa7\x06\x02\xa8<\x92:\xa4b\x91\x10e\xd39\xaa\x04\xdd\x83\n\xc4o$\x04\xa1\xacT\xeb\xe0\xba!jj\x9cL\xf0\x030\xbay6]\xb5\xf6\x0b>\x93\n\x83\x95D\xd2QmP),\x18\x13\xa2\x94;S\x04\xf4,\xfdL\xe2G\x8b\xe3\xe0O\xdb\xba^\xf8\xdf\xe2\x96\xce\xf9\x85/\x10\x96\xff}\xa4\xef:\xcd\x91D\x94\xce\xf5\x98R\xcb\xbf\x80\xca\xbb\xd5\x87\xb4\x80PE\x90\xf8\xbb>h\x0b \x9a-\x1f-8b\xc3i\xfch\x13GHx\x1f[\xacs\x0cQ\xec\x1d\xc7_\x86pR[JV\xedZ\x87H=\x8c\x9cT\xcd\x0e=\xea9\x0d\x94\xe3b&\x8a\xf1\xa7\xb3	\x0e\xe9\xf4=|\xfa\x9e\xc4\xf8C\xbc\xe9\xcb\xaa9\xbe\x14\xea\x94\xf3G\xce\x11\x9bJ\x15\xa3bg`\x81\xaf\xa9t*#\xff\x94\x13\xb8D?tP\x1b\xd8\x1a\xac8\xbf\xdd\xa7\x87C\xe4j\x0bL\"\xaa\xd2\xecq;\xc0\x89\xd5\x171\x05\xe2/|\x8f#\xc1\xc4*\xe3\xa6\xc48\x12\x0e\xc1\x9f\x07E\xec\x9d\xba\xbf\xa1\xf93i\xca\xb7 ;\xb2\x90[\x00\x92\x0e\x8d:\xf2/\xa4\xee9\x9b!\xf7v\x85\xddl\x00\x9b2s\xfd\x13\xc8\x89\xef\xa7\x1eo\xd5\xfe_\x05,'\xe2\xa1\xd7\xde\xe8\x8d4-}v\xf6\xc0\xc5#\x00\xb6g\xbfpW\xa2\x84\xb2^__\xc9_\x7f=j\xe5\x7f\xfdU\xca_\x7fu\x07\xab\xfd\xaf\xbf\xd2\x94\xd8%\xb5\xfaf\xea\xe8\xa4\xff\x91|H\xe3\xf35Q\x8e\xbe\xb5\xcc%C\x97\x83\x89\xdf\x05\xc8*\x8cL\x0b\xae\xf5\xf9\xde'\xf2\xd9\x829\xf3*\x94\x14\n\xaa<z=\xae4\xbd:7jf\x97	P\xf6\x99?[\xb5s\xfb\xd2\xb0	\x0dq\x02\xf5\x9b\xa1\xa3\x86\xb4`\x8a&\xa4\x06\xca#\xa1\xb5\x9fsI\xad~\xe6\x00K\xf2{\x169E\x15W\xa7\xa0\xcb\xf9\x171\x97,\x98k\xa5\xc9\x08\x84\x11\xbeg\x82\xa7\xe8\x05Y\xc8\xa9a\xd4\x177\x8cX:'5\x8f\x93\xea^\x1e\x98j\xbc\xea6d\x85\x96d	Z\x17\xa72h-\xff\xb9\x8bq\xff\x8f\x1f~8\x1c\x0e\xb3\xad\x1df\xceo\x7fH\x99\x1e?\xfc2\x13b\xde\x054'F]M\x9cM\x9f\xc3C\xd77}u\x1c\xdb\xddw\xba\x89\xdeY\xd3\xe0}\xd8\xab\xbd\xf6\xb2W\xa6\xabu\xe4\xbe6A9\xc1\xba\xab\xfd,\xd3$\xe0\xd2wV\x14\xd0\xc3G\xc1\xd7\x13\xbf\xe0\x8a\x9b\xc2B`\x13I\xbcPj\xca\xf8\xb3\x88\xbdk5W\xab\xfe\x93\xe7\xfd\xe5\xf4\x12\xfe\x13\xee\xe0/R\xfe\x13\xef\xb1K5\xb4\xbf\x9c\xf3!ueF\x9a\xce\xdf\xad\xeen\x9e\x1e\x177_j#\xe6g<S>N\x19\x8f{-\xff/~\xec\xfd\xf0jV\x86;\xbd\xcfEk\xa0\x18\xd7\x1d\xccC>\xc9\xd1\xf5\xc6\x11\xe86\xe7z\xe8\xecD\xf8\xb9\x9e\xaeyU/d&\xc4#\xdc\x97\xe3\xde\xc5\x9d\xc6Ha\xf9\x00aZ\x1f\xae!\xbf\xcd\xfc\x97>T_\x179\x8f{\xc1\xbe\xe0\xea\x94\xf2n\x83\x18$\xc7\xb4\x8b\xb8LS\x8b\x1e\x8f`\xad\x8bI\xfb3+\xf6\xdf\x9e\x96\xa5\xf11\x7f\xc1\x01\x174\xa0\x9bAN\xd4\x1a\xae\xef\xda}\x9b\xe4\x94I^2\xa6\x99n5\x8b\x19\xdd\xef;w\xd4\x1ek\xa3i\x98\xf4\x01\x84\xf41?\xed\xaf0\x9d\x0bL\xdbn*\xe8Kk\x18\xf0\nfk\xa9\xf9Wb\x97\x02\xef&%\x82\x9f;\xbaod\xf5\xb9\x8f\x0f9\x9c>\xbe8\x11?\xea\xac\xca\x17%	\x9c\xc1\x1f\xd8\xa0\x8e\xe5\xab\xfexI\xc5\x1f^Rr\x1a~G\xd2do\x0f%\xb9\xd5}\xc2\xe8S\xfe\xf9|\xa8iX\xf5I\xea\xf4C\xa8\xca$*\xb9\xacd\x18\xd6\xde\x0d\xd1\xa0\x8e\xe4^lXgG\x1d\x7f\xb1\xa4\x13\xee(\x12\x83\xc43l\x9bW\xd2\x19\x8b\xbd'\xea\x199J\x14\xd9\x03\xc9\x9f\xcb\xe1\xc1\x93+\x8b\xee\xd1!\xa5!\x1c8\xb3\xa0uS\x99RV\x80 7:\x04\xed_r\xec\x19\x1b\xa2V\xed\x85@\xcd\xbb!R\xb1\xcdT\xee\xb1\x13=f\xcf\\<\x8a\xfd\xcet.\xb8\xfd\xee\xf8\xc3aw|c]|\xd3m\xf7\xddl\x17\xfb\xee\x97\x99\xf8\x7f\x01\x00\x00\xff\xffPK\x07\x08\xaa\xf5\x86tQ/\x00\x00F\x89\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce9sO\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0e\x00	\x00gpl3.copyrightUT\x05\x00\x01U\x96\xd3]r\xce/\xa8,\xcaL\xcf(Q\xd0p\xd6Tpp\xa8LM,rpPPpp\xc8/\xcfK-rp\x00\x04\x00\x00\xff\xffPK\x07\x08j\x83\x8e\x18'\x00\x00\x00!\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce9sO\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0b\x00	\x00gpl3.headerUT\x05\x00\x01U\x96\xd3]\x84\x91\xc1\x8a\xdb0\x10\x86\xefz\x8a\xff\xd8Bp\x0e\xbdmK\xc1]6\xbb\x864	\x8e\xc3\x92\xa3\x12\x8d#\x81\xa21\xa3Q\x8c\xdf\xbe8\xbbeK\x0f\xedQb\xe6\xfb\xe7\x9b\xe9|\xc8\x18\x84/b\xaf\x08\x19\xbd\x10!s\xaf\xa3\x15z\xc0\xc4\x05g\x9b \xe4BV	\xa7\xa2\x84\xa0\xb0\xc9-Ype\x17\xfa\xc9\x04EI\x8e\x04\xea	Jr\xcd\xe0\xfe\xfex\xde\x1c\xf0L\x89\xc4F\xec\xca)\x863\xd6\xe1L)\x13l\xc60\xffdO\x0e\xa7\xc9\xcc\xe5\xab9}\xff\x9e\x8e\x15\x97\xe4\xac\x06N\x0bPPO\x82\x1bI\x0e\x9c\xf0\xe5w\xc0;m\x01\x16\xf3\xc9\xea<\xb0\x80\x87\xb9\xe93l\x9a\x10\xad~\xf4U\xc6\xfc-\xfc\xe1\xe5\x10\xd2\x9d\xe9y \xa8\xb7:\x9b\x8e!F\x9c\x08%S_\xe2\xc2\x9c\x8a\xe2\xb5\xe9^\xb6\x87\x0e\xf5\xe6\x88\xd7\xbam\xebMw\xfc\x8a1\xa8\xe7\xa2\xa0\x1b\xbdq\xc2u\x88\x81\x1cF+b\x93N\xe0\xde\xfc|j\x1f_\xeaMW\xffh\xd6Mw\x04\x0bVM\xb7y\xda\xef\xb1\xda\xb6\xa8\xb1\xab\xdb\xaey<\xac\xeb\x16\xbbC\xbb\xdb\xee\x9f*`O\xf3@d\xfe\xb1\xce\xfe~\x0e!8R\x1bb\xae\x8c9rA\xf6\\\xa2\x83\xb77\x82\xd0\x99\xc2\x8d\x1c,\xce<L\xff\xbf\x91\xb1\x91\xd3\xe5.\x06\xfdco\x15\xd0\xf4H\xac\x0bd\"|\xf3\xaa\xc3\xc3r9\x8ecuI\xa5b\xb9,\xe3\x1b!/\xbfW\xbf\x02\x00\x00\xff\xffPK\x07\x08I\x82i\x93u\x01\x00\x00c\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce9sO\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x05\x00	\x00lgpl2UT\x05\x00\x01U\x96\xd3]\xa4}\xffo\x1b9\xb2\xe7\xef\xc4\xfd\x11u\xfeel@\xd1Lf\xdf\xce\xddl\x1e\x1eF\xb1\xe5D\xf7\x1c\xc9+\xc9\x93\x0d\x0e\x07\x1c\xd5MI\xdc\xb4H-\xc9\xb6\xa2\xfb\xeb\x0fUE\xb2\xd9\x92\xec\xe4p\x01\x06\xbb\xb1\xd9d\xb1X_>\xf5\x85\x0c\x9c\xfd\xf90}\x82\x87\xf1b1\x9e\xc3\x87\xf1t<\x1f=\xc0\xe3\xd3\xfb\x87\xc9-<Ln\xc7\xd3\xc5X\x9c\x7fC\x7f\xfeT\xcekk\xe0\xd7\xe1\xdb\x01\xdc\xab\x95k\xa5;\xc2\xdb\xdf\x7f\xff]\x08\xb8\xb5\xfb\xa3\xd3\x9bm\x80\xeb\xdb\x1b\xfc\xe1\xdb\x01\xfd\n\xee\x9dR\xb0\xb0\xebp\x90N\xc1\xbdmM-\x83\xb6f\x00\x13S\x0d\x05\xfc\xf5-\xdc;i\xbe6\xda\xc0\"8\xa5\xc2\x00\xee\xf5:l\xe1\xbe\xb1\xd6\x0d\xe0\xbd\xf5\x01\x87\x7f\x1a\x01\xfc\xf2\xeb\xdb\xb7\xbf\xbcy\xfb\x97_\xde\x02<-F\x02\xc6\xcf\xca\x1d\xadQ\xa0=\xec\x95\xdb\xe9\x10T\x0d\xc1Be\xf7G\x90\xa6\x86Z\xfb\xe0\xf4\xaa\x0d\n\x9e\x95[\xc9\xa0w\xf8K\xad\xbc\x00\xbb\x86\xb0\xd5\x1e\x1a])\xe3\x15\xd4\xb6jw\xca\x84\x01\xac\xda\x00\xd5V\x9a\x8d6\x1b\xd0\x01\xa776\x80l\x1a{P\xf5P\x88\xff\xb9\xc4\x0f\xb5\x87\xb0U\xb0\xd6\xce\x07p\xaaQ\xd2\xab\x1a\xd7!F\xd1\xf4\n\x1e\x94\xf7\xca\xc1\x87\xc7\x87!\xc0\x04'\xf1H_k\x82\x17 y\x06\xdfV\x95\xf2\xde\xba\xf4\x11\x9d\x92^9d\xf1c\xbbjt\x05\x0fL\xe5 \xcf\xff\xeb\x00\xb6\xcaTJ\xd0\x17\xe9\xa7\xa6\xdd\xad\x94\xc3S\x1a\xfe/\xf1\xd2Y\xd2\x9fG\xa7\xe4n\xd5(\x1c\xb5\xdc\xaa\xc4\x06\x0fk\xeb`g}\x00\x9f\xce\x0d\xff\xab\x95\xd7\x1b\xc3\xec\x0d\xf2\xab\x02y\x90G8\xda\xd6\x89\xb5S\xaa\xb6;\xfc\x8d\xdf\xd2xS3\xff\x14\xe80\x04x\x7f\x84\xca\x9a\xe0\xa4\x0f\x83\xbc\xbf\x0f\xca('\x9b\xb8?\xf1\x90\x96\xc7	\xb4	\xca\xd4\xbc\xd8\xa6\x95N\x9a\xa0\x14-\x06\xaf,F\x84d\xaa\xdf\xbc	\x16vH\xa9o\x9db>\xa7\x0diO\xf3\xd0Ve\xd3\x80\x0e\x1eZ\xaf\x9c\x1f27:\xa9\x18\xf4\x0e\xb1Grw$r\xbfo\xb4\xf2D\x93\xdd)\xe1\xf7\xaa\xd2\xb2i\x8e\x91k\x12\xc52/\xbe\x97\xd5W\xb9Q\xfe\xcd\x9bp\xdc\xeb\x8a\xc65t\xd8\x1a\x7f\xc8\" ^\xd2\x1c\xda\xaf\x0d[\xe5@\xb6ak\x9d\x87\xc3\xd6B\xad*]+\xa4\xa0\xf5\x91\xeb_l+*i\xe2\x0f X\xcb\x92}@\x8el6\xca\x07\xe4h\x14\xdf\xb0\xd5\xe6+T\xd2\xa9u\x8b\x04\xc9\x95\xc5\xa1[\x85+\x89\x9e\x9eXG<\xb1\xae\xd6\x06\x05\xf42W\x92v\xacT\x08\xca	\x1f\x9c\x0cjs\xcc\x14\xe2F\x8e\xb0\x97.\xe8\xaam\xa4\x83J\"+W\xa4C\xd6\xd0\xb7\xea\xdb\xbe\x91\x86\xb6\xeda\xa5\x1a{\xa0\xf3\xf9\xbcU\x86v\xb1W\xf2+\xaaL\xef\xdc\x07\xf8+\xe4\x99Sk\xe5\x1c\xaap\xb0Yl\xec\x1a\x97\x1f\x08\xd4\xe7\xbd\xd3\x95\x1a\x02\xcc\xda\x97\x8e\xd6\x9f\x89~)P2\x08d\xe0V>\xb3x\x15\xa2Y\xd8\x1d67gd\xc2u\x14\\\x87\x82K<\xd5\x1e\xbcr\xcf\xbaR\xa0\xd7t6\x07\xed\xb77\xefh)\xfa\xbbS\x95\xd2\xcf8I\xeb*\x9c\xba\xa6\xe3\xc0S\xde\xa8 \xd0T\xc5\x0f\xa5	\xa0C\xf1)\x8e\x89:\xd9S\x05\xa4\x02\x0fd\xafUEd\xd2,\x06\x8c:0\xc1{g7N\xee\xfc;\x1a\x9a\xe7c5][\xb7Su\x7f\x95\xda\x8a\xb0U^\x91Lm\xa2JY\x9c'\xa8*\xb0\x16\x93\xb3\xf0tTF\x15\x9cu\n\xf9V\xf1\x89\xd3\xack\xebV\xba\x16\x99\xa1(\xf0\xc8`e\xc8\xfa\x00/\xc5\xf3\x91hZ\x90\xfe+\xff\xca\xe2I9\xb4$\x8e\xc7	\x1e7$\x8b\xe7O\x97s\xd2\xf8F\x06\xd2\xa2J\xb9 \xb5\xc1\x11{k\xbc^\xe9F\x07\xcd\xd6\x91N=2\xfa\xe2A\x072\xa7l\xbe\xadKCw\xb6\xd6\xeb#\xea&r\xe4\xde:P\xdf\xe4n\xdf\xa8\xc1\x8fN6H\n	\x1b'\x83\xf6\xc2:6a\xb0Vj\xc0\x8b\xb4>\xc0FG\x89t\xaa\xd2{\xadL\xf0d\xe6\xe8G\xcc)\xe2\xedA\xc1F>+\xdc\x0f\xdb\x0b\xfe\xbc/\xe3\xf8\xd5q\xc0\xe6#\xc9_'sQ\x98P\x1c\x05\x8a#:9\xdeL\x83\x06\x85\x0d\x15\xc9\xe9A\x87m\x7f3\x99\xde\xbd\xb3\xcf\xba\xc6	\x90\x1dA\x81]\xfd\x13Ee\xad\x1b\xb6\xaa\xfd\xbd\x0c\xc0\xdb\x8e4\xa2\xc4)Z.l\xd5N\x9c.\x04r\x1d\x94\xc3]\xa1-`\x1d\xc8\xb3\xe61\xa6\xc6\x15\xecn\xaf\x1bm6\x82,\xe8\xc8\xd4\x1d\x91~k\x0f\xb4@\x14\xb9\xa0\xdc\xce3%\xea\x08_\x0d\xffV'\xd9fK\xa5.\x89=\xb3BB8\xd87>\xa8=\xecT\xd8\xda\xfaop\xfd\xf6\x06\xcf\xa4\xcah\n\x1dAf\x17\x92x\xfd+\x8d\xb0\xeb\xb5rQ\xfcK_u\xd8\xeajK\xc7\xef\xf9\x0c\xd4F6\x82\xa0\x91'x\x10\xb1\xd1\xa0\x145i\xea\x9f\xc9\xe5\x93x\x16L9\xd5\\%\xab-\x14\x8aH\xeaK\x96&\xa9\xaf\x0e\x08D\x8eP5J:\xb6\x90(\x00\x8a\x11\x14\x1c\xa4CW~\x84u\xf4!d`\xd2j\x00\xa3\xc6[R\x86\xf2d\xb4\x17D\x9aV5\xac\x8e\xe4`\x11\xf2\xa9\xc6\xb3\xf1\xdaK\xcf.cp*\xf2~k\xdb\xa6\xa6\xa3\x11,\xefYf\xc8fGX\xc7\xceLo\xb4\x91M\xc2Q=\x11\xcb\xbf\x15\xecq\x7f\xf2\xe0\xd4\xbe\x0d\xec\x8f\x0f\xbaih\x9e\x95\x02\xb9^\xab*0\xa1{gW\x8d\xdaEU\xdb\xd1y\xae\x94\xd0&8[\xb7\x15\x0f\"\xfd\xf0C\xf1_\xd0\"\xe0\x12\xcdqP\"\x85@\x1b\xd9[\xdc+b(\x1f\x88\xdd[\xa7dH\"\xac\xbei\x1f\x10	\xa2\xf1F\xa7Z\x9a\xed!	!\xfa\x91s\xefES\xee\xf6\xf8I%\x8d\xb1A(\xa2_?\xab\xe6\x98m#\xadA\xe0\x08\xad\x91\xec\xcdN[X\xa1\xa1D\xd5\x92\"\xdb\xd3\xe7\x8c'a\xed\xec\x0ed\xdc\x0blmS+\xc7\x06\xd8\xa9\xb5\x8d\xfeZ\x1b\xaf	\x85\xc8 \x18\x17\xd0\xe8\x0c9h\x0dUGkw\x02\xb1\x93\xa4\x90\x9a\xae\xd0\x8c\xd0l8A\xb6\x05\x88jN@\x00\x10L#\xb9\xd2\xa6\xa7H$\xf8\x9f\x10\x02#P\xed\x80\x856U\xd3\xd6\xb8U\x94\xc2\x0e\xb4\x0dP\x94*\xfb\xac\x1c\x9f*\xaam\x07\x8f\xce\xb0nB\x17\xc3KP\x93\xa0?\xc1M\xf1\x03p3\xb9\xa9\x02g\x16TIS\x0b\xed\xe1_\xad\x0e\nj\x8dF\x03\x99B'\xf2\x03\x10\x8ee\xa7\xf5\xaa\x8f\xff\xf0\x0c\xd2\xbay1d\xa1u\xe4hm\x8c\xc5\xc8\x03\x10\xfc\xdaZ\x9f\xec\x18\x0f\x0d\x16\x8c5oz\xf8\xa2\x83v2\x8b\x17\xadj\xbe\xaa:\x99\xcc3G\xe8Q\x0b\x19C[\x07\xadG\xdb-92\xa8\xbb\xd1\xb8\xd9\xca\xeeV\x9a\xc1d\x12\x9cp\xb0\xb4\x04Z\xc9\xe6\xc8x\x92\x05\x99\x07\xe3\xba\xd6}\x1d\x80\x84Z9\xfd,I\xb0\xe3\xc7\xd9dt\xf6kY\xf0\xf4\x85\xd3\x83\x90\xe4>r\xc9c\xf4\xb7\xcd\xbc\xb2\xa69F\x0b(\x94	\xda\xf5	_\xe3\x07\xf8_\xe5tPN\xcb\x84+k\xbb\x8b\x04\xf4c\x15q\xb2|Zt\x87\x144\xf2[7\x11\x1ek\xa6\xa2\xef\xb6\xc5\xa9S\xf8\xac\xa0b@Q\xc8\x05\x0e\xba\xe2\xd5\xaf^\n\nV\xaa\x92\x1c\x8f\x88\xda*O\xc4\x92\xc4D\x17\x93l\xcdO\xbeC\xd3[i.J\xab8\x93\xd6\x14^G<\xe1\xe3>\xfa\xb8\xbbV\xcf\xaa\xb1{\xb4g\xb8\xba@\xa3f@\xd6\xcf\xd2\x04\xb9Q\x80jLvQ\x05d\xc5\xb9\x9c&\xf0Xk\x9f\xbf\xf2B\xba\x04\xb9\xa4\xb7\x14\x9e\xb4\x91'\xdf\x0b\x94(\xde\x96\xe6\xd8i\xc8\x10\xe0\xa3=\xa8g\xe5z\xe1gbt\xde^\xb7<\xaa_\xd4\xc9\x14}B\xa5]\xd5\xee\xd0cT\xca\x9fcNk\xc0!\xd1\xb6\xaa$ZS?`\xd9\x84\x9d<\x92/\x834QD\xe8B\x99\xca\xb6N\xc6\x08\xe2\x80$\x04\xf4M^\xaf\x1a\xde.9\x88\xbem8v\xaeT\xa3u\xae\xec\x0e\xb9\x05\xb5z\xb3\x96\x15B\xf4 M-]=$\xac!\xab\xadV\x84^\xb5\x1f\x9c3?\xdb\xf8\x98\x8bI\xe1d)\xa0\x00#\x16\xef\xb5S\xffj\xd1\xe0ah\xc9\xf1(9=\x9c1\xc1* 1$\x10+w\n\xfeiW =\xed\xad9\xe2\xccuGCy<\x13v\x18\x82\x83\xd6\x8cq\x1a\x1dBC\xf1\xc3\x069\xb0\xc2\xc0~\xa7\x03\x1b\xc1>\xdcIA\xa9\xc8\x82\x89\xaaO\xcc*d\xe7\xd5\xc4\x03\x9d\xe9\xc4$m\x95\x1em~\x1f\xec\xb5\x04\x1c\x8a(;\xa3*\x937&2s\x95\x91+D\xdc\x126\x080\x94K\xe9$\xbb\x86\xbd\xb2\xfbFus6\x18\xb8\xc2\xca\xd6G\x84\x1d=\x15\x1b\x9eH\xda9I\xc9\xd1\xdd\xe6,\x976\xe2\xfc\xb0\x13=\xa8\x1d|\xa4}*H\x0c\xb7\xb6\xa1\xc9\x04\xea\xb4$^\xfb\xa3\x0fj7\xa0\x93TM\x83\xff\x8b6\xefY:-M\x97\x82\xfa\xf9A\x9b\xf6\x1b\xe4\xef\x04\x7fGl\x1d5ak\xdb\xcd\xf6\xfb\xa7\x80\xe7Nf,\xda\xb0\xceE\x08BM?%K6@\x05 qS\xa6\x17]\x91\xc5c\xddI\x8e\x8f\xf5\xc5\x8b\xd2\xfb\x11%\x91]\xdb\x989LF\x92Cs\xe4\x86r\nG\xcb\x06\x99\xe4Z\xc3\xc87\xcdK\x0e\x12$dD}\x9a\xad,\x91\xff\x16\x8fBU:\x07;\x94\xb1\xb0\xa6\xd6\x1c0\x13\x10\xb0\xfb\xa36\x9b\"\xa0\x889\xaa\x08\xda\xab\xe8\xb7,\xea\xeb\x10\xe0Qbt@x6 B\x8bB\x81k'\x80RQ\xd2\xe8\xa0\x10	\x88+t\xc0\xfd\xacP\x14\xe1+\"G\x02\x8f\xa0]\xb6>\xear\x1a\xc2\xb6ZP\x96\xc2Q\x0eRj\xe3\xd9\xb1\x91GGH\x99\xd0P\x89-\xd0\x8a\xf3\x0f\x91LGfG\xac\x92/.O\xa4P\xa8\x8c\x7f\\k\x18\xd2\x9f\xfe\xf9\xa1\xec\xfbr<\xff\xb4\x80\xd1\xf4\x0eng\xd3\xbb\xc9r2\x9b.\xe0~6\x87\xdb\xd9\xe3\x97\xc9\xf4\xc3\x00\xee&\x8b\xe5|\xf2\xfe	\x7fE\x03?\xcd\xee&\xf7\x93\xdb\x11\xfe\x00\x8f\xee\x97!#\xcb$\xa2\xa3\x8dSj\x87\xa6\xb0\xc0\x8e\xa8T\xd9\xf8\x14\xe9\n2'\xc9(\xc4\x902\xb3Nb\xa8\xa3+\x05\xfbFV\x19\xed\x16!+\x03|\xb0N\x94IJ\xfd\x7fTMf\xe8\x08^\x1ecZ=:\x9a.\x14\xad\xa1M\xb9\x9a(qv\xcd\xd8\xf3u\x0d\xbc\xe6\xa4\xbal\x1aU\xc3U(v~u3\x14cI\xf8\x8a\xfeN\xda*\xeb\xda)\n\x1a\xa5\x87\xab\xa3m\xafX\xe5\xe1*\x8b\xd6NI\xdale\x9bFU	3fn\xad[\x13\x93F1x\xaee\x90b\xef\xd4\x9ep\xa7\xb7\x94\xd9\xb7@\x12c\x9e\x95\xc1\x80\x94\xd2\xbc\x05\x98\xc5\x93\x88\xea\x91,\x9e\xb8fvSt\x82!\x06\xa9%jKoEZ\xee\x86<\x88u;P\xdfT\xd5\x062\x95Yq\xaf\xa2&_\x0d8q:\xe0thw\xf0\x08:/\x9d>j\x93`*\xd0\xc8\xacP\x0d/\x9eP\xb2\n\xe4o\xcf\xd5T<\xf4y\xa94\x89Ci\xc40\x963\xc7\x12Z\xd3,\xb4\x82\xe8$\xaa\x91\x87\xbf%\x83H9=yDDNc\xa3\\&\x07[\xce,\xf6\xd6\xa5\x83\xd3a\x90\x08\xc8e \xdc+\x9eCi\xa7\xf2y\xe6\\`M\x99k\xa4cm\xddA\xba\x1a\xe1\xb9!&\xb2|7\xd2lZ\xb9A\xa7w\xfdQ9\xa5\x0d\xa5\x9b\x06y\n$A{\xc1\xb1c<|\xdb\x06F\x06\xf1\xd7&\x8b<\\\x95\xf4\\\x0do\xf0@\xaf\x16]\xbe\xf7*\xc6\xc0\xb4}fm`C\xbdV\xceq\x88\xbcK\xe6\x9c\x06\xad\xad\x131\xf9\xd5\xdfk\xb0\\(\xb8\xa7	\xb3\xf9\xcbY\xb82\xcbL+\x89\x94D,\x7f\x93j(;[\xb7\xe8\xacu\xc8\xd6b\x00\xfb\xa6\xf5t\xc8\xd2{[ib\xa86A\xb9\xb5\xac\xd0\x04\xaf\xb5\xd11\xb0iT\x1aO+TN\xef\xb9*\x13+z&8\xdb\x00g\xe8h\x03\x025A\x1b\x1fd\xd3\xf4\xc2\xba2R\x19\xa1C\xe6\xcc\xad\x8d\x02(\xcd\xcb^\xab\xc7!\xcaq\x1b\x1bD/\xb4\xef\x8c\xcb;\xa0\\\x12a\xb76x]+\x82\x19\xbe\xb2{\x15\x831Y\x05\xb4a\xae5\x9c!9q\xc4\xa5\xcc\xc6lTJ\xa1\xa8\x9a3~\xb6\x0d\xfb\x96cvAJ\xdb\x8b\x8e\x13e)l\xa4\xd0\xd0\x1aN\x1bQ\xbeH\x07\xca\xf1A\xa7\xa0\"\xfa\xd1\xb4\xf0\xb56\xb5\xda+S\xa3\x7f\x88,\x8c`\xbeG\x1f\x06\xe6\xc1\xda\x86\x04\xea\xe0\x18\xd6\xeap3\xa4\xb0=1\x97\xb5\xd4\xb5x\xba8\xa9G{\x90\x12oy2\x84At~\xf97=\xe4\x93\x1dy9\x9e\xce\xf3\xed\x90\xf3\xd4\x08#\xbe_\xe4=\xd9\xc3O^\\\x14n\xe9{\xd5\x15\xb4\x16\xb1>\xb5S\xb5nw\x83\x14r\xd5]\xd5\x07\x99\xbb\xd7Uk[\xdf0\x19r\xbfwv\xefP\xc8\x9b#\xec\xd1E\xf9-\xee\x9e\xf2\xa6\x91ZQ\x8c*\xbcft\xaaq7U#\xf5\x8e!aJ\x99\xbe\x83\xafJ\xed\xd1\xf4\xc8*d=\xe4\xcfb`C\x16\x80\xc1T\xe1\xfa	\x192\xc0\x92+\x1f\xd3\x84\xb87\xd1M}\xc2D\xc9\xc4\xa6\xfax\x9e\xa9\xb1f\x93\x81\x8f(\xa1b>\x12*gq=\"'y\xf7\xdb\xa3\xd7\x95l\xa22\xb0aL\xa59^k@\xb2p\x8c\xb3\xc8\x98-\xb7\xfb\xa8\xd5\xb8\xaf\x9c;N0\x9b\xed\xa6\xfa\x16\x8bZd\x86\xc4Z)F^\xbfvr\x12\x13\xdb4%o\xcc]\x16\x8f\xe4\x90\xa2\xe3\x10\xd1q\x84mKXw\xc7\xf4\x9e\x03\xd2\x87d9\x19\x1f\xb3T\x8a\x82\xa1\xa4\xb7}\xdb\x1b=\xed\x05\xc0\x03\x8b\xb8\xbb\xb7B\xae\xec\xb3:\x11>.\xbe!\xde\xd9)E=	q\x17^\x15\xd8\xfco\\\xfb\x977d\x852\xdag\xa7\x81!\xb4\x0e^5\xeb\x18\xe7\x9f\xc0\x80!\x7f\xbc\xba\xe9JB\x9c\xb5	\xd4\xed@\x11Y\x9a\x11M\xb3t\x8e\x8ee\xa7\x0d\x9a\x90(\x944\x07\xa5\xe7\xc8\xd0\xa5\x02!\x9dV]L\x95B\x97\x1a5\x82E3\x8e\x8atT\x17\xe9\xe0\xb8\xaf\xf4s\x8c\xb7\"\xd0\xabQ\x8a\x8c\xe5	X*\xd1is\xce\xca1\x16E	\xb8\xc0\xffR\xe8#	\xf5\x0dL(\xf7-+\xdd\xe8pL\x1e;\xb3!IP\x01\xb22dc4\x82\xd3\x10F\xc3%\x10\xbfEz}K\x88\x9c\x9c\x8b4\x97Pag\x12y\x12\xe4\\\xa4cP:5\xe9i\x02\xb7\xa1.\x96T\x179l\x95\xe9}C\x93h\x0f\xda<\xdb\xaf\xe8e\x02\x8e8\xf6\x8a\x7f\x126\xd6\xd6\xb0\x96\xa8\xecj\xbd\xb6\x8e\xaa\x0dE\xdc:\xe0ib\x9d\xfe\x99B\x8b>\xf5\x14\xe9\xa2G\xa3\x1dF\xc4Y\xf0\xa4\xe3\xc8\xa0G\x1f\xf8\xa0Q\xa8)*\x8fYl\xd8+\x87\x1a\xe8\xc9_\xa8g\xe5\xe8\x00\xd1\xb5\x12\x19\xc1\xc3\xbeuT&qjG\x81\n\x02\x16m6\xeb\xb6\x89gx\xdd\xcbI\x14\xc7C\x8e\xad\xc8\xca\xa0\x8b \xad\xfdWKm\x04\xd6\x06\x8fX\x985*/\x94|\x1dgi\x9b#\xa5\x1a\xde\x10\xa4\xa1\x8a\xc2\xa9O\xe5\xcf;\x06\xf5k \x8bv\xe5\xa3\xde\xffZ\x83S\xffj\xb5K\x86\x1d\x8d\xf5\xc9\xc7o\xb2\xd8\x94R\xc6\xf2E\x90)A\x95\xfck\x8a`I\xadU4\xac\xb2\xf9[\xaa\xb5\xbdvn:&L\x98\x1d4\x05\xb2\xa4?s<\xb4K\xacC\x08\x9b\xaa\xed\xb4\xab\x1d\xd7\xa3i\xf2\xe8\x99\xfa\x06\n\x05\x99\xf5\x9b\xaa\xc9B#\x17\xf5Z\xd3\xee\"\x97\xa2\xa6\xca\x10?a\xa4v\x1e\xd4'\xdbL\x0e\xa6\x92\x06\xb7\xcf)Z\xb9j\xa8-	q\x9b;91\x1c\xec1\x98C\x9b\x84\x0b\xa0\xba\x88\xb0U;\xaf\x9ag\xe5\xa3\xd2\x94\x96\x82\xe5\x14%\x91\x0c\xc9\x00j\xcbMc\xdd>\xadW\"\x93\x7fHZW\xf8	\xaa.K\x7f\xb2\xf4\x10\xe0=\xf7\xe2\xd0\x07\xa2\xff\x01\xe7-\xf3\xb4\xd2'\xcdH<\x8c\x11\xbc\xf6\xaf\x81@>\xe2\x1e\x1aN\xa6\x95\xe6\x88i\xd7\xe4\xf1N\x82\xf3\xae\xfclS\x89\x81\xd2}\x9c\"\x8aqR\x0c\xc0=\xa8oAeP\x92\x8a\x1c\xb4\xcc :\x83\x96,(\xc1&\xfc\x81\xa2\x9a2m\xcb\xa9\x0d\xc6`\xca\xd3\xf9\x1f\xb6\x16\x0e\x08\x07\x045U,\xb7\xad\x1f\x14\xfdzH)u\x92\x85l\xd6\x93\x8e\xa1\x9a#\xc8*\x1aG\x086{\x02w\xb9P\x1f,\xb3\x0c\xd1nP\xa6\xd3\xf5\x15\xe1\x89w\xe0$nnP.EA\xaaP\xdf\x94\xe3\xccY\xea\xb9(\xa3\x98K\xcc.+L\x18\xfer\xe2\xe19\xc9\xdf%\xc41\x14bb@\xd6\xec\xf4\x07\xb0SN\x81\xdcl\x90Ki\xda\x14\xa7\xd2>\xa8L}a\"q\x96`\xbcN\xe1\xf1+\x80\xe7\x06\xff.\xe1\xd96\xed\x8e\x8b\xcf\xe0\x83\xa5\x02\x81u\xfd\xfd1\x8e\xeel\xcb\xca\xa5\xf0\xa7\xa0\x8e\x03\x7f\x92i\x0c\xa1.\xfa\xe2\xbft\xb8\xce\xee\x89\xa7Q\xc1\xfa\x1e\xbc\xac\xba\xbc\xd8kH\xc1\xa3\x92\xf5\x19\xd2%\xf7\xbd\xd1\xcf\xca\x14H\xb8\xe3:\x15*\xa8\xcbI\xfb\xa2\x8bE6A9\xf8qh>\x00oE\xd7\xc6R\x8cy\x95\xfaK\x9d\xa0\xa2\xdcI\x7f\x95!\xc05a\x17\xa3\x0e\x9c\xfe\xe0D<\"\x86<ArQ?\xb0,e\x85\xe4~\xaf\xa4\xeb\x81\x07\xb4\xad\\P?\xf2\xa6\xd2\xe4\x89\xb4\xa2\xa5mx\x03p\xc7\xe6\x91\xe1\x869\xa6\"F\xec\x1a5\xb1\x85,r\x911\xfd\x0c\x83\x17\xda[\x1a\xe6a'kn&,\xce+\xd9\x00\xed\x9c\"*\xd0o`\xd0Jt\xf1\x08\xff\xc3|\xee\xa5L\x9bFx\xf4\xd4\xb1\xae\xc4Q\x04\x85O\xfd$V$,\xba\xa1\xb8j\xd7\\\x1a#\x1bM\xf9\x8du\xdbt\xde uj\x90\xdc%K\x1e\xb6\xa9\xd7o-B/$'I=)\x1a\xb0\xdb\xe9\x81\xf9\x7f{=j>U|	9w\xe6D\xbf\xf2\x8dh\x801s\nS~\xbd\xa1\xdc7wl\xa5\x8e\xc4.%I\x91\x93x9\xcc\xf1\xf0\x96\xc8\xf9\x15(\xda\xb9\x14\xecT\xb1KE\xe8\xa2\xa1#\x07\xf2\x95u\xdc\xa4G\x8d\x19;Ym\xb5Qo\x9c\x925\xe3\x85.\xce\x8f\xfdQ\"9\xb3\xd7\x13\xcf/\x11\x886OD{V\xb5>\xd8\x9dt:\x15\x0b\xd1\xdfu]\xc6&(W\x842\x93\xf5\x99\xd1/\xd9\x96\xa4yu\xe4\x90\x97\xc2Mj\x0cO\x02!b/M\xd1\xedAi\xf8\xa8\x86\xf9+\xc4Y\xcf\xb2!$\xd3\x9b\x80\x94\xbc\x97\xbcK8\x89P\x04M\x06^\x06\xed\xd7:f`\n\xd0\x86~\xed\x14|\x94\xdc\xc50\x00r\xd9\xac\x8c\xb2\xbaD\xdan\xaf(K_\x10\x94&\xe9\xe7\x17J\xd6\x10\xf7\xfe:\x84Q_\xd4sQ\xc2\xd8\x93\xfe\x8c\"\x8aO\xa6-\x03\x9dUKJR6\xf5\xb2\x8f?u\x81\xab#\xac\x14\xb5\x0cR\xce\x11\xdd\x9f\xeb\x15\xe4(O\xe4S\xdd\xe1r%*e\xc1\x87\x00\x0b\xca\xde	\xee(\xd1\xa8\xfd\xb6\x89\x97!\xb2\xd2\x9e\xe6\xc2\xfb\x9e\x87;z\xba\x0e\x92\xb5l\x1a\x9fs\x8f\xaf;\xce\xdcP\x90z<^\xa7\xf7\x8c\x1d\xa2\xa2\x920\xc5\x98\x85v'\x9bs\xa9C&\x9b\x94\xa2\xeb#\x9fY<\x9e\xd3\x1c\xcc\xcd b\xaa\x18\xd3^\"R\xf4\xcb{%9\xdct\x1e\xd9\xf3B\xdav(\x92\xe9\xfa\x8d\xf2\x13*BvR\xdeS\x05\xa5\xa8\xf5\xb4\xc0\x12\xbb\x94^\xe5\x1f\xfdd'\xa9\xb7\xa6IMp[%\xd1\xcc\xacu\xa3D\xe2\\i\xe4{h\xbc\xb4\x0d)\x97\xc6	\x9c\xd8\xa0!^\x97\x96\x13}\xec\xa7<Y\xe2\x86\xa2\xcb\xdc\xc6\x0b-\xae\xa5_\xaa\xee\x0e\x03\xea	e\xaeLH\xf1\"\xd7^(\x9a*5\xc2\xb6\xa1\xbf\x0dn~\xce_h\x9f\xf2N\xb2\xdf5%\xc2\xd6)\xbf\xb5M\x0d\xb9\x15\x9e\xd3#\x89\x1e\xee\xd7\xa7b4]\xab\xe0({u\x84F\x1e\x92q\xe5\xf4\xb8)\xbb\x87\xf9\x18(;n\xda\x9dr\x94\x87\xc4\xe0j\xa7\x82r\x18\xa5\xc9 \x85\x0f\xae\xadBK\x1dQGT'\xce\xe7\xc6K91\x05\xe1wT\xe5\x90\x95\xb3\xbe\xf8\x816\x8d6Jt\xf5\xb9k\x0c\x14\xf0g\x14UP\xa8\xa2\x0d4\xcal\xc2\xf6&\x07\x8e\xbd\x14;\x13,\x88`\xc4\x04\xa6,\x02\x9c\x86<|^\x8cpR\xe7Z\xa9}d`\x10\xf5\x8d;\xa9\xed\xd7\xc9\x10\x80\x14\xb2E5\x97\x13uL\xe6\x92\xbb[9\xb8Gks\xe2\xfb\x7f\xe3\xfa\xd4\x0cI:h\xaf\x06\xa7\xc7\xfd\x8aY\x18\xa4|\xef\xa9OyQ\xee_\xc9\x95\xfe6\x14#s,\x15\xb5\xbf\xe5\x9c'h\xbc\xbd\xb8\x91\x81H\x9c\xb5.\x05\x8f\\\xda\x89\xf2]k\xa7\xaa\xd0\x1c\xcf\xfd\x04\x8b4C\xd4\xdf\x860\x8a&\xb2R\xfb\x14j\xe2\xe8\x8c&bR7'\xbb\xf9>\x18\xb5\x15$\x07\xf3=\xdbrF\x02w\xd0\xd5-zQq8)\x8c\xbe`i\x07\xe7(0r)\xa1\xb5\xc4d\xce\x9co\xad\xae\xce\x92\xd1\xddapca\xbf\xeb\xa3\xcc\xce\xa6\x93d\xc8D\x1d~\xf6\xc0\x97\x93$\xf5\xde#RWB\x99\x8d6\x8aa\x0c\xd9c\xb5j7t\x0f\xef<\x89\xde\x95\x1e\xf2\xa5\x87\xd3,4\xb3\xaa+\xc0\xf4\xf2\xc5q\x03Y\xd6u,7\xa2o\x0e1\x15qR\xb7J9\x1e\"\xbb\xe7b\xc4I\xd0\x95\xe9\x8ai\xb4\xcbE\x15\xbe.\x91H\x12u\xcb\xf8\x8d\xc4\x98\x92q\xda\xef\x1by\xf4g\xa5\xa22\xf0\x8c\xa5e\xda\xcbYI)\xf1=\xef`g9\xf2\xee\xf7GI\x8e?\xe9B!\x8bz\n\xd0\xa99)\n\xf1\x0b[\xe0\xbe\xfdLOm\xc1\x1a%rY\x82\xef\x01u%\x89Q\x02\xf4\xddY|\x07\xd6\xd3\x97\xafA\xfb\xb3mv\xad\xda)c\x9cJ\x01t\xfb\xe3\xa0\x9c\xca\x87\x9d\xa9\x88\xdd\x17/\xc6\x074\xc5\xe5\xb0\xe0\x86\xaah\xe7\xf6\xaf\x07\x96\xd8\x94\xd0,\xa7*<\xb8\xc0\x82\xb3\x0dwF\x81\xe683\x0ct\xa2\xa5\xf9\x8c\xdd\x0c=\x94^\\lH\xb3\xf0m\x9e\xe2\x02H)\xee\xe4\xb0\xd2\xfd\x9a\xc2\xce\xe4\xa4-\xcdR\xec\xf2\xa4'\xe3\xb4FB	\x89\xc0~\xaeV\xce\x07k\xebX\xd8(\xdb\xe1\x0e[\xdb\xdd\xd5\xd9\xaa\xae\xaaM\xa9\xb2\xd47\xe0c\x11\x89O\x91\xa6\xe9y.\xb4\xe4F\xa1\x1f\xe7 \x0da\xd3\x8a\x1b\x08\xd3m\x1fv:E\x06\x9c\x89\xe9:\x0c\xf3\x06\x8ae\xd9\xefQ\x8d\xec\x89\xda!}\xaby\xf7\xfd\xd6s\xd8)\xdc\x84\xf6\xbb^\x7fu.\xa2\x16\x04S\x1fM\x9e\xa6\xfb\x0e\xbd\xb5\x89\xd6\xf9\xfa\xed\x0d\x9f\xba\x0c\xe0Z\x03A\xefT\xac-\x95\xe6--.\x1b\x14\x9d#\x02'OY\xd0\x8c<\xd0\xfc\xc6d\xbdK\xed\x92\x9c\xda\x97g]\x12y\xba\x0e\xe3P\xe2!\xf4\xa0w\xbe\x9f\x14\xc5\xbb+\xe1\xa0\xc8\xec\x95K\xae\xf3\xe5\xe6\xc3\xdc\x81\xa2\xd7\xb9\xd2E\xb2\x10\x9b<\x88\x11$\xe3\x14)\xc6\x0e\xbd\xb3\xd9\xb4\x8f\xb5\xa9\xd8f\xf2\x86\xacM\xa0,PV\xb2\"\x0f\x16\n3$c\x0c\x8e\xe3\xba\xf2\xe3K\x06K\xe6\xc40\x85\xde\x03x\x96\x8d\x8e\x97TXE\x1b%=\xdf\xd7QpT\x12\xb1d\xb0\xdd\xfd<\x8a\xba\xd9\xc0\xe2Nb\xb4\x10K\xa7\xe5\xed\x94\xa2<\xf4\x9b\x1c$\x0c\xc1\x9d@\xb1\xbci,\xb5\xc8&U2Qk<\x05\x17\xb1~\x96\xf1_\x19\xe6\xf4j\x9c\x17K\x00\xd1\x98}/;\x81\xb3\xbc\x98\xa1\xe0*\xfe\xab\x99	\xde\xd6\xc9\xe63S.\xe5*\"\xe9\xea\x06\xfeT\x8e\x8dWiC(U\x19u 6w\xd4=G\xac\xbc\x8a\xae%-b]\x97x\xa2Kb\xe9{R \xe2\x1dM-\xbb\x8c\x1e\xb5O\x99\x9e*\x84.q\xd2o\xc8\xba\x14\xcdfX\xd7s\xe6\xd4\x19'\x83$\xb5j\x03\x97Fsg\xb4Q\xaa\xe6\xa9\x85Sl\x92\x93\xbd-So\xc81j\xf1\xca\xd1?\xb9\xfa\xd4\xd8\x9fA\xea@\xf4\xe4/\xc6^\xa5\xff\xa3;\x00hM\x0b\xfa\xc8\xaf\xa7\x0c\x800\xd6\xed\xf8\xce{\xf1\xd9\xb56\xa9\xf1.:!\xeb`\xc5)W\xe4\xcbM\xa7\x91;\xf9O\xaa{\xec\xf6\xd6\x90\xa1\xbf\x8e\xf6\xd9\x0d\xe0\xabrF51\x0eCtq\x93C\xa7\x93\xa6o\xb0&v/\x9e\xf0\xc2\xb5\xc6\x0f\xa05\x14H\xc5\xf4Q\\*\x07\xa5Q\xcd\xb5\xf2\xa2\xff5G\x98\xdc\xb1\xba\x95\xfb\xbd\xca\x86C\xfb^\x86\x8c\x9f9\xa8u\x15R#2a$\xd1\xbbhl\xd71\xdb\xcd\x9d@* 7\xba\xebQ4s\xac\x1e&\xa6\n\xd93A\xa7\xbb\xce\xd9\xa5\x82\x00.\xbbH\xe3Sz\xde\xd8 \xd0\xa7\xad,s\xbc\xeb\x1a\xef\xc2\x88M\x8c.\xcd\xa54O\xbf\xf8\xc8\x01\xcf\x7f\xeb2\xcb\x9c=\xcc\xce\x82\xcb\xf9yCt\xd7\xfc\xbc\x96\x94\xc1\xb7\xd7\xb5z\xb3:\xbe\xe16:\x83R\xaa\xcd\xa6)\xefKD\xe2HbRQ\x91~'\x8a\xc5\x90k/$~\xce\"\x1e\x9fx\xc6M\xdd\xddU\xdb\xf3 '\x97d_4\x90iW\xbd\x0c=.\x98$\xb5\xa4\xb8d\x8f\x8e\xad\x89\x18@\x8b\xfc\x8cI\xec{8\xcb\x86\xd7\x16\"\xaa>\xd8\xef \xeb\xdc\xac\xdedH\x14\xb6}\xfb\xc7\xb6\x94b\x0f\x823\x17\xdb\x9aZ\xd3o{\xef\xca5\x89\xffd\xf9\xf3\x8e\xd2\xbd\xc6\x1f\xcc\xb3'\x1c\xd4\x8f\x90\xbb&\xa4\x0f/\x07v\x17w\x19w\xb6\x96U\xe8pe\xca\xb2\xe9pZ	\xbf\xd8\xc5E/O\x10\x86e8C\x17|\x82\x85\xb5\x8e*\x93\xf5\x11\x95\xb0`Pi\xec3oi/\xff\xbd\xd3\x14\x96\xd1\xfdq\x101\xf7\x00<\x95\x9cXH	i\xe3\xf6\x06\xbd2\xaa\xea	\x16\x9bn4\xe7\xea\x1b\xc2;\xdf\x1c;aIL\xee\x07i\xe6(d\x08j\xb7\x0f\x9d\xbcA\xbe\x10\xfe]JN\xd34E\xd4\xfclu\x94W\xc2}\xb2\x0dv\x97\xafe\xe29k\x84\x02\x94G\x88/0\\&1{\xa9T7\xc0 \x80\xbcp\xf6\xde\\r\x1b\x08\xdb\xbd!A^\x0eU\xe3|\xce.\x08H\x8ft\xe8\xdc\x89\xe0E\xa6\x8c\x9cJ\xc2\x94d\x14\x12\x01\xdcM\x84\xf6\x88.\x10Sh\xa6\xa5\x89\xc8\xe3w>\xd2\xd4|\x92=~\xb0\x84n\xf6\xe1\xb4\xde\xabM\xa5:h\x81\x069\x16!\xfaN\xdaXv\xadt\xc7}\xe3$zC\xfc\xaa\x7f\x91*\xc6k/\x9f\x8cu\x94\xab8-M\xe6\x0b\x8c2\xe9\x9b\xa3;`[\xbd\xd2!'U\xf3\x9b\x14\xb1\x8d\xe5|?\xfd\x06\xa6\xd5\x91s?\xa4\x10\xbdD\xfaI\x9f\xf3u\xec\xb2|\xd1\x15\xdcp*A\x9b\x1a\x03\xb2\xf8\xec\x0f\xaf/c\xfb\xeai\xb5\xbe\xb6\xe0-WG\xd2s>\xff/7\x96\x98\xe2L\xbe8a\xe2\xa1\xdf\x80\x11\x1f\xf0x\xfb\xcb\x10\xe8j	\x05a\xdc?\xfcZM\xf5;[\x0e\xe5\xdb\x05'*\x14\xa5\xdfS\xda\x9c7\x9d\xc0\xb0(\xae!\xe3o\xf8\x05\x94\xea\xe4\x8d\x87B\x95\xa1\xff\xd4C$@\xf8\x96\xd3\x06!\xbb\x97\x0b\xfc\x1bB\xcf\x84\xe9\x1d?L`04ttk\xa8\x8fsL\x7fW\xfe'\xc8m1\xd1DFk@2\xaej\xd8\xd2\x05\x8a\xa1\xe8kU|\x81\x85k\xc9\xa0\xcc\xda\xba*\xd5\xe5X\x1b\xa3\xa7/*\x8e\x07\xbe\xb6\\V}\x10\xae\xbc};\x84\xc9:\xc2\xe0\xca\x1a\xae\xe4W\xe9\xe6\xaam]\x80\x7f\xb6\xf5\x86\xc0\x1c_l(zi\xf8U\x02\xa1\xcd\x1a\xc3\x1f\x95\x06\xad\xe3\xf1\xb2O\x8c\x17\x80\xaf\x91v\xba\xe3\xc1\x06!\xbeh\xa0\xbdo\x95\xbf\x19\x88B*\xa9VL\xbc$\xb9@Q\xbaN\xd9\xe8\xd51RE\x97\xcb\x06 \xf3M\xaet\xc3\n\xcd\xf8MW\x10e\xf0\x17=c^\xe2DgH\xdc\x8eQ\xb7\x85\xfaV!.\xc4us\x8c\xf5\xf2\xb7\xdd\x0b/\x11S\x96\x80*]|\xe2\x1a2\xa2\xba]\xdb\x04i\x14\xb7\xc9s[\xf7\xaa\xd1\x9b\xd8\x06\xdd\x99lQ6\xacw\xdc\xdc+\x17\xd8\xef\x17\x9f\xc5*\xca\xd9!\"@M\xc2\xf9\x82*Jj\x99>\xbd\xa1\xaa\xd7\xf9\x85\n\x914\xec@\x8f\x87P\xdd\x89\x9f3p\xf6(\x9bp\xe4;\xaa\x85\xae\xeb\xb3\xeb\x93h\x0c\xb9\x0f\x08\xf5\x03\x9dX\xbaZ\x10;Fr1\x01M\xb4\xc9\x7f\x0b[G\xc5\xba\xa3my\x8bd\x89\xa8z\x15\x1f[Cah\xea\xcc^\x82\xf29W]\xfa=\x1a\xb6B\xe3(\x9cZ;tb\xb9y\x8d\x0e\xf9\x15\xf2\x87BL.\xd4\xd0\x8b\xf69\xeda\xab\x9a\x1a\xb4\xe1\x8c\x87u\xd0\x1aVL\xc5=\xa8t\xae\xd2\x1cE\xf9\xc2Wq!\x9d\x0d\xdeJ6\x9dIW\xe5\xf4\xe5\x0bp\xd4\xe1\xc5\x1d\x9c\xe5\xa8\xaeM\xf4\xe2\x07\xd4\x95\xc2ME'\x17\xe1'\xbd\x0e\xc1\xd4\xcf{\xba\xc7`\xf1h\xda\xe8\xb0\xe9o\xac\xf6q[1\x1b\xe9\x8aXN\xb9p\x8c\xcd~\xd4]\x98\x1e\xa4\x8a\xad\x85\x9c\x1e\xc28>\xde\xc0 \xa8\xc1#\xdf\xf5\x17O\xf7{=\xee\xae\xa00]~`\x9fJW\xa96.\xce\x18\xd2\x0d\xf3\xee\xa9\x83\xf2\x8cc|\x9c\xbaA\x85F\xd1GK\x12\x9f\xab\xe1\xa6\xa7\xee\x8d\x01D\x07\x15\x81\xf9O$\n|\xf3\x9a\x80\xcbN\xd6Jl\x94Q\xce\xb6\\hK\xab\xe4w\x95\x0e\x18\xc69\xea\xd2*/N\x16\xa1\x80H\xd2N\xa6+R\xa79\xd5\xccBa\xa0x\xc9\xa5lQ\xa6\xcd\xca\x10\xefk\xbf\x8b\xa0\xbe\xdd\xa7\xc5\xf9\xce\xe9\xcf\xb55\xcc\xff\xf88\x9e^\x03\xb5|\x81\xdf\x92\xc8 8$w\xdf{\xaf-\xd3\x9a\xe8\xeb\x8cQ$\x92,Tw\xa34\x9a\xc1\xe8\x0d\xd9\x10S\xb1\x8cZR\xfbZSJ)\xb5\xbe!\xa1\xb8J\x93\x1eM:\xc4\xb6\x80\x95j\xb4z\xe6\x87\x17V\xea\xdc[\xb1g\xf5\xe1\xcc<\x136\xf9u\x98\xeaJ=\x19\x88\xf5\x80K\xb7\xbb|q\xf3\x0c\xb4\x11\xe9\x15\x07z\xaa\x92\xf2\x121\x8f\xb3:B!\xfc\xabcW\x9a\xa2\x0fc\xd2\x95mt\x87N\xaa\xd3\x0b\xc2h\x15)c\xd0\xbf\xe8u\x01\xc3S\x95\xb4\xae\x05e$P\x08t\x80\x8d\xb2\x1b'\xf7[\xaa\xe7\xf7\xb6X\\\xabT\xdfR\x0d\x88\x0dq\xde\xca@\xa4\"H\xef\xd3\xde+\xa2|\xa3\x8e/oP\xc9\xacc\x04[\x8e\xd6\x0b^@\xd5\xfc \x04ks|\x10\xa2\xa0_\x9b\xca\xba\xbdu\xdciBI\xa1L\xa2\xf4\xa0\xd7\"e\x91c-*>\xaap\xe1X\xff2\xa4\x86\x97\x17\xdf\x86\xa44L\xbcx\xe6\xd4\xb3\xa6{0|\xe6F\x1dDLzw\x95\xe0WoU3\x16@p\x8bj\xa5wj((\xc9d\xd4\x01\xf2L\x14`\xad\x14:z\x8d6^\x1b\xf0{\xedt~\x9d*\xd5\x1e\xd2\xc3Zb\xd5r\x1e\x8d\xaf\xfb\x03=^\x14\xa4\xa6\xf7\n\xe2\xcdlZ\"\xbf\x9f\xc5\xbd\xd9\x95rT\xf1%\xc8\xdd\xa5\xfac\xdb\xa7\xa4\x93\xd4f\xd3jO\xa1S\xff=\xd4\xae\xcc\x9a\xf1nL0\xfb\xe29\xa9\xee\xb1\x8b\xbe/%\x8bYt\x81\xb2\xc3\x15Wh\x18\x1az&#\xcep5\xe8\x82;\xf2\xdc\xe9\x02[|\xff \x85B\x19[\x97(0\xaaW\xb4m]m\xc4Eo\xd1_*\x1ds\xaa=\xbf,\x14g{/nxp\xbf.\xc8\x0cz\xfa\xac\xe8\xba\x14\xaa\xadMP?\x0d\xe1[8?L\x05\xc3\xef\x7f\x1b&\xf0\x98\x1a]\x0b\xed \xf0~v)\x8fjM\xddC>\xddk%|\xdb\xa0\xa7\xc1\xa7\xa0\xda\\(\xfdx5 \x85S}o\x01\xf1\xe1H\x04\xf3]\x9c\x1d\x81b\xf6	\xd9{\x96F/\xbe\x81\xf0\xf2[\xc7\xbd\xe5p\x98\xb80\xec\x1d=ijw\nU\xcd\xb3w\xc8	z\x9f\xfb\xa2\xf8\xbdR\x81.\x8d\x8e!)\xe0\xa6\xa5\xd4O\xa4%\x1c,l,\x154\xd6\xac\x82\xeeY\x97\xaf\xd0\xf8 C\xcb\x8f\x1f5M\x91\"\xe0\xect{\xfahR\xccc\xee\x9d\xdd\xd9\x1c\xd1\xfb\xadt\xf4\xde\x16\xf5oD\xa7\x92?\xd9\xb0Ui\xd2u\xc2\x97\xfeLg\xf0y4\x9f\x8f\xa6\xcb/d\xe3\xfe:\x84\xf7\xe3\xdb\xd1\xd3b\x0c\xcb\x8fcx\x98\xbc\x9f\x8f\xe6_`\xb2Hod\xdc\xc1\xfd|<\x86\xd9=\xdc~\x1c\xcd?\x8c\x078n>\xc6\x11\xd3\x99Hs\xd1\x8b\x19\xc5\x04\x03X\xce\xe8\xef\xe3\x7f,\xc7\xd3%<\x8e\xe7\x9f&\xcb\xe5\xf8\x0e\xde\x7f\x81\xd1\xe3\xe3\xc3\xe4v\xf4\xfea\x0c\x0f\xa3\xcfC1\xfe\xc7\xed\xf8q	\x9f?\x8e\xa70\xc3\xd9?O\x16cX,G8~2\x85\xcf\xf3\xc9r2\xfd@\xf3\xdd\xce\x1e\xbf\xcc'\x1f>.\xe1\xe3\xec\xe1n<\xa7\xa7;~\x9e\xcd\x05}\x08\x8f\xa3\xf9r2^\xc0\xe3|\xf6\xe7\xe4\xae\xbf\xa7\xab\xd1\x02&\x8b+\xf8<Y~\x9c=-3\x1fpo\xa3\xe9\x17\xf1\x9f\x93\xe9\xdd\x00\xc6\x13\x9ah\xfc\x8f\xc7\xf9x\x81\xdb\x9f\xcda\xf2\xe9\xf1a2\xbe\x1b\xc0dz\xfb\xf0tG\xaf\x82\xbc\x7fZ\xc2t\xb6\x84\x87\xc9\xa7	\xd2\xb9\x9c\x11gD\x1c\x9bfGbf\xf7\xf0i<\xbf\xfd8\x9a.G\xef'\x0f\x93\xe5\x17zF\xe4~\xb2\x9c\x8e\x17\xfc\xd8\xc8\x88)\xbf}z\x18\xcd\xc5\xe3\xd3\xfcq\xb6\x18\x0f\x8198]N\xe6c\x98O\x16\xff	\xa3E\xe2\xeb\xdf\x9fFy\xa2\xc7\xf1\xfc~6\xff4\x9a\xde\xd29!\x15\xc59\xe2v\xe1\xcb\xeci\x08\xb0\xf88{z\xb8\xeb1\x05\x195\x86\xbb\xf1\xfd\xf8v9\xf9s<\xc0\x910Z,\x9e>\x8d\x05\xf3{\xb1$\x06=<\xc0t|;^,\xf0\xab\xc5x\xfe\xe7\xe4\x96\xf80\x1f?\x8e&s\xa0\x07S\xe6s\x9ce6e\xf7\xf9\xdb\x10\x0fo:\x83\xf1\x9f(\x02O\xd3\x07\xdc\xed|\xfc\xf7\xa7\xc9\xfc\x92 \xe0\x1c\xa3\x0f\xf311\x13&S\x91\xce\xfd\xf3\xe4\xe1\x01O\xe8\xec\xf0\x07\xf4\xc9\xf4\x0bt\x87\xff\x05>\x7f\x9c\xc1\xa7\xd1\x17~\xa5\xe5\x8b`\xf1\x80\xf98?\xe3\xd2\x97\x8a\xd1\xa2\x10\xce\xd1\xfb\x19\xf2\xe0=\xfe\x9a\xc8Z\xce\x90!\x02\x8f\xe8n\xf4i\xf4a\xbc(\x84\x80\x96\x8e/\xcb\x0c`\xf18\xbe\x9d\xe0\xff\x99Lo'w\xe3\xe9r\xf4\x00\xb3\xb9\xb8\x9dM\x17\xe3\xbf?\xe1)\x8e\x1e\xd2$0\x9aO\x168\x03\xca!\x1f\x19\xa0\n\xa2\xacM\x93\x8c,g\x10\xd52\x1f\xe7u\xb7\xf6\xb9\xfc\xc1\xc3lA\xc2v7Z\x8e\x80(^\x8e\xe0\xfdx2\xfd \xe6\xe3\xe9\xddxN\xea4\xba\xbd}\x9a\x8f\x96\xb4\x18~1^\xc0\xe2i\xb1\x1cM\xa6|((\x00\xa4\xcc\x93\xf9]\xd6'\xe4\xb3\xb8\x1fM\x1e\x9e\xe6I\xc62\x07\x973\x98=\x8eiJ\x92\xb5\xee@\x16\xb3\xfb\xe5\xe7\xd1||3 \x19\x80\xc9\xbdX<\xdd~\x8c\xa7\x87\x93\x96\x07\xf7q\xb4\x80\xf7\xe3\xf1\x14Fw\x7fNH\xf3x\x9d\xc7\xd9b1\x89<\x99\xdd\x03\xce \"\x1f_2v\xe3)\x7f}\xe1m\x9f\xfeKA\x1f\xed\x01=\xc5\x88BX\xce\xd3.	.\x04\x0b_\xd00O\xd5!\xfaF\xad|\xec|\xa5D-\xbfk\xc77}\xba^\n\x19/\xd6\xc7\x17\xa1ci7\xfaX~\xe6\xcb\x07\xd1{\xc5-!7\x82\x83\xf4<'\xf5\xca\xec\x94\xa9\xd3+\xba:\x9c8\x08\x8a\xc7Tz\x8f\x9f\x9f\xe4-\x82*\x99\x1fi\x8f9D~(\x1a\xbc\xa5x\x82Q7\xfa\x92\x93\xbc\xca\xd9k3pm\xdd\x80\xafZ\x19\xc9/\x95\x0e^**}\xe7\xe1\xbd\x1b\xbe\x8fS\xdc K\x8b\x0c@\x86 c\x0d\xb9\x83n\xf9JW\xef\xe9`~wP{\xe1\xe5\x1a\xe31\xc4\x11\xf9\xeb]\x1a\xecC,@Q/b\xac\x85S\xc3/e\xf2}\x80\xe2\xedUA\x8f\x06\xb1'\xa7\x18#u\xc9\xf4\x9fl\xa0\xa9h\x8e\xf8\xd2-7,\x84\xdcu\xa2\xc4U\xc6)W\xd4\x93\x1cc\xd7\xbd\xa5@\x0d\xd7\x8e\xa5\xad\xf4Dj\xac\xaei\x04\x1c\xad\xa9\xa38\xff;\x1e*}_v\xafD\x06\xfc\xe4\xc1\xc8]\x9az\xe5\xb4Z\x83\xae\x15=M\xc9\xd1,?{6\xfc\x0f\x9a\xab\xffoE\xfc\xf1\xc7QI\xf7\xc7\x1f\x00\x7f\xfca\x0fF\xb9?\xfe\xe0%\xe3\xcb\xa89:\xed\x89\xdb\xbb\x94\x7f\xecK\x19\x03\xf3\x9f\xe3\xdd\xf2\xfc\xde\xf6\x0b\xf2Q<\xb5zzA\x10\xa8-+\xe6#}\x0f\xe5\xbe\n\xf5\xdeE\x14O\x13<w\xffbF\x87ic\xfa\xd5:\xb8\xee?sqs\x8e\xf1\x87\x979Q\x96Sc\xc4\xb8\xb5\xfbt\x15#dD\xc8W\xcb\xb8\xad\x0b\x83\xae\x842\xd0\x1a&\xa4\xf1.\xf7\xed\xc7\xcb\x02\x94\x89\xa6\x8b\xe5\xf9\xc1\x8dx\xc9\xfe\x140X\xf7\"^\x80\x0e/,\x94J\xbcf\xae\xbe\x1erRq\x82\x1e\xe9\xc4\x90\xd0G\x0e\xa0\xc1(E\xfcr/\xcf\x8f\x9egy\xcd\xa8\xe3\xec;\xd0k\x94\xfe\x0bh=\x9f4\xcds\xfa\x8f\x98\x0c\xfe\xff\xfe\x11\x131j<\x05\xbe\xf15\xfd\x98\xe12\xb0eG@\xdd\x94\xfc~8J\x9fjT\x15\x9c5\xba\xe2\xfe\x00\xb9\xa7\xa7\xcdu3\x14\xa2`\x13u\x95oT\x940\xb5\xdb7\xf6\xa8\x1c\\\xa7K\x986]\xb3\x8f\xd1\xd4N\xb9\x1b<Q*\xd1z\x8c\xf6\x1a\xce\x8a\x1bz\xfa\x9d\xee\x80\x80\x84\xce\x9c@\xf7L\xcdUn\xbe-\x1b\xf8R\xd7%\xda\xc7\x8f\xf1yL	\x9e\x12\xee\xef\xe2]Y\xfc\x06M\x07w0|\xb1G[\x1f\x8dJlE\xc3\xb4:\xe6\x85\xf8	\xfb\x8e\x00\xb2`\x8a:\xa6\x80[\x08\x92\x8e\xfc\xef{gW?\xc1u\xf7\xaa\x03\x11x\x88\xaf\xfb~5v\xe5or\xeb\xde\xea\x08\xff\x03i\x80\xb94\xb5\xdd\xc1GY}U\x8e$\xef\xdf\xb9\x89\xadu\xe40\x97G\xb8\xb5\xd6\xfc\xc7\x00\xde\xc2h\xeft\x03o\x7f\xff\xfd\x17t!\xfc\x8b\x01<:\xe5uz\xf2\xe1O])!\x96[\x19~\xca\xaf\xef3\x1b(\x85\xf0_\xc5\xff\x0d\x00\x00\xff\xffPK\x07\x08b\xdd\xf1\xa9x$\x00\x00\x9dg\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce9sO\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0f\x00	\x00lgpl2.copyrightUT\x05\x00\x01U\x96\xd3]r\xce/\xa8,\xcaL\xcf(Q\xd0p\xd6Tpp\xa8LM,rpPPpp\xc8/\xcfK-rp\x00\x04\x00\x00\xff\xffPK\x07\x08j\x83\x8e\x18'\x00\x00\x00!\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce9sO\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0c\x00	\x00lgpl2.headerUT\x05\x00\x01U\x96\xd3]\x94QOo\x9bN\x10\xbd\xf3)\xde\xf1\xf7\x93\xa8\x1bR\xf5\xe4\x13\x89B\x82\xe48\x16`E>.0\x94Q\xd7;\xd6\xec`\x8bo_\xe1&J\xd5\xf6\xd2\xe3>\xed{\xf3\xfe4#Gxn\xd5\xe9\x0c\x8e\x18\x94\x08Q\x06\xbb8\xa55f\x99\xd0\xb9\x00\xa5\x9e\xa3)\xb7\x93\x11\xd8\xe0B\xffY49J\xcf\xc3\xbc\x00S\xe8Ia#\xc1H\x8f\x112\\\x1f\x8f\xdb=6\x14#)\x1e)\x90:\x8f\xdd\xd4z\xee\x92\x0dw\x14\"\xc1E\x9c\x16$\x8e\xd4\xa3\x9d\xaf\xacb1Q\xbf\x99@!S\xe8\x9d\xb1\x845\x88m$M\xce\xa4\x91%\xe0v\x95\xbd_z\xd3K!\x8a\xff\x9c-\xce\x15rZh\xff\xc3\x85\x19\xde\x19)\xde\x98\xab$\xf9=\xf9G\xc0\x1e\x1c\xae\x9a\xa3\x9c\x086:[\x12^\xd8{\xb4\x84)\xd20\xf94i'\xc3k\xd9<\xbd\xec\x1b\xe4\xdb\x03^\xf3\xaa\xca\xb7\xcda\x8d\x0b\xdb(\x93\x81\xce\xf4S\x87\x8f'\xcf\xd4\xe3\xe2T]\xb0\x192$\xcf\x0f\xd5\xfdS\xbem\xf2\xbbrS6\x87\xc5vQ6\xdb\x87\xbaF\xf1R!\xc7.\xaf\x9a\xf2~\xbf\xc9+\xec\xf6\xd5\xee\xa5~X\x015\xd1{\xaf\xc9_{}\xef\x01\x83(\x8e\xa2\x84\x9e\xcc\xb1\x8f\xab$9\xc8\x848\xca\xe4{\x8c\xeeLP\xea\x88\xcf\xd4\xc3\xa1\x93\xd3\xfc\x0f\x9by	\xdf\xae1a\xbf\xb4\xb8\x06\x0f\x08b).\xcaF0\xf9s\xcd\xe4c\xcd\x14e\xe8V)\xbef(\xd4\x85\xef\x9e\x03jS\"KQ\xf0`#\n/\xa2)\xee$\xda\xf2\xfd9\x07nn\xb3\xec\xe6S\xf6\xe5&\x03\xf6u\xfe#\x00\x00\xff\xffPK\x07\x08\x7f\x85\x84\xf3\x9e\x01\x00\x00\xbf\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce9sO\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x05\x00	\x00lgpl3UT\x05\x00\x01U\x96\xd3]\xbcY\xdd\x8e#\xb7\xd1\xbd\xef\xa7(\xe8\xc63@\x7f\xda\xb5\xd7\x9f\x83\x18A\x80\xc9xv3\xc1\xd8Yx\xec\x04\xb9\xa4\xc8j53l\xb2C\xb2\xa5U\x9e>\xa8\"\xd9b\xebgv\x03\x03\x99\x1b{%\xb2X<Uu\xea\x14\x05\xe7\x7f\x1f~\xfa\x15\x9e\x1e\x9e\x9f\x1f~\x86\x0f\x0f?=\xfc|\xf7\x04\x1f\x7f\xfd\xd3\xd3\xe3=<=\xde?\xfc\xf4\xfc\xd0\\\xd8D\x7f\x7fC\x1f\xb4\xb3\xf0\xae\x85o~\x0f\x7f\x99,\xc27o\xdf\xfe\xaei\xe0\xde\x8d\x07\xaf\xb7}\x84\x9b\xfb[\xfe\x10\xde{Dxv]\xdc\x0b\x8f\xf0\xdeMV\x89\xa8\x9dm\xe1\xd1\xca5\xfc\xa1\x8fq\xfc\xfe\xcd\x9b.tk\xe7\xb7o\xfe\xd8\xc0\xc3\x0e\xfd\xc1Y\x04\x1d`D?\xe8\x18QAt \xddx\x00a\x15(\x1d\xa2\xd7\x9b)\"\xec\xd0oD\xd4\x03}\xa914\xe0:\x88\xbd\x0e`\xb4D\x1b\x10\x94\x93\xd3\x806\xb6\xb0\x99\"\xc8^\xd8\xad\xb6[\xd0\x91\xcc[\x17A\x18\xe3\xf6\xa8\xd6M\xd3\x00\xfcB[w\xf9~l\n\x13N\x18\x02z\xf8\x80\x16\xbd0\xf0q\xda\x18-\xe1)\x9f\xa1\xadt~t^D\x0c\x0dm\x89\xe8\x87\xc0\xaeJg\x95\xa6\xfb\x062W,\xbf\xabm/\x8d6\xd9h\x0ba\x1aG\x83\xe4;*\xd8\x1cx\xbdP\xc9\x9a0	\x9a\x10\xd8\xb4\xd1\x81\x17\xa1q\xfb5\xdd\xe3\xed\x1a\xee\x8eK\x7f\xc0N\xdb\xe4\x05\x7f{\x17`\n\xa8\xa0G\x8f\xda\xb6\xb0b\xc4\xf2\xc1+\xf0\xd8\xa1\x0f\x84\xf8E\x7f\x13\x16\xcde,Z\xbe5-]\xf1\xdd>>}\xce\xde\x15C\xec\xe8\xea\x97\x1e\xe1Io\xbc\xf0\x87\xda\x8e\x00\xe9v\xe8Q\xc1\xde\xf9\x17\xd8\xd2?lA\xe9x\x95\xb6q\xb1G\x0f\xb1\x17\x16\x84\x85\xbbq4Zr\xfa\x81\xf3 \xe0\xde\x0d\x1bM\x1b\xffNfD\x00EH-\x90\xbc\xb3\xb0\xaa\xf6\xad(m\x84=\xa4\x83c/\"\x0c\xe2\x05\x19P\xba\x94\xb0\xa0mD\xdf	\x890z\xb7\xd3\nU\x93\xa3\x97o\x92Rq\xdfk\xd9\x97$d?\xf7: l\x04E\xc6\xd9z\xc3\xbaI\x11\xb4[\x10\x10\xa6\x8d4\"p>	H\xff;\xbb\xbd8\x87\x8c+\xc4\x01\x15\x08\x18\x9c\xc2\xc6u0\x056s\xc9\xcd\x93\xed\xe9\xfa\xb0Z\x80\x94\xee\x9fn?z\xa7&\x99\xf6I^D\xa6\x9d\x07\xa3\xedK:\xa5\xa9!\xdf\xeb\xd8/\x0e\xa0zC\x18\x85\x8fZNF\xf8\xd3\xca\xcb\xeb\x1a\xde\x98\xf0\xa2\x8f\x97Q\xdb\x8b\x00\x83PL\x17\xc2\x04\x07R\x18\x839\x07\x9f\xb4}A\xd5d\xc6Z\xadS\x8d#\xac~\xd4V\x0f\xc2\xc0\xbd\xf3\x1e\xc3HUj\xb7\xf0\xec&/q\x05\xdd\x85\xec\x18P\xd8@V\x9bK{x\xcb\x99s-\xe0'i&\x95\xc08@Hk%\xc5\x826\x8c\xce\xcf\xdcp~3\xca\xae\x96\x18$h\xc5\xc9\xae-\xe8\xe0L\xe6O\xe1\xb1YdK\x85u*C\xce\xac\x92I\x04D\xa1\xee#\x0e\xcb\xbb\xd4\xd1\xbaw\xea\xb3H\xb8\xcd?QF\xbe\x0f\x1d\xf8\xc6\xf9\xfa\x8a3&\x0b\xc7\xb4\xad\x11Q\"\x8a\x86|\x9d\xa26:\x1e(\xa9\xb6^\x0c\x01,\"\xe5$\xd9\xf0\x98R\x8d6\x9d\xc3\xd4y7\xb07\x8bc\xa8\xc4\x8e\xe0\xd3\xae\xe7C\x888\xe4\xa4\xd2x\x19tF\xe6\xeb5<|\x9282\x0e\xd1\xc13\xcaxN\xdb\x1f\x9fx\xf1?\xdc\x04\x83\xa0\n\xb0;<\x9cR\xd3d\x15z\x08\xc9@\x80w\x1c\x97o\xe7.\x95\x89\x8a3\xdcM\x116H\xden\xa8KRU\x85\xd7\x0e\xfef\x0d\xf7|&m\xf9\xd1)\xdd\xe9c\x84\x13\xd1?vp \xf7\xe8\xcb\xe4\xdax8).\xce\x14\x8a\n\xad\xf4ii\x061\xb4 \x9aN\xc8\x14\x98\x9a}\xbb\xc9\xca\xc2\xa2\x14A\xfat\x83\xa9c\xe9D\x08K\xbam\x98)\xa7\x80\x9c70\x1b\xbd\xa9	\x9a\x88\x15\x84\xdfr\xbf\x86Q\x04\xca\xed}\x8f\x9c\xc1GGt\x00mw\xee\x05\xd5mK\xdf\xd8t\xc7e\x08\x8e\xf7\x1c22M&\x97\xef	\x18\x10\xb794\x8b~q\xa4B\xf67\xd9}A\x10\xb0uNA'\x88\x88\xb0\xeb\x9c\x8f\x10\x1d\xd9A\x1b&\x8f\xb9Tu\xaa5\xdc\x91\xff'\xfdF9LT\xcf 1\xd1\xd2\xfeS$\xdb%>!jc\xc0\x8d\xc8\xda\"U\xf5\x88\xbes~\x08\xb4}\xdf\x8b\x88;\xf4L\xa2tc\x1d\x03\x8c\x93\x1f]@\xf08\x08m\x03\xd7\xab\xb6\xdbn2-8\xcf\xd7\xdf\x1c\xaf?'U\x9b\x08\xda\x92\xf4\xca\xd8]\x91\x1b\xae#\x1b5r \xd2U7\x06)\x17\x18=\n\x02g\xe1\xbb5\xfc5\x11\x05q\n\xe9\xbe\xac\x968qED\xaf\x85Ie\\:\xd7\x9fQ\x90w\xef\xb5\xc10\x93U\xcd6\x84@\xee\xb75\xca\x94\x05\x95\x1a\x83\xa1\xb6\xde\x08\xe8\x93\xddN\x9b\x143\xd6\x98\x19\xbaeg:)\xeb0\xc9>;\xd0\xb0\x03\x19>\x16z\xaeK\xc5#{\xa7\xe5i\x16\xb5\xa0\x93\xed\xca/\xd5\xcc\x8ee\x01`\xf4\xa0\xb3\xcc\xb5\xd3\x80^K\xc2\\x1`D\x1f\xdaTf!\xfaI\xc6\xc9cc\xc4\xc1M1iL!%\x86\xe0h\x15\x11\xf0 \x8c\x81AH\xef\x02\xa5\xa4\xd1\x16\xe74K\x1b\"\x0e\xa3a\xb1z\x13\x91S\xaf\xc3=r\xdfF*.0h\xb7\xb1\xbfm\xb9\x02\x94\x83\x8d\x8b}\x81\xa8s$\x98\xb5\xdd\xce\x85\xf4A\xefXD\x0c\xdaR\xe2[\x17\xb5\xc4\x94L(d\xbf\xa8\xc6:\x86\x1c\x80\\\x07\x95daiJ\xad.f-\x99V\xcdK\xe8CJsR\\\xd4\x03\x01f\xca=\xd1\x7f\xeb\x92\xebwR\xbaa\xa4~s\xea\x03{\xb9$\x8c\\\x0e\xf9\xf0\xe3(A\xb6\xca4\xc1\x96\xbf]/{G\xb8\xdc\x0f\x96\xdd\xeaz\xde\xa4li\xa2x!\xc6s[$jl\x89n\xa8\x0b\xec\xd0\x1c(\x14\x94,\x1ei\xfe\x91q\xc1\xd6\xd9\xfd\xe6TS\x14\xd8\xa4\xb3Q\xb0\x1f\x99\xa6N\xc4\xafU\xe0\x89J\x026H3\x12\xa2\xa7\xfa\xa4\xfe\xabp3myl\xe228\xe9\x11:5\x19\xd6^\xca\xa5\x90\xbb\xae\xf9\xed\xb9r\xae\x86\x12\xed\xe0\xff4[N\xd4\xe6o\xc8\x17\x90\xb7\xf0\xfe\x82\x9eb\x9f\x95\x0e\xa3\x11\x87\xc0\xa6\xd3\x0c\x9d\xc0	\xa0&\x8a\x04w\x9bO(\xa7ZF!{p\xbae\x16^3\x08\x83K\x06b\x8f\x01\x8b\xe1\x96Z\xee\x1e\x8d\xe1\xd6\x9b\x1a<Z\x89\xa0\xb4\xa7\x94\xcb\xbai\xa2\xc9\x97I=\xa37V\xe2\xe9\xda\xe5On\xaen\xe1\x07\x07Ug9\xc9\x0c\xfa{{\x9b\xf5\x0c/xM\xa0W\xbdk.$:\xbcX:\x9bE_W\xb9\x94>\x82;J1\x10&\x1d\xb9\x97u\xce'+u\xd9r\xbc\xd2\xcbD[#Tv{L\xd3\x10\x02kW\x9a\x86x\xd9\xd9,$fqRM>\xc5J<S\xed\x14\x84<s\xd5[O&\x8eT\xdd\xc5\xca \xac%\x01:\xa2L\xab+U\xf9\xddi\x10)md\x91\x94\xc5\xc2\xa5\x00\xac\xe7\xa0}}\x0b\xbfRe\x1d!\x0b\xbd\xa0\xe229\xf5\x06\x94\xbd\xb0:\x0cl\xbdL\x86e\x14<F\xac\xf4\xdd\xbb\xa3\xa5\xe3V\x1d8w\x18\xf8\x1b\x92n$$E\x04?Y\x88z\x98\xcd\\T\xb8 \x8cG\xa1h\xb4\xc0@\xa4\x93G\"\x8a\xdaWTo\xc38E\xf4s\xecyLHQ\xbf\xd9\xdc\xc2\xbeR`\x04\xff\x88\xde\x1c\xae\xc5\xaf\x189\xf1\xa0H\x8dy\xe6\xfe?f\x98\xa8\xe9\x9a\xd5X\xcc\xc3j6Q\x8fj\x80\xb7\xf01\x89\nx\xb4!\n\x93\x86@x\xb4\x94\xb6\xd5\xc8\xe3\xac9\x14F\xde\xbb\xc9\xa8\xe3\xd3\x02\xb3\x1b\xa9\xc2\x7fM\xda'\xa9\x91\x85J\xa2u}\xb4\xb5\x1c[\xe6Di\xf2\xab!\x8bE\xc2\x87OK\xd4\x00\xf8)\x12\xb8|\xd53{\xa96-\x92Pa@\x1c\xe8t\x0f\xb6\x93x\x0d/\x17D9yI\x9a\xf5\xe3C)\xb8\xfc\xfc\x90J.\xd3\x17\xed\xfc\xb2\xc2\xbbPqk\xb8y\xe4\x82$8\xf9\x85'\xcd\x84\xdf\xaa\xb7\xa9\xf2\xaf\x05\x03\x86)D\x12f\xa9\x91\x94\xbe\xf5*\xa9\x11\x10W\x89*\x01\xa0p]f\xba\x857_'\xa5\xc6\x87\x96\x98\x9e\xbaG\x16j\x0f\xb3\n\xf8o\x08\x82L,8\xe229\xdcR\xca\xfe\x7f%\x8e\xe6\x91{!\x90F#$\xce<\x91G\x1e\x8d\x99_\x85\xc7\xf2\xceT?r4\xa5\xa4\x02\xddq\x93\xff\xcb\x04\x1e\xb4\xdd\x9a\xa3\xc1\xa2\xa0R\xc4\xd3\x9c\x99\xbfk.\x1dF\xc2\xaa\x02<K\xeb\xfc\xc5\x89dh\x16\x1d\xa6\x1e\x11D~	\xab\x18\xf0\x82\xe2k\xca\xa4\x90+\xf5\xb3\x12{)I\xce\x8e\xb8\xa0J\x82\x18\xb0\x82\x8f\xec,\x1f\x15[\x98\xecl(\x19\xb0\x87%NUP\xda\xd4\xfa\xe9\xa2\xa8\xae\xf5\xdf3%\xf5\x8a\xda\xbbx\x8f\xd4Y\xe7Q\x96\xac\x1c\x9f\x1b/\xbd\x8c\xb6\x99>F#R\xf9\xef{\xf4<\x82v:\xf5~\x06\xb0\xa0G+\xaa[\x97!r\x81\x17;\xff\xdd\x1a~\xc6\x9d\x0e\xd5\x83J]\x0b\xaf\xfe\x1c0\xcf\xab\xd7~\xfaH\xd9O{BO\x9a\x9b\x8f\xc9\xcfg\x16\xf7\x85\x92B\xf3\xa5'\xe6'0=\xa4\xe1[\x0f\xb8\x86g\xcaF\x8b\xfb\xf2\xec\x11R#\xdb \x04=h#<\x15M\x18\xb5\xd7\xb10x\xe9\x8fyGj(\x8384Jw\x1d\xf2\x06\x85Qh\xc3\x8f@Jy\x0c\x81\x1d\x1e\xbd\xdb\x18\xa4<`n\x90\xe8\xf3\xfb\xd3\x03I\xfa\xc2\xb0:\xc0V\xef\x90JU\xe9@\xf2r\xd2\xa1\xa7\x90\x94\x15v\x1a6\xe8\x99\xe2\xeaR\x17\x81\x8b\xc4\xa3D\xbd#\xb5\x1fg\xba*\xf5\x0b\x12=M7\xd9D\xd5\x90\xbf\x18\xc3\x15)s{\x00\x1a\x8c\xe7\x97\xe8U\xc3\x0f\x1b\xc8\xef^$\xf7\xc8\x91^\xec\x12\xbdf\xfeu\xdd\xb1b\xab\xa2\x10V5\xd5\xcf?\xa8\xb9\xb4\xd8\x1f\xca\xf2\x14\xff\xba\x03\xf9\xf4\xa0q\xe2Bs\\\x99\xdf\xe7\xaf\xe5U\x01\x0e\x96\xc055p\xc7g(F\x90\xe6\xd3%\xfa\xe7i~\xf5\x97\x9e\xf9\xd9\xadw\x8e4\xa0=\\\xff\xe9\xec\x8a\x11H\x8fW_|\xc3\xfc\xa8y~\xc9\xd7\xb3c\xf4\xee\xd3\x01\xa4\xa0\x04\x96Za\xb3\xefSo\xe8\xa68y\x9c+\xee\x8bk\x9cT\xae1\x9c\x1c\x876\x07\x94\xce\xf8*\xa4\xcbH\x08QD\xfe\xf1\x8e\x83*%\x8eQ\xd0l\x95C|,\x8a\x86&	\xc1\xfc(\xa6\xd8;\xaf\xff\x9dX\x82:-\xdd,\xba\x820\x9fSv\xe6\x19\xaf\x99\x7f\xad\xf9O\x00\x00\x00\xff\xffPK\x07\x08\x1bp\xe4\x17#\n\x00\x00\xe3\x1d\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce9sO\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x03\x00	\x00mitUT\x05\x00\x01U\x96\xd3]\\Q\xcdn\xeb(\x14\xde\xf3\x14\x9f\xba\xbaWB\x9d\xfd\xechLb46D\x98\xdcL\x96\x8eMjF\x0eD\x86L\xd5\xb7\x1f\x1d7\xbdUge\xf9p\xbe\xdf\xe3&\x8fV94a\xf01{\xfch\x95\xfb\xc9\xd8\xde/\xd7\x90sH\x11!c\xf2\x8b?\xbf\xe3u\xe9c\xf1#\xc7e\xf1\x1e\xe9\x82a\xea\x97W\xcfQ\x12\xfa\xf8\x8e\x9b_r\x8aH\xe7\xd2\x87\x18\xe2+z\x0c\xe9\xf6\xce\xd2\x05e\n\x199]\xca[\xbfx\xf4qD\x9fs\x1aB_\xfc\x881\x0d\xf7\xab\x8f\xa5/\xa4w	\xb3\xcf\xf8Q&\x8f\xa7\xee\x81x\xfa\xb9\x8a\x8c\xbe\x9fY\x88\xa0\xb7\xcf'\xbc\x852\xa5{\xc1\xe2sY\xc2@\x1c\x1c!\x0e\xf3}$\x0f\x9f\xcfs\xb8\x86\x87\x02\xc1\x97\xf0:\x95\xccJ\xc2={\xbe\xfa\xe4\xb8\xa61\\\xe8\xeb\xd7X\xb7\xfby\x0ey\xe2\x18\x03Q\x9f\xef\xc5sd\x1a\xae]q\xca\xf1GZ\x90\xfd<\xb3!\xdd\x82\xcfX\xb3~\xb9[w\xc8\xfa\x8d\n-\x8f\x8a2M\xde\xa6t\xfd\x9e$dv\xb9/1\xe4\xc9\xaf\x981!\xa7U\xf1\x1f?\x14\x9a\xd0\xfa%\xcdsz\xa3hC\x8ac\xa0D\xf9O\xc6\xe8\x90\xfd9\xfd\xeb\xd7,k<\xc4T\xc2\xf0Q\xf7z\x80\xdb\xd7U\x1fOy\xea\xe7\x19g\xff(\xcc\x8f\x08\x91\xd1\xe83\xceB\xf2\xb9\xf4\xb1\x84~\xc6--\xab\xde\xffc>3\xe6j\x89\xcel\xddQX	\xd5ao\xcd/U\xc9\nO\xa2\x83\xea\x9e8\x8e\xca\xd5\xe6\xe0p\x14\xd6\n\xedN0[\x08}\xc2_JW\x1c\xf2\xef\xbd\x95]\x07c\x99j\xf7\x8d\x92\x15\x87\xd2\x9b\xe6P)\xbd\xc3\xcb\xc1A\x1b\x87F\xb5\xca\xc9\n\xce\x80\x04\x1fTJvD\xd6J\xbb\xa9\x85v\xe2E5\xca\x9d8\xdb*\xa7\x89sk,\x04\xf6\xc2:\xb594\xc2b\x7f\xb0{\xd3I\x08]A\x1b\xad\xf4\xd6*\xbd\x93\xad\xd4\xee\x19JC\x1b\xc8_R;t\xb5h\x1a\x92b\xe2\xe0jc\xc9\x1f6f\x7f\xb2jW;\xd4\xa6\xa9\xa4\xed\xf0\"\xd1(\xf1\xd2\xc8\x0f)}\xc2\xa6\x11\xaa\xe5\xa8D+vrE\x19WK\xcbh\xed\xc3\x1d\x8e\xb5\xa4\x11\xe9	\x0d\xb1q\xcah\x8a\xb11\xdaY\xb1q\x1c\xceX\xf7\x1bzT\x9d\xe4\x10VuT\xc8\xd6\x9a\x963\xaa\xd3liEi\xc2i\xf9\xc1BU\xe3\xdbE\x8c]\xff\x0f\x9d\xfcM\x88J\x8aF\xe9]\x07\xa5\xbf\x9d\xef\x99\xfd\x17\x00\x00\xff\xffPK\x07\x08\x0f#\xf6\x90l\x02\x00\x00\x16\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce9sO\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0d\x00	\x00mit.copyrightUT\x05\x00\x01U\x96\xd3]r\xce/\xa8,\xcaL\xcf(Q\xd0H\xd6Tpp\xa8LM,rpPpp\xc8/\xcfK-rp\xe0\x02\x04\x00\x00\xff\xffPK\x07\x08\x9e#\xb0\x1a'\x00\x00\x00!\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce9sO\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\n\x00	\x00mit.headerUT\x05\x00\x01U\x96\xd3]\\Q\xcdn\xeb(\x14\xde\xf3\x14\x9f\xba\xbaWB\x9d\xfd\xechLb46D\x98\xdcL\x96\x8eMjF\x0eD\x86L\xd5\xb7\x1f\x1d7\xbdUge\xf9p\xbe\xdf\xe3&\x8fV94a\xf01{\xfch\x95\xfb\xc9\xd8\xde/\xd7\x90sH\x11!c\xf2\x8b?\xbf\xe3u\xe9c\xf1#\xc7e\xf1\x1e\xe9\x82a\xea\x97W\xcfQ\x12\xfa\xf8\x8e\x9b_r\x8aH\xe7\xd2\x87\x18\xe2+z\x0c\xe9\xf6\xce\xd2\x05e\n\x199]\xca[\xbfx\xf4qD\x9fs\x1aB_\xfc\x881\x0d\xf7\xab\x8f\xa5/\xa4w	\xb3\xcf\xf8Q&\x8f\xa7\xee\x81x\xfa\xb9\x8a\x8c\xbe\x9fY\x88\xa0\xb7\xcf'\xbc\x852\xa5{\xc1\xe2sY\xc2@\x1c\x1c!\x0e\xf3}$\x0f\x9f\xcfs\xb8\x86\x87\x02\xc1\x97\xf0:\x95\xccJ\xc2={\xbe\xfa\xe4\xb8\xa61\\\xe8\xeb\xd7X\xb7\xfby\x0ey\xe2\x18\x03Q\x9f\xef\xc5sd\x1a\xae]q\xca\xf1GZ\x90\xfd<\xb3!\xdd\x82\xcfX\xb3~\xb9[w\xc8\xfa\x8d\n-\x8f\x8a2M\xde\xa6t\xfd\x9e$dv\xb9/1\xe4\xc9\xaf\x981!\xa7U\xf1\x1f?\x14\x9a\xd0\xfa%\xcdsz\xa3hC\x8ac\xa0D\xf9O\xc6\xe8\x90\xfd9\xfd\xeb\xd7,k<\xc4T\xc2\xf0Q\xf7z\x80\xdb\xd7U\x1fOy\xea\xe7\x19g\xff(\xcc\x8f\x08\x91\xd1\xe83\xceB\xf2\xb9\xf4\xb1\x84~\xc6--\xab\xde\xffc>3\xe6j\x89\xcel\xddQX	\xd5ao\xcd/U\xc9\nO\xa2\x83\xea\x9e8\x8e\xca\xd5\xe6\xe0p\x14\xd6\n\xedN0[\x08}\xc2_JW\x1c\xf2\xef\xbd\x95]\x07c\x99j\xf7\x8d\x92\x15\x87\xd2\x9b\xe6P)\xbd\xc3\xcb\xc1A\x1b\x87F\xb5\xca\xc9\n\xce\x80\x04\x1fTJvD\xd6J\xbb\xa9\x85v\xe2E5\xca\x9d8\xdb*\xa7\x89sk,\x04\xf6\xc2:\xb594\xc2b\x7f\xb0{\xd3I\x08]A\x1b\xad\xf4\xd6*\xbd\x93\xad\xd4\xee\x19JC\x1b\xc8_R;t\xb5h\x1a\x92b\xe2\xe0jc\xc9\x1f6f\x7f\xb2jW;\xd4\xa6\xa9\xa4\xed\xf0\"\xd1(\xf1\xd2\xc8\x0f)}\xc2\xa6\x11\xaa\xe5\xa8D+vrE\x19WK\xcbh\xed\xc3\x1d\x8e\xb5\xa4\x11\xe9	\x0d\xb1q\xcah\x8a\xb11\xdaY\xb1q\x1c\xceX\xf7\x1bzT\x9d\xe4\x10VuT\xc8\xd6\x9a\x963\xaa\xd3liEi\xc2i\xf9\xc1BU\xe3\xdbE\x8c]\xff\x0f\x9d\xfcM\x88J\x8aF\xe9]\x07\xa5\xbf\x9d\xef\x99\xfd\x17\x00\x00\xff\xffPK\x07\x08\x0f#\xf6\x90l\x02\x00\x00\x16\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce9sO\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x04\x00	\x00mpl2UT\x05\x00\x01U\x96\xd3]\xac\\_\x93\xdc\xb6\x91\x7f\xc7\xa7@m\xd5UvS\x14\xad\xdd\x95\x9c\xc4\xa9{Pl\xc5\xa7*I\xe5\x8a\xecs\xe5\x11C6g`\x81\x00\x0d\x80;\x9a|\xfa\xab\xee\x06@\x903+\xad\xaf4\x0f\xf1j\x86h\xa0\xff\xff\xba\xd1\xcc;\xf7\x1fm\x8c\x92?\xcd;\xa3;\xf9Vw`\x03\xc8\xff\x05\x1f\xb4\xb3\xf2\xae}.\xfe\xfb\x8b\x1f!n[\xf9\x03\x0c\xda\xea\xa8\x9d\x0d\xe2\xd9\xea\x83?\xdf\xb6\xf2\xea{g\xa3\xd7\xbb9:\x7f%\xa4\x94r\x04e\x83\x04\xd5\x1d\xa4\xb6\xbd~\xd0\xfd\xac\x8ct^\x1a\xd8+#\xc1F\x1dO2\x1eT\x94\x9d\x07\x15!4\xb2\xcbD \xc8\xe8\x88L<\x00\xff\x8e'vC\x83\x14\xdc\xd1\x06\xf9\xbd{\x00\x0f\xbd\xfc\xe0\x86xT\x1eZ<\xc9\xdd\xfa$\x99\xd5\xfaDD\xd0\x8d;m3M\xfa\xaa\xacB\x1e\xf1K\x17\x0f\xe0\x83\xbc\xd6\x83T\xf6t#\xe7\x00=\x91\xd9\x9d\xa4\x92\xf5&\xca\xf6\xcc\xc7\xa4|\xd4\xddl\x94\xaf\x7f\xffSX\x11\xa7s\xde\xd7\xe7\xdc\x1cp\xcb\x18\x1eF=B\x9b\x88\xbd b\xebE5\xc1\x0fn\xf6\x1dr\xd8\x83\xfc\xa7\xf3\xa3\x8cN\x1e\x0f\xba;\x10\xe3\xa4WeV\x1c\x1dT\x90*F\xd5\x1d\x12\xcf\xf8\xa0uQw\xf8\xbc|\xfd\xe9\xa0w:\xcaW\x0d\xfd\xf0\xfa\x13tsT;\x93\xc8\xbbA\x86\xb9;\xd4\xfb\x12\x11\xfc\xb1!i\xbds\xbd\x1et\xa7\x8a\xb0\xb7\xcf\xa7g\xb5e\x03\xeaT`\x12\xdavf\xee\xb5\xdd\xcb\xc9y^\x8dj\x027\x90$^\xb6\xf2\xea\x8d\xed\xdc8\xa9\xa8\xf1<\xbf\xeax\x90\x1f\xa0s\xb6W\xfe\x94= T\xd2\x11\xf4\xe7\xb5\xbaa\x15>E\"\xb54z\x08\x9d\xd7\xbb$&>b\x91\xcf?P\xd2l\\k\xed\xfc]:\x9f6\xdeU\x1b\x9fi\xfe\xa8\x82\x1cU\x0fR=(mH\xc2\xb3\xed\xc1\xd3\xd3\x11\xfc\x88\xc2+;?$\xbf\xbemo\xd1K@y\xa3\xc1g\x03O\xbc7r7G<\xbdT&\xb8\x85\\\xa1\x92\xc9Ju.8\x12\xf2\xb7\xad\xbc\xda\xa8\xbc\xb66eOrHf\x80\xfb\x1e\x9d\xff\xc8\xce\x84\x8c\xda3-\x13\xcd\xbf\xb4\xf2\xea\xad\xf2{\xf0\xf2W\xe7?\xae\xe81\x05\x0e\x14\xe4\xb7p\xc1G\x8e\xa8h\xdeeT\x11\xbcV\x86\xcc\x87\x08)\x19`R^E\x90\x836\x80\xc2\xc1\xff\x86\x86\xa9\xea@\xf2\xb8\x18P\xfe\x8a\x07c\xe6\xd7AD\x07\xd9\xbbn\x1e\xc1Fz\xf0o\xe5A\x94J\xfd\xecA=\xa0\xc1\xa2,\xbc\xde\x1f\"\x1a\xc5\xde+\x1b\x9bl\x1e\xa3\xfa\xa4\xc7y\x94\xf0)\x82\x8drr!\xa0\xf56D\xe4x\x00b+\xd9H\xd4#d\xd1fC%j\xc8U\x98w\x01~\x9f\xc1FsjH\x13\xca\xb2e*c\xf2*:D\xc0X\xfb\x00'\xe81\x9e\x11;\xb5\x8eo\x9f\xb7\xf2j\xe5\xa5[\x15'b\x833\xc6\x1d\xb5\xdd\x7f\xb78\x12Y\x00\xcaY\x9fk\x9b%\xee!\xcc&\x069x7Je\xa5\xea{J.2\xba\xa6\x18b\x0f\x06\xe8K|\x8a\xe2\xfeX\x1d(\x1f\x00S\x06\xd8H\x16\x9b4X(\\v7<\x9e\x85\xe3\x17\x8e\x88t\x95N\xcc>F\x98e\x85\xe9\xef'E\xaa\xfb\xde(=\x86+v\x9f*~l\x847\xf1\xd3\x1d>}\x1dn\x9a*\xae\xa1!\xbb9J\xa3G\x1d\x89\xd3F\x8e\x10\x0f\xaeg\xc9L\xdeu\x10\x02\xc7Q5\x91Y\xcf\x81I\x052\xf9j\x83\xc5 Q\xcb\x18d\x89F\x1d\xd8\x88\xd9\xa3\x9bM/w(\x8e\xc1k\xbb\x87\x9e\xc3\xc4\xe08\xd4$\x03\x1bJ\xa0X\x82\xc9)Y\xf0Gm\xf7\x8d\x9c\x03\xfd'\x801\xf4\x87\x1b\x06@\x82D)(\x03Mr\x07\x16\x88\xea\xa1\x91z\xc4XN\n\x8e^\xd90p\xcc\x02Mf\xafc\xd8\xe6f\xfa\xf2\x8c\x91\x94\xeaY%\x88\x03\xce\xa2\xd7\n\x97\xe8\x14\x91@\xfe\xf8\xfe\x17\xf9#X\xf0\xcal\x90RSC\xa5&?\xcc\x02\x80\x10\xc0?a\xddmY'_\xa10\\^Cd\x1e[w\x8f\xfb\x11\xb48I\x83\xf1,\x07\xf7\xc0Zp)\x1f\x9a\x94\xd0\x98kD\x15[k\xdeB\x9f\xb3\xd0<y\x18\xc0c\xe0C\x1d\xb1\"W\x9e\x96\x88#\xca\xf8\xb7\x9b\xaf\xe4\xb5\xf3\xf4\x97\xbf\xbaY\x99\xf5\x06\xe6\xa95\xd0\x83O\xe0;\x8d\xe6\x91\x03PN=:\xd4&\xd5\xe2\xa9\xeb\xa5\x1a\xe34\xef\xcc^\x02\xecC\x15\x80\xa4\xf5\x84\x1d\x9dA\x1f\x08\xf9\x1f\x86\xc2\x1bIR\xe7\x0d;7\x8e\xce\xe6'8q\xfc\xdb\xcd\xb4/{\xd8\xec'\x17 I\x1a\xe3|\x01\xbf\x8d\xbcJ\xeb\xae\x12\xdb\x0c\x1c@N\xee\x08\xbe\x91\xbd\xf6\xd0\xf1ypO\xcb\xff\xa6@\xdf\xa99\x00=\xcb_R\x0cC\x81[\xb5\x07L#\x05\x071g\xeb\xe8\xbf;\xf1\x89UG\x81\x9e2\xddQ\xa3\xc98OQ\xcd\x1d-\xf8p\xd0\x13\x92\x19\x9d\x07\xca\xb6Dc\xd0C<\xc9	|\x87\xbb\\\xbf|\xfe_7\xd9\x02\xdc\x1cCT\x96\"O8(\x0f\xe4^;\xb00\xe8N'#]\x91\xaeN\xd8\nq\xd7\x96\xaa\xe2G\x8c\x11\x81\xc2\xd2\xf7\xce\xf6\x17\x8b\x85\xf3\x0f\x92\xb8m\xd3b!^#\xda[\xe1.\xf0\xb0;q\x00\n\xa8'\xc6\x03\xa6\x7fv\xd4\x18<\xbc;)\x13O\xcf\x06\x0f\xd0\x08\xeb\xec3\xf8\xd4\x999\xe8\x07\xc8\xce\xf1\x9d\x10\xa8%\xd6\xbe\xb6\x11\x8c\x81.\xa2\x95N\xdeM\xe0\xe3)[\xe4u\x05SR\x04\xe5\xa0\xd4\xc3\xa8\xfc\xc7\x9b\xcaN\xeb\x98\xba\x8e\xa7\x0e+\x85Fz\x98\xbc\xeb\xe7\x0e\x1a\xf4\xa9\n\xc1\xb1^\xc9\xc1Nh0a2\xea\xd4\xa0z\x06\xc2\xbc\xbd\x0e\xa9\x06\xe2\x18_T-\xe1\xd3d\x9c\x8e\xe7\xd1\x0f%\xdd\xe4\x90\xe60\x03\xc8\xd9\xb2\x0b\xa3\x03\xa8\xa0C\xc3\x86\xbeJ\xe9h;\x0c\x0e\x02\x95\x17\x9c\xb5*\x18\xf6w\x82\x0f\x02\xcd\x8b\xa5\xb7\xcar\xc5\x1a6\xec#\xbb\x0d\x0b\x01\xf3@J\x02l\x89u\x16\x80M\xfc_3[R\xc1\x92\x07\xce\x99N\xb9\xe0\x91<p\x87\xe5\xe0\xeba@g{\x00\xf9\x83\x8a \xc4\xcf\x87b\x18\x81\xcd\nzB\x01\xc9%\xef\xda[\x16\x95\x870AGh\x8d!\xc0\xb2\xaf\xd8A\xe7F\x90Ph\x0f\x84\xb7kY\x90{[\xf6w\x04\x9e\xab\x1a\x93\x00\xa8\x0fQ,\xca\x0e\x1bYf\x16\xee\xd1\xc32\x14\x08H\x92|E~\xe8\xdc\xf4\x19v(p\x15\x9e\xa4\xf2|\x02gM\xb1\xf6\xfc4\xa9V\xac \xa0|\xef\n&S&/\xc0\xb8\x9c\xb7:jc\x080\x8c\x93A##$\x87\xe8\xa0p\x94\xe2\x1b\xaf\xc0\xf8\xb2\x00\xb4\x05\xb6/9`\x81\x9f\xef]D\x05\x94\xb8Ti\x86\xe0\xdb\xce=@#\xad\xcb.\x9a\xce\x84\x11>\xb3\x84%\xba\xa8\xa4\x9dB\xc0\x902j\x87\xc9\x91\x80\x8f:+\xf2<\x8c\xee!3tV\xb7\xa5\xc8\xce>1P\x88g\xc04\x12\x06\xa5\x18\x8f\xdb\x7f'\xaf\xf5\x0d\x86+\xee\x0e\x10`N\xb1E\xfb\x9e\xbc\xed\xf4\xa7\xb0D\x82\xba\x16\xden\xca!^\xeb\x1by\xa1uq\xd9-\xaaj(dQ_\xc3\xa7\x0e\xa6X;\xfb\xc6u\x88Pr\x9f\x1b\xc6\xcc\xd7\xdde\xd7/8\x11E}\xa6U\xcdv\xafv\x01l\x07\xb9D=Cr-\x9a\xef\xa2y\xd9;\xe0J\x8c\xe1&\n-Y^\"X\"q\xc0\xd0\xe2\x1f\xb0\x00\xe7\x7f\n\xb44\xb7w\\\xb8\xd6\xee\x8a\xb2[8\x1f\xd5	\xad\xd6\x02bh\x84\x86\x98\x98\xdd8\x99\x13\xc9LT\x95\xbd\x87\xdfg\xed\x93b\xab\x00q\xdf\xbe\xb8!\xcf|\xd1\xca\x0f\xa5\xde*\xad\x05!\xde\xbb\xd5\xee\x18\x0eC\xedM)\x97),m\xb9\x10\xc2C\x93\xadt\x07\x87[GW\x05\x86\xcb\x9d\x01V\x8a\xaa*\xbeR\xfe'\xd8\"\xb2X\xaf\x03@9\xfd\xed\xf3\xf6\xee\x06\x0d\xea\xbc\x8fp\xa9\xe0\x97\xd7z\x10\x13\xf8Q\xc7\x12*\xd6\x8b\x16\xb1\xdc\xb3X^\xb6\xf2_0y\x08`9h]\xc8\xea>?\x10\xea\xe6\xc7\xf2\xfb\x0e\x8c\x86\x07\x08d\xdek\xd3&\xfb\xa2`\xa4\xf7\x1a\xc5\x99\x9b\x83\xd7\xe1\x86\xd3\x01\xb9q\x98\x07D0(\x17\xb6!\x91\xab\xee\xba\x06\x8e\xeeB}\xf1\x99\xc2\xf8\xae\xfd\xb6\x95\xffT\xda\xcb_\x02l\xac7u\x11\x10`\xd8\x1ez\xa4M5\\m\xc7\x88_(\xf3q\xccU\xd3dtGP\xa2s\xd3\x89\xbb\x03\xbd\xeb\xa2\xa7&\x87\x1b\xe4\x80{Q*\xa5\xbfzP\xa9\xaeJ\x10P\xa0\x91>(\x83\xa2\xa4\xf3\xfd\xa5\xad\xd1\x97H\xda	\xf2\x1e\x0b\x91\xfb\xf6\x0e\xff\xe7\x9es\xed}\xfb\x82\xa4\xd9\x95\xe73*\xdc&\x14\xb1\xce\x8f\xad\x10\xf7\xa8\xe409\x1b\xf4N\x1b\x02\xe9\x97\xa0\x1e>x\xdb\xca\x1fV9a\xc8\x157\x96'B\xbc2F\xf6\x9b\x07.E\x94K\xdd\xc1\\4+{\x12\xeb\x9e\"\x99\x15\x8a\x9b{\xcb\x92\xa1	w=\xe9\xeb\xacr\xc4gs\x88\x18\x15r\"\xac\xac{\x9d\x15q!=\xac-\x95Q\x1e:=\xe9\xb5\x19\xf31\xc5\xd2M(\xfd\xe5-KA\xee\xf1;\x9b-m\xb3\xad(E!*\xeb\xe0\x8e\xf8\xc8Iv\xcaJ\xb7\x8b\n\x0b}\xb2\x9aG\x8e\xa9Nh\x8eB\xc5\x08\xe3\xc4(\xc6`\x1dI\xde\x87\xd2\xee\x92'\x14\x1e\xfe\xb4\x89\xb6u\xfb65\xea\xee\x11Qm\xb5\xb9\xe9\x05\n\xf1\x86\xa2Y\x05d/\xeas\xdb5\x8e\x07\xb0)O'$\xb4YC\x92\xa7\xa6\xe5\x0e\xb6]\xd1\xb5}P\xbaI\xdd\xe6\xb0\xb4h\xd7Q\xfc\x96\x05\xfb\x19\x9d\xa6\xbc\x15/\xb4\xb8?\xaf\x8eG\xbb\xdf\xa8h\x0f*8K\xa4\xb8\x84\xa4\xa5Q\x8f`NX\x0dZ\xac#	\x9at\x07\x84\xe2\x88t\xb0\x9eKgQ6\xc1\x80@ic\xe59\xa9\x9dXX\xa8\xf0{\xb6\x89J)t\xca-c\x17\"\xfc\xb6BoR\xa7\xb1\xe0\xae\x98V\xf5\x1a\x81>\x86\\Z\xdb`}\xf5\xa0)\x16&\xef\xa8{\x16\xa5\xb9\xb4=B\x81\x01\x95\xedr$E\xf8\x86F\\\xd4\xf2$\xe3]3\xb6\x8a\xe7\xf7\x08\xaf\xb7\xf6\xbc*\x81\x84\xc8\x92K\x81\x04m\xa6\x12\xe2\xea\xe1\xbcI\x96\\\x95\xd3\x1b\xb1\x16\x06U\xb2h\xcb\x15\xfaH,U\xb0c\xe3\xda(2q)\x98\xb4\xf2Mj\xf2W\x87\xd1\x81Lr\x05\x17s#s\xdd7O-\xf6:\x1c9\x0b\xdcq\xf5p\xe1\xfe\xa4Iw^\x18\xe7\xce\xc3\x1a\xea\xee)\xb70\xcd\x1a\xa70\xcc\xe0$\x19\xeb\xfa\xc3\x9c\x9b\xedv[q\xc1n\xd9\x0b\xb7\xbbR\xbb5\xb8%`\x17\x1bB\x7f\xdf\xcap\xc4\xd2\x9c\x1f\xd4^\xba)R\x13h\x98=a\xeb\xcb M\x9cU7\xd5\xa1J\xdf\xb1\xd2*\xb9S}V\xb1\x9c\x95\x8c\xf4\x05\x16d\x08J\xc3b\x8e(d\xaeU\x8a[\xd0N\x08\x08\xa3b\xd8\xcd\xed\xc3\xb4\x8bM\x14\xae\x97\xb4\xb9\x80\x8e\xf4c\x93k\xaa\xf2\xef^\x07j-\x83\xa7\xe3\x1f\x95GHpb\xc0]\xd7\xa5\x834Z\x11\x168\xdd\xe4\xe69\xf4d_\x8f\xb9d\x92\xf7y\xbd\x93\xf0zq\x14\xe4\x979\xbc\xc0\x10\xa2e$\x93.O\x92\xff\x10\xfeB7\xeaO\xf2\xa3uG+\x07\xc5M\x1fmU\xd7\xcd^u\x9a\xda\xa6\xf7\x08X_1\x10\xcb^\xf2j\x01\xeb?\xa3\xe2\xaa pp. @\xe7\xa6F\xf2\x03\x97C\xb5\x92\x03\x90\x976\x8b\xa4d\x98'jp\x08m{\x18\xad\x8e'\xae\x8b\x93\xb4\xa4\xdb\x19\xbd\xcf\xb0\xc5\xad<o\x95\x8c\xce\xec\xab\x95\xff\xe3\x8e\xf0\x80\xc7(\xe1\xdd\xa1mS\x95\xef,\xc7\x1f\xe4}\x07\x07e\x06>-\x1a\x0e\xb50\xf0\xab\x0b\xe5R\x05s\xa8q\x85\x08v\x17\x9c\x99#&\xa8\xce\x80J\x97\x06\x08\xba\xc8n\xcfY\x95\x85\xd5\xe61^1P\x90\x0c)\xde\x08\x8e\x87\xce\xc2\x92\x95S\xebO\xed=\x90\xc4\x13\xcd\xe1$\x91\xe7u\x89\x97*{\xb1\xec\xa4m7{&~\xde\xa5\xda\xd4^O\xd7\x15\xfb1\x9e\x8e\xce\xbe`\xad\xd4\x9a\xae\xa2\x96x\xc4u\x88\xbf\xc7\\G\x86	:\x84\xb1\xa9\xed$~\x9b\xbd\x0e\xbd&\xd0\xd2\n\xf1\xa2\x95ol9\x0c\x16\x9a\x94=~\x98IB\x1f\xa2\x8a3\x83\xdd\x7f\xc1~6\\~]\xc0\xe5_\xfa\x10\x86\xd3t3\xaa\xc7|\x1dIBN\xc1\xb9\xceZ\xd5m\xe0E\x00{\xd6N\x0bnLA\xcb<\x8a\x8e{\xe2H\x04\xe6\xa8\x91\xbf\xcd=5\xa4\xa5\xf3=\x1a<\xc1\xd8\xcc\"a\xc7b\xb6\xdfQS~[\xd5_\x84\xf4_\xb8\x80%\xfc$\xafw7\"c\xc8T\x1e-\xcaKy0\xb7\x94\xe0$\x155\x05[\xf9\x01\x8d\x8e\xd7Q\xda\xa0\xb3\x89\x1d\xc8\xc9\xa8\x8e\xc1\xa8\x92\x11>\xc5|\x11I\x06\xd4'\x99n\x8a\xa2\xb0\x11\xd4y\x8e\xa9\x0b\x80\xd7)~2s\x99)\xefh*!\xb9\x04\x0bV\xac\xe4\xd8\xb0\xa7l\x0f\x8dx{\xa9\xa71\x1bCT\xda\xa4\x1b#%\xea\x0c\x8a\xfa\xd1\x16\xf3m\xf8\xa8\x8d\xc13\xec@\x12\xb8\x8bi\xda\x80\x1a{R\xc7V\x88\x97-\x05\xd8\x04P\xce&\x8b^b\xf1\xf8\xf3R\xae\xaf:\x96kER?2&Z \xd5\x1c\xdd\xa8\xa2\xee\x10=\x08\xcd\x05\xc9\xa0\xb4y\xc4x\x11w\x90\x85T15\xad\xe2^\xaf\xa0E\x9ao\xed\xd1\xda\xe2\x93\x8e\xc5\x17\xdc\xd5\xf0\x8eX\x85!\n\xf1\xda\xa22\xa0'\xb3%\x94\x18\x12\xeci\xe4l\x0d\x04\xb6\xb2\xd9Fm\xf8\x0e\xb7\xa6\x01\x9f0uiT\x0b>5h\x06LE\x14\x81\xb3\x007\x9d\x9al\xd0|K \x9c\xdd;\x04\x02\xe9\x8a@_h\xe8\xa3\xd0(/a\xba\x1dN)\xf6\x11\xf6\xb3\xce>\xcbb\xe9\xf86\x04=\xfb\xac\xc0\x99\xbc\xe6\xf2\xfb\xdb\xe7\xb2W\xa7 \xd5\x80\xb9<7C\x04\xf5\xd2w\xaa\xfb(\xb5\xcd\xea!\x92\xad|\xe7<\xb8\x9c\xe12\x17\x7fL\xaa|\x1f\xb2\xe2\xf4\x12\xa3\x82\xf8\xd3\x10*\x0e\xe5\x139d \x8b\xd1\x12\xc5B\x9d}\x9e\xd3(\xfd\x1e\x0f\x1d\xe8\x07\xe8ss\xd1\x0d[\xda\xa9\x00XlG\x10\x97\xdbc.\xe91\xddA\x14\xbb\\\xc4|_\x8bY\x90\xe0h\xff).|\xe1)\xc8\x01\xef\xa8v@\x82<M\x121\xbe\xc5\x9c\xa4\xd5^\xa1 \xeb\x0bWL\xc9!\x80\x8f\xd4xI\x80Q\xd4}p\x1eF\xa0\xb6k\x02\x9a=tFy\x15\x9d?a$\xdf\xd3S\x8a[S\x8d\xe8\xdcl#\xf8gy\x86\x019\xec\xbc\x0b!}s\x83\xe1\x10\xf6<Ds\xd6\xb4O\x0dk\xc1\xb7\xaa\xe6T_\xbc\x9aS\xe9T\xd7\x83\x17\x8f\xbbpt\x84D\x90G\x1e\x9e\xa1H\\m\x17J\xd9\xfaHK65\x18\xc4]{{\x96m\xc2A\xd5Q\x8a\xe4\x7f\x8f\x19\x9d\xe3\xf4C\n\xa1q	\x89k\xa2A\xbe\xe4\xd1\xae\x97\xed]\xbe\x07Q\xc6\x08\xc0\xf0\x10\xa0\\\xcf0f\xe2\x12\xb2\xd6BN(\xc8\x06\xf2\xe6!\x801\xe0\xc3\x0dw\xc6\x04\xd9\xea\x0e\xc0\xca\x07etoN\xf5\x8d\n\xf9\x85gG\\\x91:\x8f{\xa2\xd8b\xcd\x0b\xb3\x1ff\xff\xa0\x1f\xa0\xfe\xa5\x15\xe2\xcf_\xe9#\xfe,\xbf\xca\x87\x08}K\xcd\x81\x04\xe2P3\xbff\x0c\xf7\xf4\x0f\x11z\x0cg=\xfb\xd2\xea\xea\xf3uY\xbbT\xb7\x97\x0e\xc5\x85D\xc6Q\xf4Ja\x94\xbb\xaa	U\xb7\xcbn\x8e\x0b\xcaM\x85\xc5Gm\xfbr5\x0d\x9f&\x0f!@\xdf\xe4{C\x02rL\x88\x11\x89\xf3\xa7\xaa\xb1\xdb\\\x1c\x87J{`\xac.U\xfc\x17X\x1b\xb0\x86p\x83\xec\x01\xc1Yh\xe4\x08\xbe;(Km\xa7F\x0e\x9ag\x9d\xd4JF\xd5\xc8m\x9a	\xc1\xd3b\xdcNAE\xdb=\xe3\x13\x0c\x8d\x98ut\xf8\x88\xb5E\x02^\x85\xd0\xef\xb3\"\xc0\x8e>\x97\xee\xf9s\x85~1\x92\xe8P\x8d\xa5\xd4'\xfap\xa0A\xadj$mY\x84\xda\x83\xc4 \xfaW\x9a\x04K\xb8\x9bK\xc4B\xe8\x9a\xfak\xeb\xc2\xef\x06\xe3\xfa<B\xc2\xb3\xdc_\xe4a\xb9|[\xc6\x97n\xa4\x98D\xc8\xc3\xa44\xe3\xf1\xce\xf94\xd7\x82B\xd1\xa1\xea\x1d\xac\xea\x9f\xce\xd9\x10u\xa4;oe3!\xb4\x0bK\xd3\x8c\xf9v\xf2\xecFz\x0e\xb0\xd4\xab\xe7\"K\x84\xd4\x1c\x0f\xce\xeb\xff\\\xb6\xe3\xd4Z\xa8~YN\xd9\xae]\xe4k|\xfe\xfc\xf5\x02\xdb\xd7\xa3\xf4\xf5x\x93\xf2/\xf5x\x02j\xe7m)e\x9f\xfc\xf9|\x88\xfc\x031\xf2\xeb\xb2\xf6\x0b\xd9\x88u\xb2\xd3\xbe\x9bG\xee\xaae,\x9e~\xe29\xb5x\x00\x8a[yX+:\x1f+BU\xc7\xcd\xc2\xde\xe8=\xd8\x0en\x9a2\xd0\xd5l&\xba8O\xa2\x99oN\xb4\xc2\x81\xdc\xf1p\x16\xe4\xf1\xe0d=Hr\xe6\x1c*l\x08-7\xba	E\xec\x80z\x10\\\xa5Q\xad\x94F%\xf2\x00\xdb2\xca\xb6\"D\xed\n\x1e\xb5\xeet\x0f6\xe2\xdf\x14\x0bl\xba\x96F\x8f\xee\xd5\xa8\xf6P\xee\xe6\xbb\x83B\xb6\xc1W\x84\xbe\x10\xf23\x85\x81\xee\xf9\x03U\xb3\x83\xc6@n\\ \xc2\x99\xd0\xde\xb9\x1e\x8b\xc1\x86{\xda!\xbaiR{h\x08(\xcf\xb8)V4\xb3\xe7&\x9b2\xc3l;\xde#q\\\xe2H\x02\x7f<F\xd1\xb9\x11S\xc6\x8a\x1b:\n\xf5\xb1\x11\xba\x95\xa2\x82\x86;j\x19\x91:\x17h\xc5\x17MX\x99p\xf8\xe7nC\xea\x8f%\x1ai\x8f\x14H\x13!\xb3r\xb4\xaagD\x1bPD\x9f\xb0\xb0\xa5\xfb\x92\xfc#J\xac\x07\x15\x0f\x94i\x8b\xfa\x03u7\xb5\xfdm\xf6\xa7\xd4	\xa31\xddRs\xa4\x11\x95\xcab\xeb\xb4\x96\x836\xb7\x16\xaa{l\xa3\x8e\xa5\xd3\x90F\x9a\x96c\xb7\xf2\x03\x96,\xeb\x0f\x11\xaa\xdb\\A\xf6.\xbd\xa0`\xf8\x9aM\xa6\x99\xbe<QT\x8baCh\xb1\xc3G\xcd0\xb5\xffu\xa8\xc8\xa2\xb2\xd7\x84\xaa]r\xbb\xbdH\xb7\xce\xcb\x9f\xfb|\xcdx\xf4\x95>B\xfc\x15#v.\xf0\xd6-\x17!^Q\x7f\xbd\x94\x7f\x1e\x8c\"\xbb\x88n\x9dF\xd3\x1c\xcd\xce\xbby\x7f\x88\xdcp\xe66?Vs>\xa6y\x92Z\xad\x18\x1d\xd3\x14\x1a\x82\x14\xdbc\xcd:*\x9d\xc6\xec\xd1\\&\x8f\xea\x9b\x94\x11\xd4#C\x1a\xbb9h\x9b[ \xc9\x9c\xca\xf1\xd8\xf0w\xb0\xba\xb92\xea\x98\xbae*\xae\xda\xa7Kd\xa1\x89\xe7l\xd2\x9a\xdf\x85\x18\x8c\xee\xe237<K\x16\xcc]\x98@\xe3h\x07\x14\xc1v\xa8\x8e7\x9f<\x97m\xaa8L\xd5\x9d\xdd!H\x14u)\xcbFY\x97\xbb\xad\x10\x7fk\xe5;\x1d:0FYp\xf3\xf9$\xc5f\xd2d5=\x93:\x00\x10\xab\xaa\x0f\xf9\xe9\xc0\xdb\xfc\xdaI\x98w\xbfA\x17\xc5\xa8\"\x06\xc0\xf4\xc2\x14\xd6\xfdT\x13gf\xcf\xeaU\x1d\xe4\x01L\xcfM<1[\xc0\xc8\xd5\x01cf\x8e\x12em\xd1\x85\x87\x1c\xde,{\xcb\xd2\x83\x14\xab\xb9\xab|\xbbPQm%\x19\xa0:n\xfa\xbb<\xb7\x91\xea\x93 \n\xea7\xca\xeeg\xb5O\xaf\xc8\x95\x01\xe9r\x16\x82\x9a~\xc6<\x97\x1a\x19d~\x9e;#K\xdc\xdc\x01\xbd\xde\xc7\xcdA^\xb2\x96D\xe9\x83\xacnJ\x84\xb8}\xde\xe6\x06Di\xd1\xe6\x1ax\xab\xc5\x95Bo\x9f\xb7\xb7\xad|\x0f\xc7\xb2\\\x88\xfc\xba\xe6?\xddl\xfbrI\x12\x97\x81\x1c\x19\"\x1c\x95\xefK\x87WUE\xdb2\xdc\x80\xc4\xefiB\x92\xee\x92\x96\x99\xe6\x0b\xa4hX*\xd6\xaf&\xf1d\xb2t^L\xf3\xce\xe8p\xa0\x97e\xd6\xaf\x1f\xac\x9a\xcd\xaa;\x94\xd9\xb3<\x16\xba\xd7\x98\x11\x15\x8d\xb1i\xbb\x9fu \x1f\xca\x8f\xd9y\xdcA\x92_\x99\xd1E\xd2k\x81\\\x18Z\xf8L\xe7e\xd3\xe5\x87\xbc\x9bX\xeb%=\xbd\x8c\x02\xe5I2sZ\xdat\x97\xb6\xa1;\xcfKcs\xf6ti\x0c/Io\x99\xeeI\x92\x17Y\x89\xc4\xfd}\x9bf\xb2\xa1\xaf\x18\x7f3\xc8\xd32\xbbTF8i6r53\xb4(\x82\xdbf\xb8\xeaHCnN\xe4\x81\x05\xd2_=tA\x9e\x1b\xcam\xeb\xe9|\xc0\x01+-%\xca\x04\xf9f\xb2p\x89\x0f|L\x0fV\x8d\xb0\xb2.\xee.\x8d\xee\x01\x04\x17\xa1)\xe0\x96\xda\x98V\xac\xc7\xcd\x8aI\xe6yMn:\xf3\xa4.\xdf4\x96\x13\xe5%<r\x12\xf2\xf8\xf1r\xb6\x1b\x16\xef\x8bz\xbc\xc3\xee\x1fy\xd7K\x87\xcf\xcd+\xe4{\xacP&\x9b\x96[\xe0\xca2\x9fNZ\x9c\x8fB<6w\xb3\x16}\xf5\x12'\xf7\xdf\xd7\xaf\xa0\xae_=\xdd*+\xdf\xe4\xe4\xb7X[!\xca{\xbc\xf2\xd9\xf9\xf1\xf3:\x9e<\xf8#\xb7\x88BH\xc6\xadg4u\xc8\xb9(\xdb\xc1\xcaa\xd7\xef\xab\x8bj\xe2\xe8\xa1\x95w\xedsNY\xd5\xd4\x1b\xc8w?\xbd\xa5\xb7d\xd15\x16e\xf4K;]H\xba_\xe3\xfeG5\xad\x85\xa1QEy\x88q\xfa\xee\x9boF\xde\xb9u~\xff\xcd\xbb\x9f\xde~s\xd7>\xff\xa6\xadnA\x91|\xb9\x07%D\x1d\xb4\xcf\xf5\xd24s^Y^\x90^\xddM\xf0\xf6\xcb\x15eu[\xbc]e\\\x1aB\xb8&s\xa7k\xea\xb7o\xbe\x7f\xfd\xfe\xc3k\x91_WT\x88\xca\xe0\x01\x9d\x9cK2\xe7O7	\\\xa9j\x9a\xa5\xbc\xd3g\xf4GHU\x81s\x1fE\x89\x00j\xb9\x06(C\x16}_O*\xf3\xa0D\\F-\xdcP\xcd\x8c\x94\xd7\x82*K\xfa\x87|\xf6\xc47\xb0\xff\x1fv\xb5\xfe|\xd6\xca\x9ev\x86F*\xb4\x0fz\xbfk\x89\xd3\x97\xff_\x13\x8a\x0d\x8a\xff\x0b\x00\x00\xff\xffPK\x07\x08xF\x88\x89\xad\x14\x00\x00VA\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce9sO\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0b\x00	\x00mpl2.headerUT\x05\x00\x01U\x96\xd3]<\xcd\xb1J\xc00\x10\x87\xf1\xbdO\xf1\x7f\x80\x92\x94\x8e\xae\x82 \xb4P\xd0\xc51I\xaf\xe6$\xcd\x95\xdc\xc5\xa2O/(8~\xc3\xc7\xef5\xb3\xe2EzK\x84G\xd9	O\xd2N\xb0B{\xfc\xa0d0\x81e\x82Q;\x15r\xfc\xc6*\xdf\\J\xc0\xd6c\xe14,\x9c\xa8*\x8d\xf8\xa4\xa6,\x15\xb3\x9b\x1c\x9e\x0f\x04$\xb9\xbe\xfe\xafm\xc1\x1d\x14U\x0c;\xab5\x8e\xddh\xc7\xcd\x96a\x99u8\xb8\xd0\x887\xe9H\xa1B\xa2\x05\xae\x90J\x08\x86lv=x\x7f\xfe\xd1N\xda\xbb_\xb7\xc5\xcfn\xf2n\xf8	\x00\x00\xff\xffPK\x07\x08^\xdfR\xb0\x9e\x00\x00\x00\xc6\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce9sO\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x06\x00	\x00newbsdUT\x05\x00\x01U\x96\xd3]\x94\x92Ao\xe36\x10\x85\xef\xfc\x15\x83=u\x01\xc1E\xdb[\xf7DKc{\x00\x99TI*^\x1f\x15\x8b^\x13\xb0D\x83\xa4\x13\xe4\xdf\x17\xa4\x1d\xc4\xd9\x16\x05z\x12!rf\xbe\xf7\xe6\xf1\xf3\x19\x82\xfbqJ\x11\x82\x8d6\xbc\xd8q\xc1\x98\xb2\xa3\x8b)\xb8\xe7kr~\x86a\x1e\xe1\x1a-\xb8\x19\xa2\xbf\x86\x83-\x7f\x9e\xdd<\x8478\xfa0\xc5\n^]:\x81\x0f\xe5\xeb\xaf	&?\xba\xa3;\x0c\xb9A\xc5\x86`\xe1b\xc3\xe4R\xb2#\\\x82\x7fq\xa3\x1d!\x9d\x86\x04\xe9d\xe1\xe8\xcfg\xff\xea\xe6\x1fp\xf0\xf3\xe8rQ\x84\\4\xd9\xf4'c\xbf-\xe03R\x04\x7f|g9\xf8\xd1\xc2t\x8d	\x82M\x83\x9bK\xc3\xe1\xd9\xbf\xe4\xab\xcb[Q\x07\xb3O\xee`+H'\x17\xd9\xd9\xc5\x94\x1b<\xce\x9a\xc7\x9f@F\x17\x0f\xe7\xc1M6,\x18\xfb\xfd\x9f\x00n~t\xe0\x1d\xe0\x12\xfcx=\xd8\xffb`\x99\x01\xfe'\x03\xdcu\x8d\xfep\x9d\xec\x9c\x86\xf7\xc5\xfc\xea\x03\xf3\xe9d\x03LC\xb2\xc1\x0d\xe7\xf8\xe1oYJ){@_0\xf6\xc7\x02\x84u\xa5*\xdf\xce\xc3d3K>\x7f\xe0\x9e\xfcy\xb4\x01f\xff\xf1\xa8\xd8\xeeR\xcc\xd4\xb7~>D\x98\x867\xf6lsBFH\x1e\xec<\xfa\x10m\x0e\xc3%\xf8\xc9'\x0b7WR\x84\xd1\x06\xf7bG8\x06?\x95]@\xf4\xc7\xf4\x9a7\xfd\x1e\x9cx\xb1\x87\x9c\x1cv	.\xe7)\xe4\xcc\xcc\xb7\xf4\xc4x\xe37\x1b\xd2\xa0\xe5\xca\xec\xb8B \x0d\x9d\x92O\xd4`\x03\xcb=\x98\x0dB-\xbb\xbd\xa2\xf5\xc6\xc0F\xb6\x0d*\x0d\\4PKa\x14-{#\x95\x86/\\\x03\xe9/\xf9\x82q\xb1\x07\xfc\xde)\xd4\x1a\xa4\x02\xdav-a\x03;\xae\x14\x17\x86PW@\xa2n\xfb\x86\xc4\xba\x82eo@H\x03-m\xc9`\x03FVe\xe8\xbd\x8c}\x94\x81\\\xc1\x16U\xbd\xe1\xc2\xf0%\xb5d\xf6\x05dEF\xe4Y+\xa9\x80C\xc7\x95\xa1\xbao\xb9\x82\xaeW\x9d\xd4\x08YVC\xban9m\xb1Y0\x12 $\xe0\x13\n\x03z\xc3\xdb\xf6_Uf\xf6O\x1a\x97\x08-\xf1e\x8b\xb7Ib\x0f\x0d)\xacM\xc5H\xdcOY\x185(\x0co+\xd0\x1d\xd6\x94\x0f\xf8\x1d\xb7]\xcb\xd5\xbe\xba\xf7\xd4\xf8W\x8f\xc2\x10o\xa1\xe1[\xbeF\x0d\xbf|\xb6\x84\xfdlI\xa7d\xdd+\xdcff\xb9\x02\xdd/\xb5!\xd3\x1b\x84\xb5\x94M1Z\xa3z\xa2\x1a\xf57h\xa5.n\xf5\x1a+h\xb8\xe1y0\xeb\x94\\\x91\xd1\xdf\xf2\xdbe\xaf\xa9\x98F\xc2\xa0R}gH\x8a\xaf\xb0\x91;|B\x055\xef56\xc5])\x8aT\xb3A\xa9\xf6\xb9i\xf6\xa0\x98_\xb1\xdd\x06\xcd\x06\x15\x90\xb89\xc5\xb3\x05\xda(\xaa\xcd\xc3\xb3<\xcfHe\x1e4\x82\xc0uKk\x145\xe6[\x99\xbb\xecH\xe3W\xc6\x15\xe9\xfc\x80ncw|\x0f\xb2/\x92\xf3\x8ez\x8d\xb7\xe3Cb\xab\xb2I\xa0\x15\xf0\xe6\x892\xf6\xed1\xeb\xa4\xd6t\xcfI\xb1\xac\xde\xdc\xed^\xfc\x1d\x00\x00\xff\xffPK\x07\x08Y\x97\xb8\x85\x07\x03\x00\x00\xa9\x05\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce9sO\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x10\x00	\x00newbsd.copyrightUT\x05\x00\x01U\x96\xd3]r\xce/\xa8,\xcaL\xcf(Q\xd0H\xd6Tpp\xa8LM,rp\xd0Qpp\xc8/\xcfK-rp\xe0\x02\x04\x00\x00\xff\xffPK\x07\x08OAG\x98(\x00\x00\x00\"\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce9sO\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0d\x00	\x00newbsd.headerUT\x05\x00\x01U\x96\xd3]\x94\x92Ao\xe36\x10\x85\xef\xfc\x15\x83=u\x01\xc1E\xdb[\xf7DKc{\x00\x99TI*^\x1f\x15\x8b^\x13\xb0D\x83\xa4\x13\xe4\xdf\x17\xa4\x1d\xc4\xd9\x16\x05z\x12!rf\xbe\xf7\xe6\xf1\xf3\x19\x82\xfbqJ\x11\x82\x8d6\xbc\xd8q\xc1\x98\xb2\xa3\x8b)\xb8\xe7kr~\x86a\x1e\xe1\x1a-\xb8\x19\xa2\xbf\x86\x83-\x7f\x9e\xdd<\x8478\xfa0\xc5\n^]:\x81\x0f\xe5\xeb\xaf	&?\xba\xa3;\x0c\xb9A\xc5\x86`\xe1b\xc3\xe4R\xb2#\\\x82\x7fq\xa3\x1d!\x9d\x86\x04\xe9d\xe1\xe8\xcfg\xff\xea\xe6\x1fp\xf0\xf3\xe8rQ\x84\\4\xd9\xf4'c\xbf-\xe03R\x04\x7f|g9\xf8\xd1\xc2t\x8d	\x82M\x83\x9bK\xc3\xe1\xd9\xbf\xe4\xab\xcb[Q\x07\xb3O\xee`+H'\x17\xd9\xd9\xc5\x94\x1b<\xce\x9a\xc7\x9f@F\x17\x0f\xe7\xc1M6,\x18\xfb\xfd\x9f\x00n~t\xe0\x1d\xe0\x12\xfcx=\xd8\xffb`\x99\x01\xfe'\x03\xdcu\x8d\xfep\x9d\xec\x9c\x86\xf7\xc5\xfc\xea\x03\xf3\xe9d\x03LC\xb2\xc1\x0d\xe7\xf8\xe1oYJ){@_0\xf6\xc7\x02\x84u\xa5*\xdf\xce\xc3d3K>\x7f\xe0\x9e\xfcy\xb4\x01f\xff\xf1\xa8\xd8\xeeR\xcc\xd4\xb7~>D\x98\x867\xf6lsBFH\x1e\xec<\xfa\x10m\x0e\xc3%\xf8\xc9'\x0b7WR\x84\xd1\x06\xf7bG8\x06?\x95]@\xf4\xc7\xf4\x9a7\xfd\x1e\x9cx\xb1\x87\x9c\x1cv	.\xe7)\xe4\xcc\xcc\xb7\xf4\xc4x\xe37\x1b\xd2\xa0\xe5\xca\xec\xb8B \x0d\x9d\x92O\xd4`\x03\xcb=\x98\x0dB-\xbb\xbd\xa2\xf5\xc6\xc0F\xb6\x0d*\x0d\\4PKa\x14-{#\x95\x86/\\\x03\xe9/\xf9\x82q\xb1\x07\xfc\xde)\xd4\x1a\xa4\x02\xdav-a\x03;\xae\x14\x17\x86PW@\xa2n\xfb\x86\xc4\xba\x82eo@H\x03-m\xc9`\x03FVe\xe8\xbd\x8c}\x94\x81\\\xc1\x16U\xbd\xe1\xc2\xf0%\xb5d\xf6\x05dEF\xe4Y+\xa9\x80C\xc7\x95\xa1\xbao\xb9\x82\xaeW\x9d\xd4\x08YVC\xban9m\xb1Y0\x12 $\xe0\x13\n\x03z\xc3\xdb\xf6_Uf\xf6O\x1a\x97\x08-\xf1e\x8b\xb7Ib\x0f\x0d)\xacM\xc5H\xdcOY\x185(\x0co+\xd0\x1d\xd6\x94\x0f\xf8\x1d\xb7]\xcb\xd5\xbe\xba\xf7\xd4\xf8W\x8f\xc2\x10o\xa1\xe1[\xbeF\x0d\xbf|\xb6\x84\xfdlI\xa7d\xdd+\xdcff\xb9\x02\xdd/\xb5!\xd3\x1b\x84\xb5\x94M1Z\xa3z\xa2\x1a\xf57h\xa5.n\xf5\x1a+h\xb8\xe1y0\xeb\x94\\\x91\xd1\xdf\xf2\xdbe\xaf\xa9\x98F\xc2\xa0R}gH\x8a\xaf\xb0\x91;|B\x055\xef56\xc5])\x8aT\xb3A\xa9\xf6\xb9i\xf6\xa0\x98_\xb1\xdd\x06\xcd\x06\x15\x90\xb89\xc5\xb3\x05\xda(\xaa\xcd\xc3\xb3<\xcfHe\x1e4\x82\xc0uKk\x145\xe6[\x99\xbb\xecH\xe3W\xc6\x15\xe9\xfc\x80ncw|\x0f\xb2/\x92\xf3\x8ez\x8d\xb7\xe3Cb\xab\xb2I\xa0\x15\xf0\xe6\x892\xf6\xed1\xeb\xa4\xd6t\xcfI\xb1\xac\xde\xdc\xed^\xfc\x1d\x00\x00\xff\xffPK\x07\x08Y\x97\xb8\x85\x07\x03\x00\x00\xa9\x05\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce9sO\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00	\x00	\x00unlicenseUT\x05\x00\x01U\x96\xd3]lTO\x8f\xe26\x14\xbf\xfbS\xfc\xb4\xe7\x94\xb9WU%\x0f\x98\xc1j\xb0\x91c\x96\xce1$\x8f\xc1mb\xa7\xb6\xd3\x15\xfd\xf4\x95\x03;\xb3S\xf5f\xec\xf7\xfb\xcb\x03{u	.\xe1\x12\x89\xd0\xfa\x1e\xb3'\xdf\xcd\xe3\x99\"\xf5H\xe1\x92\xbf\xb5\x91\x10i\xa06Q\x0f\xe7s@\xbe\x12\xa6\xf9<\xb8\x0e}\x18[\xe7W\x8cq\x7f\x0b\x9e\xde\xa9r@\x17\xa6[\x851\xf4\xeer\xab\xee\xf3\xe9ZaNT\xa1\x0b\xe3\xe4\x06\xaa\x90h\x18*\x84\xc8z\x97rt\xe79\x13r\xf1\xf4]\xba\x02\xb9|\xa5\x08\xe7\x91\xc2\x1c;B\x17z\xc2%\xc4\x11!\xa2Mh\xbf\xd3\xf5\xec\xec|\x1boUyE\xebo\x98\xe68\x85\x87\xe0H\xb1s\xedP@>\xf8\x9f>n\xaa%\xf8\xf9V\x10l\xa4\xd6\xa7\x15c\xd2\xe3\x8f9\xba\xd4\xbb.\xbb\xe0\x13\xf2\xb5\xcd\x88\xd4\x857\xef\xfe\xa1%]to\xd7\x8c\xa1\xfd\x96\xaa\xa5\x93v\xce\xd7\x10\x17W\xcb)\xb1p\xf9\x9c\x06=\xf5\xaek3-\xee\x8al;\x0c?p9\x9f)R*\x87\xc2\xc8\xdeq\xff\xdb:N\x84\xb1\xfd\xf3\xd1\xd8\x83\xda\x05\xbf\xc4/\xe3g\xf2tq\xf9n\xe3\x1d\xdd\x16\xcf\xf1\xed\xfe}?\x88{\xca\xd1\x8d\xe43\xc2\x05a\x8e\xb8\x92\x8b\xa9L\xb04w\x1d\xa5\x14bZ\x04\x8b\xc5\x82\xfb\x8fd\x0e8\x17F\x84\xbf)f\xb4]ab\x91\x06\xe7\xff\x9a]\xba.\xdc\xcec\xa28Q\x9e]\xbe\x15\xa5\x92~\x8a\x94\xcacqs\x99\xf3\\\xd6\xad\x94\x91\xee\xde\\\xfaha\xf6=\xc5\xcf\xd5\xaf\x18\xb3;\x81Fo\xed\x89\x1b\x01\xd9\xe0`\xf4W\xb9\x11\x1b|\xe1\x0dd\xf3\xa5\xc2I\xda\x9d>Z\x9c\xb81\\\xd9W\xe8-\xb8z\xc5oRm*&~?\x18\xd14\xd0\x06r\x7f\xa8\xa5\xd8T\x90j]\x1f7R\xbd\xe0\xf9h\xa1\xb4E-\xf7\xd2\x8a\x0d\xacF\x11|PI\xd1@o\xd9^\x98\xf5\x8e+\xcb\x9fe-\xedk\x85\xad\xb4\xaapn\xb5\x01\xc7\x81\x1b+\xd7\xc7\x9a\x1b\x1c\x8e\xe6\xa0\x1b\x01\xae6PZI\xb55R\xbd\x88\xbdPv\xc5\xa4\x82\xd2\x10_\x85\xb2hv\xbc\xae\x17)~\xb4;m\x1a<\x0b\xd4\x92?\xd7\xe2\xce\xaa^\xb1\xae\xb9\xdcW\xd8\xf0=\x7f)F\x0c\xd3v'\xcc2\xf60r\xda\x89\xe5J*p\x05\xbe\xb6R\xab\x12\x7f\xad\x955|m+Xml\xc9\xbe@O\xb2\x11\x15\xe3F6%\xfb\xd6\xe8}\x85\xd2\x9c\xde.\xf5\xa8\x82S\xe2\xceRZ\xc5\xa7\xf2\xb5Y>\x1f\x1b\xf1\xe1e#x-\xd5KS\xc0?\x0e\xaf\x18\xdb\x86\x881\xc4\xb2S\xe5\xe7\xbc,R\x85i\xf9\xabA\xa4\x0b\xc5\xb2\x02\xbf\\s\x9e~~z\x9a\xfd\xe0:\xf2\x89V!\xbe=\xfd\xca\xfe\x0d\x00\x00\xff\xffPK\x07\x08\xde\xf4\xce\x98\xbc\x02\x00\x00\xbb\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce9sO\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x13\x00	\x00unlicense.copyrightUT\x05\x00\x01U\x96\xd3]r\xce/\xa8,\xcaL\xcf(Q(O\xcc,KMQpp\xa8LM,rp\xd0Qpp\xc8/\xcfK-rp\xe0\x02\x04\x00\x00\xff\xffPK\x07\x08\x0c\xa7uF+\x00\x00\x00%\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce9sO\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x10\x00	\x00unlicense.headerUT\x05\x00\x01U\x96\xd3]\x1c\xcb\xb1\x8d\xc30\x0c\x05\xd0\xdeS\xfc\x01\x0ev\x7f\x08Rf\x82, K_\x11\x01\x894(\nY?H\xaaW\xbdg#\xb2iPc\xc2*\xa2\xc9D\x95N|u\x12I\x0b\x96R\xf3\x1a'\x9d\x05\xd3j\xbc\x93\x13\xce\xce4Y \x1a\x86h\xdc\xaeuv\xc9(6\x92\xe8\x8e\x879\x869!Z\xcdG\n1\xfd\xc3\xf5[pV:\xc2pk\x11\xd7\xffq,\xed\x92\xa9\x93\xbb\xf9\xeb\xb8o\x9f\x00\x00\x00\xff\xffPK\x07\x08k\xc8\xae\xa4|\x00\x00\x00\x9b\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00k:sO\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x03\x00	\x00uplUT\x05\x00\x01{\x97\xd3]dT\xc1r\xa38\x10\xbd\xf3\x15\xafr\x8a\xab\xa8\xec\xeeuo\xc4\xc6\xb1j1\xb8\x00O6G\x19\x9a\xa0\x1d!\xb9$\xd9\x1e\xdf\xe6Cv\x7fn\xbed\xab\x818\xc9\xcc\xc5.\xa1\xee\xf7^\xbf\xeeV\xdd\x13\xf6F\x9d\xc9y\xa9\xb1#7(\xef\xd5\x99\x90\xa9\x86\x8c'\xdc\xefw\xd9\"\xc6\x17r^Y\x83?\x1e~\x8f\xa2\xeat\xf8\x87\x9a\x80`\x11zBcM\xab\x02\xdfz\n\xe8\xac\x0b=\x0e\xa4\xed%\xc6q\x06\xb4\x06\xca\xa3'G\x87+^\x9d4\x81Z\x04\x1bIs\xe5\x18o\x0d\xec!He\x94y\x85Dc\x8fW\xd8\x0e\xa1W\x1e\xdev\xe1\"\x1d\xc5\x90\xde\xdbFI\xcemms\x1a\xc8\x04\xc9\xbc\x914\xedo\xd6\xa1\x95A\xe2\xbe\xb1ZS\x13\xd4\x99\xf4u\xd4wW\xcd\x08w\x8b\x18\x9d#b\xe8\xa6\x97\xee\x95 M\x8b\x93i\xc9\x81\xa5H\xd3FR\xeb\x91\xdf\xa9\xd7>`\xfc\xf5PfD\xaa\xde\xa5\x98\xf6-\x03\x9cq\x94\x81\xcc-\xdc^\x0c\xb5\xb0.b6}\x85\x1e\xcd\x94\x07M8\\A\xb2\xe9\xe7O\xd6\x8d\xa6L\n\x1a{&\xc7\x06\x90\n=\xb9\x08\xb8W\x8b\x91\xf7d\x06\xdb\xaaNQ{\x93\x00\xe9\xd9\xf8\xe0\xd4\xe14\x99	\xebpt\xf6\xacZj\x99\xc6\x9f>\xd0\xc4\xac\x86\x01g\xc4\x8c\xabwx\xb6\xee\xab\xc7\xbd\xf4h\xa9S,zl\xdc\"\x8e\x82EKRs\xe5\x07\x1bz\xce\x95\x8b_M\xe0\xef\x87\xc5h\xc5QQ3Z\xebo\x12\xa7\xb6\xf4\xd2\xb5\xe3Y+\xcfRg3\xb5{u\x17\xf7\xd5?\x84o\x01\x9d\xd2\x04\xd5E\xd6\x10O\x8a2\x8d>q\x1d\x17\x15\xfaO\xac\xb8\x1f\xed\x93\xf8\xf1\xfd\xdf\x0fE\xfc\xf8\xfe\x1f[p\xe9U3\xc6G\xb7x\xf5\xd9\xa7\x9f\x8d\xf1\x8b8b\x12{\np\xe4\x83S\x0d\x8fT<K\xe0v\xbc]k5\xa8i\xe0FEs\xaf\x83\x8dx\\b4\x8ed \xb4\xe4\xd4Y\xf2\xf8\xe12\xbak\xbb\x18\xad\xf2G-\xaf\xe3Bt\xd6\x0d\xd3\x00\xb5\xca\xcf\xba\xa2O%\xf2\xdd \xbfR\x8c\x93\xa7\x18\x9e\xb4\x8ea\xbb\x8e\x1co\x17\xbc\xd4\x14C\x0dG\xebB\x0c\xfa6\xfd\xf7\xf2L\x18dK1\xaf\xc3t\xf4V\xb7\xf8\x05\xfa\xa7\xfe\xdf\xfb\xc5$'X\xf8\xd3A\xcf\x8b\xcf&v\xd6\xd1\xabe\x0f\xde\x06\xdb\xcc\xc3\xc9\xd7\x9ex\xe4\xect$7\xf8\x87(\xaayc\xdf xy??\x15\x9d\xd5\xda^\x18\xf0\xf6h\xfc\xc9I\x04y\xb0g\xfa\xb0x\xc6\x06\xd5Lzo\x8cc+\x87\xa3\xa6@\x1f_\x969\xd4:\xc8\x10I\x0c\xca\xa8\xe14@\xc2QG\x8eLCo\xf4\xfb]\x86\xe1\xe4\x03\x0e\xf4>b\xca`\xdeyE\x9e\xd7\xc4\x9f\x0e>H\x13\x94\xd4`o\x955~z\x8d\xde\x8d\xe4R7)\xaab]?'e\nQaW\x16_\xc4*]\xe1.\xa9 \xaa\xbb\x18\xcf\xa2\xde\x14\xfb\x1a\xcfIY&y\xfd\x82b\x8d$\x7f\xc1_\"_\xc5H\xff\xde\x95iU\xa1(#\xb1\xdde\"]\xc5\x10\xf92\xdb\xafD\xfe\x84\xc7}\x8d\xbc\xa8\x91\x89\xad\xa8\xd3\x15\xea\x02L8C\x89\xb4b\xb0mZ.7I^'\x8f\"\x13\xf5K\x1c\xadE\x9d3\xe6\xba(\x91`\x97\x94\xb5X\xee\xb3\xa4\xc4n_\xee\x8a*E\x92\xaf\x90\x17\xb9\xc8\xd7\xa5\xc8\x9f\xd2m\x9a\xd7\x0f\x109\xf2\x02\xe9\x974\xafQm\x92,c\xaa(\xd9\xd7\x9b\xa2d}X\x16\xbb\x97R<mjl\x8al\x95\x96\x15\x1eSd\"y\xcc\xd2\x89*\x7f\xc12K\xc46\xc6*\xd9&O\xe9\x98U\xd4\x9b\xb4\x8c8lR\x87\xe7M\xca\x9f\x98/\xc9\x91,kQ\xe4\\\xc6\xb2\xc8\xeb2Y\xd61\xea\xa2\xaco\xa9\xcf\xa2Jc$\xa5\xa8D\xfe\x14\xad\xcbb\x1b\x83\xed,\xd6\x1c\"r\xce\xcb\xd3	\x85\xad\xc6\xa7\x8e\x14\xe5x\xdeW\xe9\x0d\x10\xab4\xc9D\xfeTE\"\xff\x14\xfc\x10\xfd\x1f\x00\x00\xff\xffPK\x07\x08;&\xe9\x95\xda\x03\x00\x00\x08\x07\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\xce9sO\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0d\x00	\x00upl.copyrightUT\x05\x00\x01U\x96\xd3]r\xce/\xa8,\xcaL\xcf(Q\xd0H\xd6Tpp\xa8LM,rpPpp\xc8/\xcfK-rp\xe0\x02\x04\x00\x00\xff\xffPK\x07\x08\x9e#\xb0\x1a'\x00\x00\x00!\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00k:sO\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\n\x00	\x00upl.headerUT\x05\x00\x01{\x97\xd3]dT\xc1r\xa38\x10\xbd\xf3\x15\xafr\x8a\xab\xa8\xec\xeeuo\xc4\xc6\xb1j1\xb8\x00O6G\x19\x9a\xa0\x1d!\xb9$\xd9\x1e\xdf\xe6Cv\x7fn\xbed\xab\x818\xc9\xcc\xc5.\xa1\xee\xf7^\xbf\xeeV\xdd\x13\xf6F\x9d\xc9y\xa9\xb1#7(\xef\xd5\x99\x90\xa9\x86\x8c'\xdc\xefw\xd9\"\xc6\x17r^Y\x83?\x1e~\x8f\xa2\xeat\xf8\x87\x9a\x80`\x11zBcM\xab\x02\xdfz\n\xe8\xac\x0b=\x0e\xa4\xed%\xc6q\x06\xb4\x06\xca\xa3'G\x87+^\x9d4\x81Z\x04\x1bIs\xe5\x18o\x0d\xec!He\x94y\x85Dc\x8fW\xd8\x0e\xa1W\x1e\xdev\xe1\"\x1d\xc5\x90\xde\xdbFI\xcemms\x1a\xc8\x04\xc9\xbc\x914\xedo\xd6\xa1\x95A\xe2\xbe\xb1ZS\x13\xd4\x99\xf4u\xd4wW\xcd\x08w\x8b\x18\x9d#b\xe8\xa6\x97\xee\x95 M\x8b\x93i\xc9\x81\xa5H\xd3FR\xeb\x91\xdf\xa9\xd7>`\xfc\xf5PfD\xaa\xde\xa5\x98\xf6-\x03\x9cq\x94\x81\xcc-\xdc^\x0c\xb5\xb0.b6}\x85\x1e\xcd\x94\x07M8\\A\xb2\xe9\xe7O\xd6\x8d\xa6L\n\x1a{&\xc7\x06\x90\n=\xb9\x08\xb8W\x8b\x91\xf7d\x06\xdb\xaaNQ{\x93\x00\xe9\xd9\xf8\xe0\xd4\xe14\x99	\xebpt\xf6\xacZj\x99\xc6\x9f>\xd0\xc4\xac\x86\x01g\xc4\x8c\xabwx\xb6\xee\xab\xc7\xbd\xf4h\xa9S,zl\xdc\"\x8e\x82EKRs\xe5\x07\x1bz\xce\x95\x8b_M\xe0\xef\x87\xc5h\xc5QQ3Z\xebo\x12\xa7\xb6\xf4\xd2\xb5\xe3Y+\xcfRg3\xb5{u\x17\xf7\xd5?\x84o\x01\x9d\xd2\x04\xd5E\xd6\x10O\x8a2\x8d>q\x1d\x17\x15\xfaO\xac\xb8\x1f\xed\x93\xf8\xf1\xfd\xdf\x0fE\xfc\xf8\xfe\x1f[p\xe9U3\xc6G\xb7x\xf5\xd9\xa7\x9f\x8d\xf1\x8b8b\x12{\np\xe4\x83S\x0d\x8fT<K\xe0v\xbc]k5\xa8i\xe0FEs\xaf\x83\x8dx\\b4\x8ed \xb4\xe4\xd4Y\xf2\xf8\xe12\xbak\xbb\x18\xad\xf2G-\xaf\xe3Bt\xd6\x0d\xd3\x00\xb5\xca\xcf\xba\xa2O%\xf2\xdd \xbfR\x8c\x93\xa7\x18\x9e\xb4\x8ea\xbb\x8e\x1co\x17\xbc\xd4\x14C\x0dG\xebB\x0c\xfa6\xfd\xf7\xf2L\x18dK1\xaf\xc3t\xf4V\xb7\xf8\x05\xfa\xa7\xfe\xdf\xfb\xc5$'X\xf8\xd3A\xcf\x8b\xcf&v\xd6\xd1\xabe\x0f\xde\x06\xdb\xcc\xc3\xc9\xd7\x9ex\xe4\xect$7\xf8\x87(\xaayc\xdf xy??\x15\x9d\xd5\xda^\x18\xf0\xf6h\xfc\xc9I\x04y\xb0g\xfa\xb0x\xc6\x06\xd5Lzo\x8cc+\x87\xa3\xa6@\x1f_\x969\xd4:\xc8\x10I\x0c\xca\xa8\xe14@\xc2QG\x8eLCo\xf4\xfb]\x86\xe1\xe4\x03\x0e\xf4>b\xca`\xdeyE\x9e\xd7\xc4\x9f\x0e>H\x13\x94\xd4`o\x955~z\x8d\xde\x8d\xe4R7)\xaab]?'e\nQaW\x16_\xc4*]\xe1.\xa9 \xaa\xbb\x18\xcf\xa2\xde\x14\xfb\x1a\xcfIY&y\xfd\x82b\x8d$\x7f\xc1_\"_\xc5H\xff\xde\x95iU\xa1(#\xb1\xdde\"]\xc5\x10\xf92\xdb\xafD\xfe\x84\xc7}\x8d\xbc\xa8\x91\x89\xad\xa8\xd3\x15\xea\x02L8C\x89\xb4b\xb0mZ.7I^'\x8f\"\x13\xf5K\x1c\xadE\x9d3\xe6\xba(\x91`\x97\x94\xb5X\xee\xb3\xa4\xc4n_\xee\x8a*E\x92\xaf\x90\x17\xb9\xc8\xd7\xa5\xc8\x9f\xd2m\x9a\xd7\x0f\x109\xf2\x02\xe9\x974\xafQm\x92,c\xaa(\xd9\xd7\x9b\xa2d}X\x16\xbb\x97R<mjl\x8al\x95\x96\x15\x1eSd\"y\xcc\xd2\x89*\x7f\xc12K\xc46\xc6*\xd9&O\xe9\x98U\xd4\x9b\xb4\x8c8lR\x87\xe7M\xca\x9f\x98/\xc9\x91,kQ\xe4\\\xc6\xb2\xc8\xeb2Y\xd61\xea\xa2\xaco\xa9\xcf\xa2Jc$\xa5\xa8D\xfe\x14\xad\xcbb\x1b\x83\xed,\xd6\x1c\"r\xce\xcb\xd3	\x85\xad\xc6\xa7\x8e\x14\xe5x\xdeW\xe9\x0d\x10\xab4\xc9D\xfeTE\"\xff\x14\xfc\x10\xfd\x1f\x00\x00\xff\xffPK\x07\x08;&\xe9\x95\xda\x03\x00\x00\x08\x07\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce9sO\x86\x1fy*M\x0f\x00\x00P,\x00\x00\x07\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x00\x00\x00\x00apache2UT\x05\x00\x01U\x96\xd3]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce9sO\x0c\xc8i\x93#\x00\x00\x00\x1d\x00\x00\x00\x11\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x8b\x0f\x00\x00apache2.copyrightUT\x05\x00\x01U\x96\xd3]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce9sO\xf6a\xa3WY\x01\x00\x00&\x02\x00\x00\x0e\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xf6\x0f\x00\x00apache2.headerUT\x05\x00\x01U\x96\xd3]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce9sO\xfc\x1b\xa6x\xdb\x14\x00\x00\xca?\x00\x00\x04\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x94\x11\x00\x00cddlUT\x05\x00\x01U\x96\xd3]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce9sO\xc4[H\\\x18\x01\x00\x00\x04\x02\x00\x00\x0b\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xaa&\x00\x00cddl.headerUT\x05\x00\x01U\x96\xd3]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce9sO\xee\xaby\xa8\xba\x0f\x00\x00\xe0+\x00\x00\x03\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x04(\x00\x00eplUT\x05\x00\x01U\x96\xd3]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce9sOU\xe9\x92\xd4(\x00\x00\x00\"\x00\x00\x00\x0d\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xf87\x00\x00epl.copyrightUT\x05\x00\x01U\x96\xd3]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce9sO\x12\xb1=\xf4\xab\x00\x00\x00\xea\x00\x00\x00\n\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81d8\x00\x00epl.headerUT\x05\x00\x01U\x96\xd3]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce9sO\xc6`\xd3\xd7\xa7\x02\x00\x00\xf1\x04\x00\x00\x07\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81P9\x00\x00freebsdUT\x05\x00\x01U\x96\xd3]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce9sOOAG\x98(\x00\x00\x00\"\x00\x00\x00\x11\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x815<\x00\x00freebsd.copyrightUT\x05\x00\x01U\x96\xd3]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce9sOv\xb2\x07@\xa7\x02\x00\x00\xf2\x04\x00\x00\x0e\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xa5<\x00\x00freebsd.headerUT\x05\x00\x01U\x96\xd3]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce9sO\x07\xa1\x8f\xe2\x8a\x1a\x00\x00\xa7F\x00\x00\x04\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x91?\x00\x00gpl2UT\x05\x00\x01U\x96\xd3]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce9sOj\x83\x8e\x18'\x00\x00\x00!\x00\x00\x00\x0e\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81VZ\x00\x00gpl2.copyrightUT\x05\x00\x01U\x96\xd3]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce9sO}\xb6\x80,\x94\x01\x00\x00\xa7\x02\x00\x00\x0b\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xc2Z\x00\x00gpl2.headerUT\x05\x00\x01U\x96\xd3]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce9sO\xaa\xf5\x86tQ/\x00\x00F\x89\x00\x00\x04\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x98\\\x00\x00gpl3UT\x05\x00\x01U\x96\xd3]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce9sOj\x83\x8e\x18'\x00\x00\x00!\x00\x00\x00\x0e\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81$\x8c\x00\x00gpl3.copyrightUT\x05\x00\x01U\x96\xd3]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce9sOI\x82i\x93u\x01\x00\x00c\x02\x00\x00\x0b\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x90\x8c\x00\x00gpl3.headerUT\x05\x00\x01U\x96\xd3]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce9sOb\xdd\xf1\xa9x$\x00\x00\x9dg\x00\x00\x05\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81G\x8e\x00\x00lgpl2UT\x05\x00\x01U\x96\xd3]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce9sOj\x83\x8e\x18'\x00\x00\x00!\x00\x00\x00\x0f\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xfb\xb2\x00\x00lgpl2.copyrightUT\x05\x00\x01U\x96\xd3]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce9sO\x7f\x85\x84\xf3\x9e\x01\x00\x00\xbf\x02\x00\x00\x0c\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81h\xb3\x00\x00lgpl2.headerUT\x05\x00\x01U\x96\xd3]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce9sO\x1bp\xe4\x17#\n\x00\x00\xe3\x1d\x00\x00\x05\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81I\xb5\x00\x00lgpl3UT\x05\x00\x01U\x96\xd3]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce9sO\x0f#\xf6\x90l\x02\x00\x00\x16\x04\x00\x00\x03\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xa8\xbf\x00\x00mitUT\x05\x00\x01U\x96\xd3]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce9sO\x9e#\xb0\x1a'\x00\x00\x00!\x00\x00\x00\x0d\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81N\xc2\x00\x00mit.copyrightUT\x05\x00\x01U\x96\xd3]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce9sO\x0f#\xf6\x90l\x02\x00\x00\x16\x04\x00\x00\n\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xb9\xc2\x00\x00mit.headerUT\x05\x00\x01U\x96\xd3]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce9sOxF\x88\x89\xad\x14\x00\x00VA\x00\x00\x04\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81f\xc5\x00\x00mpl2UT\x05\x00\x01U\x96\xd3]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce9sO^\xdfR\xb0\x9e\x00\x00\x00\xc6\x00\x00\x00\x0b\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81N\xda\x00\x00mpl2.headerUT\x05\x00\x01U\x96\xd3]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce9sOY\x97\xb8\x85\x07\x03\x00\x00\xa9\x05\x00\x00\x06\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81.\xdb\x00\x00newbsdUT\x05\x00\x01U\x96\xd3]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce9sOOAG\x98(\x00\x00\x00\"\x00\x00\x00\x10\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81r\xde\x00\x00newbsd.copyrightUT\x05\x00\x01U\x96\xd3]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce9sOY\x97\xb8\x85\x07\x03\x00\x00\xa9\x05\x00\x00\x0d\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xe1\xde\x00\x00newbsd.headerUT\x05\x00\x01U\x96\xd3]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce9sO\xde\xf4\xce\x98\xbc\x02\x00\x00\xbb\x04\x00\x00	\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81,\xe2\x00\x00unlicenseUT\x05\x00\x01U\x96\xd3]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce9sO\x0c\xa7uF+\x00\x00\x00%\x00\x00\x00\x13\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81(\xe5\x00\x00unlicense.copyrightUT\x05\x00\x01U\x96\xd3]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce9sOk\xc8\xae\xa4|\x00\x00\x00\x9b\x00\x00\x00\x10\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x9d\xe5\x00\x00unlicense.headerUT\x05\x00\x01U\x96\xd3]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00k:sO;&\xe9\x95\xda\x03\x00\x00\x08\x07\x00\x00\x03\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81`\xe6\x00\x00uplUT\x05\x00\x01{\x97\xd3]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\xce9sO\x9e#\xb0\x1a'\x00\x00\x00!\x00\x00\x00\x0d\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81t\xea\x00\x00upl.copyrightUT\x05\x00\x01U\x96\xd3]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00k:sO;&\xe9\x95\xda\x03\x00\x00\x08\x07\x00\x00\n\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xdf\xea\x00\x00upl.headerUT\x05\x00\x01{\x97\xd3]PK\x05\x06\x00\x00\x00\x00#\x00#\x00\xeb\x08\x00\x00\xfa\xee\x00\x00\x00\x00"
-	fs.Register(data)
-}